@@ -19,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -321,6 +322,10 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 		RetainHeight: retainHeight,
 	}
 
+	if app.commitDumper != nil {
+		app.keepCommit(header.Height)
+	}
+
 	// call the hooks with the Commit message
 	for _, streamingListener := range app.abciListeners {
 		goCtx := sdk.WrapSDKContext(app.deliverState.ctx)
@@ -365,6 +370,36 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	return res
 }
 
+// keepCommit keeps the commit info and the writes of the block of the given
+// height for a dump. Failures are logged, as the dumps are only meant for
+// diagnostics.
+func (app *BaseApp) keepCommit(height int64) {
+	cInfo, err := app.CommitInfo(height)
+	if err != nil {
+		app.logger.Error("failed to keep commit info for dump", "height", height, "err", err)
+		return
+	}
+	app.commitDumper.commit(height, cInfo)
+}
+
+// ReportAppHashMismatch dumps the commit info and the writes of the latest
+// committed block if the commit dumps are enabled. It is called once consensus
+// rejects a block because the app hash of the latest committed block differs
+// from the one agreed upon by the network.
+func (app *BaseApp) ReportAppHashMismatch() {
+	if app.commitDumper == nil {
+		return
+	}
+	path, err := app.commitDumper.dump()
+	if err != nil {
+		app.logger.Error("failed to dump commit", "err", err)
+		return
+	}
+	if path != "" {
+		app.logger.Error("app hash mismatch reported, dumped the latest commit", "file", path)
+	}
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -593,10 +628,11 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResultWithDebug(err, app.trace)
 	}
+	defer release()
 
 	res, err := handler(ctx, req)
 	if err != nil {
@@ -640,10 +676,12 @@ func checkNegativeHeight(height int64) error {
 }
 
 // createQueryContext creates a new sdk.Context for a query, taking as args
-// the block height and whether the query needs a proof or not.
-func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
+// the block height and whether the query needs a proof or not. The returned
+// function must be called once the query is done, so that the queried height
+// can be pruned.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, func(), error) {
 	if err := checkNegativeHeight(height); err != nil {
-		return sdk.Context{}, err
+		return sdk.Context{}, nil, err
 	}
 
 	// when a client did not provide a query height, manually inject the latest
@@ -652,7 +690,7 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	if height <= 1 && prove {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidRequest,
 				"cannot query with proof when height <= 1; please provide a valid height",
@@ -661,16 +699,44 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 
 	lastBlockHeight := app.LastBlockHeight()
 	if height > lastBlockHeight {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidHeight,
 				"cannot query with height in the future; please provide a valid height",
 			)
 	}
 
+	// serve the past heights from the historical index if it holds them, which
+	// it does even if they are pruned, unless a proof is required
+	if app.historicalIndex != nil && !prove && height < lastBlockHeight && app.historicalIndex.HasVersion(height) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			cacheMS, err := app.historicalIndex.CacheMultiStoreWithVersion(rms.GetStores(), height)
+			if err != nil {
+				return sdk.Context{}, nil, fmt.Errorf("failed to load historical multi store for height %d: %w", height, err)
+			}
+
+			ctx := sdk.NewContext(
+				cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
+			).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
+
+			return ctx, func() {}, nil
+		}
+	}
+
+	// prevent the height from being pruned while it is queried
+	release := func() {}
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		var err error
+		release, err = rms.RetainVersion(height)
+		if err != nil {
+			return sdk.Context{}, nil, err
+		}
+	}
+
 	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
 	if err != nil {
-		return sdk.Context{}, fmt.Errorf("failed to load cache multi store for height %d: %w", height, err)
+		release()
+		return sdk.Context{}, nil, fmt.Errorf("failed to load cache multi store for height %d: %w", height, err)
 	}
 
 	// branch the commit-multistore for safety
@@ -678,7 +744,7 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
 	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
 
-	return ctx, nil
+	return ctx, release, nil
 }
 
 // GetBlockRetentionHeight returns the height for which all blocks below this height
@@ -868,10 +934,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResultWithDebug(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]), app.trace)
 	}
 
-	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResultWithDebug(err, app.trace)
 	}
+	defer release()
 
 	// Passes the rest of the path as an argument to the querier.
 	//
@@ -16,7 +16,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/historical"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
@@ -112,6 +114,14 @@ type BaseApp struct { //nolint: maligned
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
 
+	// commitDumper dumps the commit info and the writes of each block to disk
+	// for app hash mismatch diagnostics, if set
+	commitDumper *commitDumper
+
+	// historicalIndex serves the queries at past heights from the changelog of
+	// the stores, if set
+	historicalIndex *historical.Index
+
 	LastTxManager LastMsgMarkerContainer
 }
 
@@ -331,6 +341,18 @@ func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
 }
 
+// CommitInfo returns the commit info of the given version of the multistore,
+// i.e. the name, version and hash of each store, or of the latest version if
+// it is zero.
+func (app *BaseApp) CommitInfo(version int64) (*storetypes.CommitInfo, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("commit infos are not supported by multistore %T", app.cms)
+	}
+
+	return rms.CommitInfo(version)
+}
+
 // LastBlockHeight returns the last committed block height.
 func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
@@ -357,6 +379,47 @@ func (app *BaseApp) init() error {
 				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
 				app.snapshotInterval, pruningOpts.KeepEvery)
 		}
+		for key := range rms.GetStores() {
+			storeOpts := rms.GetStorePruning(key.Name())
+			if storeOpts.KeepEvery > 0 && app.snapshotInterval%storeOpts.KeepEvery != 0 {
+				return fmt.Errorf(
+					"state sync snapshot interval %v must be a multiple of pruning keep every interval %v of store %s",
+					app.snapshotInterval, storeOpts.KeepEvery, key.Name())
+			}
+		}
+	}
+
+	// record the writes to the committed stores for the commit dumps
+	if app.commitDumper != nil {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("commit dumps require a rootmulti store")
+		}
+		for key, store := range rms.GetStores() {
+			if store.GetStoreType() == sdk.StoreTypeIAVL {
+				rms.AddListeners(key, []storetypes.WriteListener{app.commitDumper})
+			}
+		}
+	}
+
+	// bring the historical index in line with the loaded state
+	if app.historicalIndex != nil {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("the historical index requires a rootmulti store")
+		}
+		indexed := make(map[string]bool)
+		for _, name := range app.historicalIndex.StoreNames() {
+			indexed[name] = true
+		}
+		for key, store := range rms.GetStores() {
+			if store.GetStoreType() == sdk.StoreTypeIAVL && !indexed[key.Name()] {
+				return fmt.Errorf("the historical index must index all the IAVL stores, %s is not indexed", key.Name())
+			}
+		}
+		if err := app.historicalIndex.Sync(rms); err != nil {
+			return fmt.Errorf("failed to sync the historical index: %w", err)
+		}
 	}
 
 	return nil
@@ -382,6 +445,14 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setCommitDump(dir string, keepRecent uint32) {
+	if dir == "" {
+		app.commitDumper = nil
+		return
+	}
+	app.commitDumper = newCommitDumper(dir, keepRecent)
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -583,6 +654,8 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 
 	if mode == runTxModeSimulate {
 		ctx, _ = ctx.CacheContext()
+		// break the gas consumption down by store for the simulation result
+		ctx = ctx.WithGasStats(storetypes.NewGasStats())
 	}
 
 	return ctx
@@ -607,6 +680,30 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 	return ctx.WithMultiStore(msCache), msCache
 }
 
+// storeGasInfos returns the gas stats of each store, sorted by store name, or
+// nil if the stats are not recorded.
+func storeGasInfos(stats *storetypes.GasStats) []sdk.StoreGasInfo {
+	if stats == nil {
+		return nil
+	}
+
+	names := stats.StoreNames()
+	infos := make([]sdk.StoreGasInfo, len(names))
+	for i, name := range names {
+		storeStats := stats.Store(name)
+		infos[i] = sdk.StoreGasInfo{
+			Store:      name,
+			Reads:      storeStats.Reads,
+			ReadBytes:  storeStats.ReadBytes,
+			Writes:     storeStats.Writes,
+			WriteBytes: storeStats.WriteBytes,
+			GasUsed:    storeStats.Gas,
+		}
+	}
+
+	return infos
+}
+
 // runTx processes a transaction within a given execution mode, encoded transaction
 // bytes, and the decoded transaction itself. All state transitions occur through
 // a cached Context depending on the mode provided. State only gets persisted
@@ -634,7 +731,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
-		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed(), StoreGas: storeGasInfos(ctx.GasStats())}
 	}()
 
 	blockGasConsumed := false
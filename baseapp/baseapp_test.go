@@ -1,8 +1,10 @@
 package baseapp
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/historical"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
@@ -125,6 +128,97 @@ func TestLoadVersionPruning(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(7), lastCommitID)
 }
 
+func TestCommitDump(t *testing.T) {
+	dir := t.TempDir()
+	app := setupBaseApp(t, SetCommitDump(dir, 2))
+
+	var res abci.ResponseCommit
+	commit := func(i int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		app.deliverState.ctx.KVStore(capKey2).Set([]byte("height"), []byte{byte(i)})
+		app.deliverState.ctx.KVStore(capKey1).Set([]byte{byte(i)}, []byte("value"))
+		if i > 1 {
+			app.deliverState.ctx.KVStore(capKey1).Delete([]byte{byte(i - 1)})
+		}
+		res = app.Commit()
+	}
+
+	// nothing is dumped until a mismatch is reported
+	for i := int64(1); i <= 3; i++ {
+		commit(i)
+	}
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// the latest block is dumped once, and only the 2 latest dumps are kept
+	app.ReportAppHashMismatch()
+	info, err := os.Stat(filepath.Join(dir, "commit-3.json"))
+	require.NoError(t, err)
+	app.ReportAppHashMismatch()
+	info2, err := os.Stat(filepath.Join(dir, "commit-3.json"))
+	require.NoError(t, err)
+	require.Equal(t, info.ModTime(), info2.ModTime())
+
+	for i := int64(4); i <= 5; i++ {
+		commit(i)
+		app.ReportAppHashMismatch()
+	}
+	_, err = os.Stat(filepath.Join(dir, "commit-3.json"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "commit-4.json"))
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(filepath.Join(dir, "commit-5.json"))
+	require.NoError(t, err)
+	var dump commitDump
+	require.NoError(t, json.Unmarshal(bz, &dump))
+
+	cInfo, err := app.CommitInfo(5)
+	require.NoError(t, err)
+	require.Equal(t, res.Data, cInfo.Hash())
+	require.Equal(t, int64(5), dump.Height)
+	require.Equal(t, hex.EncodeToString(res.Data), dump.AppHash)
+	require.Len(t, dump.Stores, 2)
+	require.Equal(t, "key1", dump.Stores[0].Name)
+	require.Equal(t, int64(5), dump.Stores[0].Version)
+	require.Equal(t, []commitDumpKVPair{
+		{Store: "key1", Key: "04", Delete: true},
+		{Store: "key1", Key: "05", Value: hex.EncodeToString([]byte("value"))},
+		{Store: "key2", Key: hex.EncodeToString([]byte("height")), Value: "05"},
+	}, dump.Writes)
+}
+
+func TestHistoricalIndexQuery(t *testing.T) {
+	idx, err := historical.NewIndex(dbm.NewMemDB(), []store.StoreKey{capKey1, capKey2})
+	require.NoError(t, err)
+	app := setupBaseApp(t,
+		SetPruning(store.PruningOptions{KeepRecent: 1, Interval: 1}),
+		func(app *BaseApp) { app.SetHistoricalIndex(idx) },
+	)
+
+	for i := int64(1); i <= 5; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		app.deliverState.ctx.KVStore(capKey1).Set([]byte("height"), []byte{byte(i)})
+		app.deliverState.ctx.KVStore(capKey2).Set([]byte{byte(i)}, []byte("value"))
+		if i > 1 {
+			app.deliverState.ctx.KVStore(capKey2).Delete([]byte{byte(i - 1)})
+		}
+		app.Commit()
+	}
+
+	// the pruned heights are served by the index, unless a proof is required
+	_, _, err = app.createQueryContext(2, true)
+	require.Error(t, err)
+
+	ctx, release, err := app.createQueryContext(2, false)
+	require.NoError(t, err)
+	defer release()
+	require.Equal(t, []byte{2}, ctx.KVStore(capKey1).Get([]byte("height")))
+	require.True(t, ctx.KVStore(capKey2).Has([]byte{2}))
+	require.False(t, ctx.KVStore(capKey2).Has([]byte{1}))
+}
+
 func testLoadVersionHelper(t *testing.T, app *BaseApp, expectedHeight int64, expectedID sdk.CommitID) {
 	lastHeight := app.LastBlockHeight()
 	lastID := app.LastCommitID()
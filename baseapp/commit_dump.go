@@ -0,0 +1,195 @@
+package baseapp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitDumpFileFmt is the name of the dump file of a height in the dump directory.
+const commitDumpFileFmt = "commit-%d.json"
+
+// commitDumper records the writes of each block to the multistore, and keeps
+// those of the latest committed block in memory with its commit info, to dump
+// them to disk once consensus reports an app hash mismatch.
+//
+// Tendermint rejects the next block of a node whose app hash differs from the
+// one agreed upon by the network, before the application gets to process it.
+// Hence the latest committed block is the diverging one when the mismatch is
+// reported, and its dump can be compared with the commit info of the same
+// height on another node to find out which store and which keys diverged.
+type commitDumper struct {
+	dir        string
+	keepRecent uint32
+
+	mtx        sync.Mutex
+	writes     []storetypes.StoreKVPair
+	lastHeight int64
+	lastCInfo  *storetypes.CommitInfo
+	lastWrites []storetypes.StoreKVPair
+	// dumped is the latest height dumped, consensus reporting a mismatch
+	// each time it rejects a block
+	dumped int64
+}
+
+var _ storetypes.WriteListener = (*commitDumper)(nil)
+
+// commitDump is the content of a dump file.
+type commitDump struct {
+	Height  int64              `json:"height"`
+	AppHash string             `json:"app_hash"`
+	Stores  []commitDumpStore  `json:"stores"`
+	Writes  []commitDumpKVPair `json:"writes"`
+}
+
+// commitDumpStore is the commit info of a store.
+type commitDumpStore struct {
+	Name    string `json:"name"`
+	Version int64  `json:"version"`
+	Hash    string `json:"hash"`
+}
+
+// commitDumpKVPair is a write of the block, the value being empty for a delete.
+type commitDumpKVPair struct {
+	Store  string `json:"store"`
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Delete bool   `json:"delete,omitempty"`
+}
+
+func newCommitDumper(dir string, keepRecent uint32) *commitDumper {
+	return &commitDumper{
+		dir:        dir,
+		keepRecent: keepRecent,
+	}
+}
+
+// OnWrite implements WriteListener.
+func (d *commitDumper) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.writes = append(d.writes, storetypes.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Key:      key,
+		Value:    value,
+		Delete:   delete,
+	})
+
+	return nil
+}
+
+// commit keeps the commit info of the given height and the writes recorded
+// since the previous commit as the latest committed block.
+func (d *commitDumper) commit(height int64, cInfo *storetypes.CommitInfo) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.lastHeight = height
+	d.lastCInfo = cInfo
+	d.lastWrites = d.writes
+	d.writes = nil
+}
+
+// dump writes the commit info and the writes of the latest committed block to
+// the dump directory, unless they are already dumped, and removes the dumps
+// beyond the ones to keep. It returns the path of the dump file, if any.
+func (d *commitDumper) dump() (string, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if d.lastCInfo == nil || d.dumped == d.lastHeight {
+		return "", nil
+	}
+	height, cInfo := d.lastHeight, d.lastCInfo
+
+	dump := commitDump{
+		Height:  height,
+		AppHash: hex.EncodeToString(cInfo.Hash()),
+		Stores:  make([]commitDumpStore, 0, len(cInfo.StoreInfos)),
+		Writes:  make([]commitDumpKVPair, 0, len(d.lastWrites)),
+	}
+	for _, storeInfo := range cInfo.StoreInfos {
+		dump.Stores = append(dump.Stores, commitDumpStore{
+			Name:    storeInfo.Name,
+			Version: storeInfo.CommitId.Version,
+			Hash:    hex.EncodeToString(storeInfo.CommitId.Hash),
+		})
+	}
+	sort.Slice(dump.Stores, func(i, j int) bool { return dump.Stores[i].Name < dump.Stores[j].Name })
+
+	// the stores are written in no particular order, so sort the writes by
+	// store to make the dumps of two nodes comparable
+	writes := d.lastWrites
+	sort.SliceStable(writes, func(i, j int) bool { return writes[i].StoreKey < writes[j].StoreKey })
+	for _, write := range writes {
+		dump.Writes = append(dump.Writes, commitDumpKVPair{
+			Store:  write.StoreKey,
+			Key:    hex.EncodeToString(write.Key),
+			Value:  hex.EncodeToString(write.Value),
+			Delete: write.Delete,
+		})
+	}
+
+	bz, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		return "", err
+	}
+
+	// write to a temporary file first so that a crash never leaves a partial dump
+	path := filepath.Join(d.dir, fmt.Sprintf(commitDumpFileFmt, height))
+	if err := os.WriteFile(path+".tmp", bz, 0o644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return "", err
+	}
+	d.dumped = height
+
+	return path, d.prune()
+}
+
+// prune removes the dumps older than the keepRecent latest ones.
+func (d *commitDumper) prune() error {
+	if d.keepRecent == 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return err
+	}
+	var heights []int64
+	for _, entry := range entries {
+		var height int64
+		if _, err := fmt.Sscanf(entry.Name(), commitDumpFileFmt, &height); err != nil {
+			continue
+		}
+		if entry.Name() == fmt.Sprintf(commitDumpFileFmt, height) {
+			heights = append(heights, height)
+		}
+	}
+	if len(heights) <= int(d.keepRecent) {
+		return nil
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	for _, height := range heights[:len(heights)-int(d.keepRecent)] {
+		err := os.Remove(filepath.Join(d.dir, fmt.Sprintf(commitDumpFileFmt, height)))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -537,6 +537,60 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// Simulate a transaction accessing a store, the simulation result breaks the
+// gas consumption down by store.
+func TestSimulateTxStoreGas(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			ctx.KVStore(capKey1).Has([]byte("ante"))
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kvStore := ctx.KVStore(capKey1)
+			kvStore.Set([]byte("key"), []byte("value"))
+			kvStore.Get([]byte("key"))
+			ctx.GasMeter().ConsumeGas(10, "test")
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	tx := newTxCounter(0, 0)
+	txBytes, err := cdc.Marshal(tx)
+	require.NoError(t, err)
+
+	gInfo, _, err := app.Simulate(txBytes)
+	require.NoError(t, err)
+
+	gasConfig := store.KVGasConfig()
+	storeGas := gasConfig.HasCost +
+		gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*8 +
+		gasConfig.ReadCostFlat + gasConfig.ReadCostPerByte*8
+	require.Equal(t, []sdk.StoreGasInfo{{
+		Store:      capKey1.Name(),
+		Reads:      2,
+		ReadBytes:  4 + 8,
+		Writes:     1,
+		WriteBytes: 8,
+		GasUsed:    storeGas,
+	}}, gInfo.StoreGas)
+	require.Equal(t, storeGas+10, gInfo.GasUsed)
+
+	// the breakdown is only recorded when simulating
+	gInfo, _, err = app.Deliver(aminoTxEncoder(), tx)
+	require.NoError(t, err)
+	require.Nil(t, gInfo.StoreGas)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
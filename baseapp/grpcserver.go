@@ -47,10 +47,11 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.createQueryContext(height, false)
+		sdkCtx, release, err := app.createQueryContext(height, false)
 		if err != nil {
 			return nil, err
 		}
+		defer release()
 
 		// Add relevant gRPC headers
 		if height == 0 {
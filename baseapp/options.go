@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/historical"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,6 +21,34 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets pruning options on the stores with the given names of
+// the multistore associated with the app, overriding the pruning options of the
+// multistore for these stores.
+func SetStorePruning(opts map[string]sdk.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		for storeName, storeOpts := range opts {
+			bapp.cms.SetStorePruning(storeName, storeOpts)
+		}
+	}
+}
+
+// SetStoreMetrics enables the metrics of the accesses to the stores with the
+// given names of the multistore associated with the app, or to all the stores
+// for "*".
+func SetStoreMetrics(storeNames []string) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		for _, storeName := range storeNames {
+			bapp.cms.SetStoreMetrics(storeName, true)
+		}
+	}
+}
+
+// SetAsyncPruning enables or disables the deletion of pruned versions in the
+// background on the multistore associated with the app.
+func SetAsyncPruning(async bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetAsyncPruning(async) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -67,6 +96,14 @@ func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
 }
 
+// SetCommitDump provides a BaseApp option function that dumps the commit info
+// and the writes of the latest committed block to the given directory when an
+// app hash mismatch is reported, keeping the keepRecent latest dumps, or all of
+// them if it is zero.
+func SetCommitDump(dir string, keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCommitDump(dir, keepRecent) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
@@ -248,6 +285,13 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.msgServiceRouter.SetInterfaceRegistry(registry)
 }
 
+// SetHistoricalIndex sets the historical index serving the queries at past
+// heights, and registers it as a streaming service to index the writes.
+func (app *BaseApp) SetHistoricalIndex(idx *historical.Index) {
+	app.SetStreamingService(idx)
+	app.historicalIndex = idx
+}
+
 // SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore
 func (app *BaseApp) SetStreamingService(s StreamingService) {
 	// add the listeners for each StoreKey
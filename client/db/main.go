@@ -0,0 +1,21 @@
+package db
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// Cmd creates the main CLI command of the tools operating on the application
+// database of a stopped node.
+func Cmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Tools for managing the application database of a stopped node",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(MigrateCmd(defaultNodeHome))
+
+	return cmd
+}
@@ -0,0 +1,327 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	flagFrom      = "from"
+	flagTo        = "to"
+	flagTargetDir = "target-dir"
+	flagBatchSize = "batch-size"
+
+	appDBName        = "application"
+	progressFileName = "migrate.json"
+
+	// reportInterval is the minimum interval between two progress reports.
+	reportInterval = 5 * time.Second
+)
+
+// MigrateProgress is the progress of a migration, which is saved after each
+// copied batch of keys so that an interrupted migration can be resumed.
+type MigrateProgress struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// LastKey is the last key copied to the target database.
+	LastKey []byte `json:"last_key,omitempty"`
+	Keys    uint64 `json:"keys"`
+	Bytes   uint64 `json:"bytes"`
+	// Done is true once all the keys are copied.
+	Done bool `json:"done"`
+}
+
+// MigrateCmd copies the application database of a node to a database of
+// another backend.
+func MigrateCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy the application database to a database of another backend",
+		Long: fmt.Sprintf(`Copy the application database of the stopped node in --home, using the --%s backend,
+to a new database using the --%s backend in --%s, which defaults to <home>/data/<to>.
+Once all the keys are copied, the new database is verified to hold the same keys and values, and
+the stores of its latest version to match the hashes of the latest commit info.
+
+The migration saves its progress to %s in the target directory and resumes from there when
+interrupted and run again with the same flags.
+
+The backends available depend on the build tags of the binary, e.g. rocksdb requires the
+rocksdb tag. Once migrated, replace <home>/data/%s.db with the new database and run the node
+built with the new backend.`, flagFrom, flagTo, flagTargetDir, progressFileName, appDBName),
+		Example: "migrate --home ./ --from goleveldb --to rocksdb",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			from, _ := cmd.Flags().GetString(flagFrom)
+			to, _ := cmd.Flags().GetString(flagTo)
+			targetDir, _ := cmd.Flags().GetString(flagTargetDir)
+			batchSize, _ := cmd.Flags().GetInt(flagBatchSize)
+
+			if to == "" {
+				return fmt.Errorf("the --%s backend is required", flagTo)
+			}
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid --%s %d", flagBatchSize, batchSize)
+			}
+
+			dataDir := filepath.Join(home, "data")
+			if targetDir == "" {
+				targetDir = filepath.Join(dataDir, to)
+			}
+			if filepath.Clean(targetDir) == filepath.Clean(dataDir) {
+				return fmt.Errorf("the target directory must differ from %s", dataDir)
+			}
+
+			if _, err := os.Stat(filepath.Join(dataDir, appDBName+".db")); err != nil {
+				return fmt.Errorf("failed to find the application database: %w", err)
+			}
+			srcDB, err := dbm.NewDB(appDBName, dbm.BackendType(from), dataDir)
+			if err != nil {
+				return err
+			}
+			defer srcDB.Close()
+
+			if err := os.MkdirAll(targetDir, 0o755); err != nil {
+				return err
+			}
+			progressFile := filepath.Join(targetDir, progressFileName)
+			progress, err := loadMigrateProgress(progressFile, from, to)
+			if err != nil {
+				return err
+			}
+
+			dstDB, err := dbm.NewDB(appDBName, dbm.BackendType(to), targetDir)
+			if err != nil {
+				return err
+			}
+			defer dstDB.Close()
+
+			out := cmd.OutOrStdout()
+			if !progress.Done {
+				if len(progress.LastKey) > 0 {
+					fmt.Fprintf(out, "resuming the migration after %d keys\n", progress.Keys)
+				}
+
+				ctx := cmd.Context()
+				if ctx == nil {
+					ctx = context.Background()
+				}
+
+				start, lastReport := time.Now(), time.Now()
+				err := CopyDB(ctx, srcDB, dstDB, progress, progressFile, batchSize, func(p MigrateProgress) {
+					if time.Since(lastReport) >= reportInterval {
+						lastReport = time.Now()
+						printProgress(out, p, time.Since(start))
+					}
+				})
+				if err != nil {
+					return err
+				}
+				printProgress(out, *progress, time.Since(start))
+			}
+
+			fmt.Fprintln(out, "verifying the migrated database")
+			cInfo, err := VerifyMigration(srcDB, dstDB)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(out, "migrated %d keys to %s, the app hash at height %d is %X\n",
+				progress.Keys, filepath.Join(targetDir, appDBName+".db"), cInfo.Version, cInfo.Hash())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node home directory")
+	cmd.Flags().String(flagFrom, string(dbm.GoLevelDBBackend), "The backend of the application database")
+	cmd.Flags().String(flagTo, "", "The backend of the migrated database")
+	cmd.Flags().String(flagTargetDir, "", "The directory of the migrated database (default <home>/data/<to>)")
+	cmd.Flags().Int(flagBatchSize, 10000, "The number of keys written at once to the migrated database")
+
+	return cmd
+}
+
+// CopyDB copies the keys of src following the last key of progress to dst,
+// in batches of batchSize keys. The progress is updated and saved to
+// progressFile after each batch, then passed to report. It is marked as done
+// once all the keys are copied.
+func CopyDB(ctx context.Context, src, dst dbm.DB, progress *MigrateProgress, progressFile string, batchSize int, report func(MigrateProgress)) error {
+	var start []byte
+	if len(progress.LastKey) > 0 {
+		// the smallest key following the last copied one
+		start = append(copyBytes(progress.LastKey), 0)
+	}
+
+	it, err := src.Iterator(start, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Valid() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch := dst.NewBatch()
+		next := *progress
+		for n := 0; n < batchSize && it.Valid(); n++ {
+			key, value := copyBytes(it.Key()), copyBytes(it.Value())
+			if err := batch.Set(key, value); err != nil {
+				batch.Close()
+				return err
+			}
+
+			next.LastKey = key
+			next.Keys++
+			next.Bytes += uint64(len(key) + len(value))
+			it.Next()
+		}
+		if err := it.Error(); err != nil {
+			batch.Close()
+			return err
+		}
+
+		err := batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+
+		*progress = next
+		if err := saveMigrateProgress(progressFile, *progress); err != nil {
+			return err
+		}
+		report(*progress)
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	progress.Done = true
+	return saveMigrateProgress(progressFile, *progress)
+}
+
+// VerifyMigration checks that dst holds the same keys and values as src, and
+// that the stores of its latest version match the hashes of the latest commit
+// info of src, which is returned.
+func VerifyMigration(src, dst dbm.DB) (*storetypes.CommitInfo, error) {
+	if err := compareDBs(src, dst); err != nil {
+		return nil, err
+	}
+
+	version := rootmulti.GetLatestVersion(src)
+	if version == 0 {
+		return nil, errors.New("the application database has no committed version")
+	}
+	srcInfo, err := rootmulti.GetCommitInfo(src, version)
+	if err != nil {
+		return nil, err
+	}
+
+	cInfo, err := rootmulti.VerifyCommitInfo(dst, version)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(cInfo.Hash(), srcInfo.Hash()) {
+		return nil, fmt.Errorf("app hash mismatch at height %d; expected %X got %X", version, srcInfo.Hash(), cInfo.Hash())
+	}
+
+	return cInfo, nil
+}
+
+// compareDBs checks that the two databases hold the same keys and values.
+func compareDBs(db, otherDB dbm.DB) error {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	otherIt, err := otherDB.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer otherIt.Close()
+
+	for ; it.Valid(); it.Next() {
+		if !otherIt.Valid() {
+			return fmt.Errorf("key %X is missing from the migrated database", it.Key())
+		}
+		if !bytes.Equal(it.Key(), otherIt.Key()) {
+			return fmt.Errorf("key mismatch in the migrated database; expected %X got %X", it.Key(), otherIt.Key())
+		}
+		if !bytes.Equal(it.Value(), otherIt.Value()) {
+			return fmt.Errorf("value mismatch of key %X in the migrated database", it.Key())
+		}
+		otherIt.Next()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if otherIt.Valid() {
+		return fmt.Errorf("unexpected key %X in the migrated database", otherIt.Key())
+	}
+
+	return otherIt.Error()
+}
+
+// loadMigrateProgress loads the progress of the migration from the given
+// backends, which is empty if the migration did not start yet.
+func loadMigrateProgress(file, from, to string) (*MigrateProgress, error) {
+	progress := &MigrateProgress{From: from, To: to}
+
+	bz, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return progress, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bz, progress); err != nil {
+		return nil, fmt.Errorf("invalid migration progress file %s: %w", file, err)
+	}
+	if progress.From != from || progress.To != to {
+		return nil, fmt.Errorf("%s is the progress of a migration from %s to %s, remove the target directory to start over",
+			file, progress.From, progress.To)
+	}
+
+	return progress, nil
+}
+
+// saveMigrateProgress atomically writes the progress to the given file.
+func saveMigrateProgress(file string, progress MigrateProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, file)
+}
+
+func printProgress(out io.Writer, p MigrateProgress, elapsed time.Duration) {
+	fmt.Fprintf(out, "copied %d keys (%d MB) in %s\n", p.Keys, p.Bytes>>20, elapsed.Round(time.Second))
+}
+
+func copyBytes(bz []byte) []byte {
+	return append([]byte{}, bz...)
+}
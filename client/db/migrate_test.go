@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func commitStores(t *testing.T, db dbm.DB) storetypes.CommitID {
+	ms := rootmulti.NewStore(db, log.NewNopLogger())
+	keys := []*storetypes.KVStoreKey{storetypes.NewKVStoreKey("store1"), storetypes.NewKVStoreKey("store2")}
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	var id storetypes.CommitID
+	for i := 0; i < 5; i++ {
+		for _, key := range keys {
+			ms.GetKVStore(key).Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		}
+		id = ms.Commit()
+	}
+
+	return id
+}
+
+func TestCopyDB(t *testing.T) {
+	src, dst := dbm.NewMemDB(), dbm.NewMemDB()
+	id := commitStores(t, src)
+	progressFile := filepath.Join(t.TempDir(), progressFileName)
+
+	// interrupt the copy after the first batch
+	ctx, cancel := context.WithCancel(context.Background())
+	progress := &MigrateProgress{From: "memdb", To: "memdb"}
+	err := CopyDB(ctx, src, dst, progress, progressFile, 3, func(MigrateProgress) { cancel() })
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, uint64(3), progress.Keys)
+	require.False(t, progress.Done)
+
+	_, err = VerifyMigration(src, dst)
+	require.Error(t, err)
+
+	// resume from the saved progress
+	progress, err = loadMigrateProgress(progressFile, "memdb", "memdb")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), progress.Keys)
+	require.NoError(t, CopyDB(context.Background(), src, dst, progress, progressFile, 3, func(MigrateProgress) {}))
+	require.True(t, progress.Done)
+
+	cInfo, err := VerifyMigration(src, dst)
+	require.NoError(t, err)
+	require.Equal(t, id.Hash, cInfo.Hash())
+
+	// a progress file belongs to the backends of its migration
+	_, err = loadMigrateProgress(progressFile, "memdb", "goleveldb")
+	require.Error(t, err)
+
+	// any difference fails the verification
+	require.NoError(t, dst.Set([]byte("extra"), []byte("value")))
+	_, err = VerifyMigration(src, dst)
+	require.Error(t, err)
+}
+
+func TestMigrateCmd(t *testing.T) {
+	home := t.TempDir()
+	db, err := dbm.NewGoLevelDB(appDBName, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	id := commitStores(t, db)
+	require.NoError(t, db.Close())
+
+	targetDir := filepath.Join(t.TempDir(), "migrated")
+	run := func() error {
+		cmd := MigrateCmd("")
+		cmd.SetArgs([]string{
+			fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+			fmt.Sprintf("--%s=%s", flagTo, dbm.GoLevelDBBackend),
+			fmt.Sprintf("--%s=%s", flagTargetDir, targetDir),
+			fmt.Sprintf("--%s=%d", flagBatchSize, 4),
+		})
+		return cmd.Execute()
+	}
+	require.NoError(t, run())
+
+	// running a completed migration again only verifies it
+	require.NoError(t, run())
+
+	migrated, err := dbm.NewGoLevelDB(appDBName, targetDir)
+	require.NoError(t, err)
+	defer migrated.Close()
+	cInfo, err := rootmulti.VerifyCommitInfo(migrated, 0)
+	require.NoError(t, err)
+	require.Equal(t, id.Hash, cInfo.Hash())
+}
@@ -25,6 +25,8 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(PubkeyCmd())
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(StateDiffCmd())
+	cmd.AddCommand(StreamReadCmd())
 
 	return cmd
 }
@@ -0,0 +1,245 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHeight      = "height"
+	flagOtherHeight = "other-height"
+	flagStores      = "stores"
+	flagDecode      = "decode"
+	flagDecoders    = "decoders"
+)
+
+// defaultStateDecoders are the value decoders of the known key prefixes of the
+// stores of the SDK modules, as store/hex-prefix=protobuf-message-name.
+var defaultStateDecoders = []string{
+	"acc/01=google.protobuf.Any",
+	"authz/01=cosmos.authz.v1beta1.Grant",
+	"bank/02=cosmos.base.v1beta1.Coin",
+	"distribution/00=cosmos.distribution.v1beta1.FeePool",
+	"distribution/02=cosmos.distribution.v1beta1.ValidatorOutstandingRewards",
+	"distribution/04=cosmos.distribution.v1beta1.DelegatorStartingInfo",
+	"feegrant/00=cosmos.feegrant.v1beta1.Grant",
+	"gov/00=cosmos.gov.v1beta1.Proposal",
+	"gov/10=cosmos.gov.v1beta1.Deposit",
+	"gov/20=cosmos.gov.v1beta1.Vote",
+	"slashing/01=cosmos.slashing.v1beta1.ValidatorSigningInfo",
+	"staking/21=cosmos.staking.v1beta1.Validator",
+	"staking/31=cosmos.staking.v1beta1.Delegation",
+	"staking/32=cosmos.staking.v1beta1.UnbondingDelegation",
+	"staking/34=cosmos.staking.v1beta1.Redelegation",
+}
+
+// StateDiffCmd compares the application state of two heights or two home directories.
+func StateDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [home] [other-home]",
+		Short: "Compare the application state of two heights or two home directories",
+		Long: fmt.Sprintf(`Compare the application state at --height in [home] with the one at --other-height
+in [other-home], or in [home] if omitted. Heights default to the latest height of each home.
+
+The application databases are opened with the --app-db-backend backend, or the one the binary was
+built with, and are not written, but the nodes must be stopped. Each IAVL store
+whose hash differs is walked in parallel and the keys whose value differs are printed as
+'<store> <op> <key> <value> [<other value>]' in hex, where op is '-' for keys only in [home],
+'+' for keys only in [other-home], and '~' for keys whose value changed.
+
+If the --decode flag is set, the values of the known key prefixes of the SDK module stores are
+decoded as protobuf messages and printed as JSON. More prefixes may be decoded with the --decoders
+flag, as store/hex-prefix=protobuf-message-name.
+
+Example:
+$ %s debug state-diff ~/.simapp --height 100 --other-height 101
+$ %s debug state-diff ~/.simapp ./validator2 --stores bank,staking --decode
+`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			otherHeight, _ := cmd.Flags().GetInt64(flagOtherHeight)
+			storeNames, _ := cmd.Flags().GetStringSlice(flagStores)
+			output, _ := cmd.Flags().GetString(cli.OutputFlag)
+
+			var decoders []string
+			if decode, _ := cmd.Flags().GetBool(flagDecode); decode {
+				decoders = append(decoders, defaultStateDecoders...)
+			}
+			extraDecoders, _ := cmd.Flags().GetStringSlice(flagDecoders)
+			decoders = append(decoders, extraDecoders...)
+			decoder, err := newStateDecoder(clientCtx.Codec, decoders)
+			if err != nil {
+				return err
+			}
+
+			backend := server.GetAppDBBackend(server.GetServerContextFromCmd(cmd).Viper)
+			db, err := openAppDB(args[0], backend)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			otherDB := db
+			if len(args) == 2 {
+				otherDB, err = openAppDB(args[1], backend)
+				if err != nil {
+					return err
+				}
+				defer otherDB.Close()
+			} else if height == otherHeight {
+				return fmt.Errorf("set --%s or --%s to compare two heights of the same home", flagHeight, flagOtherHeight)
+			}
+
+			out := cmd.OutOrStdout()
+			return rootmulti.DiffVersions(db, height, otherDB, otherHeight, storeNames, func(diff rootmulti.KVPairDiff) error {
+				entry := stateDiffEntry{
+					Store:      diff.StoreName,
+					Key:        hex.EncodeToString(diff.Key),
+					Value:      decoder.decode(diff.StoreName, diff.Key, diff.Value),
+					OtherValue: decoder.decode(diff.StoreName, diff.Key, diff.OtherValue),
+				}
+
+				if output == "json" {
+					bz, err := json.Marshal(entry)
+					if err != nil {
+						return err
+					}
+					_, err = fmt.Fprintln(out, string(bz))
+					return err
+				}
+
+				var line string
+				switch {
+				case diff.OtherValue == nil:
+					line = fmt.Sprintf("%s - %s %s", entry.Store, entry.Key, entry.Value)
+				case diff.Value == nil:
+					line = fmt.Sprintf("%s + %s %s", entry.Store, entry.Key, entry.OtherValue)
+				default:
+					line = fmt.Sprintf("%s ~ %s %s %s", entry.Store, entry.Key, entry.Value, entry.OtherValue)
+				}
+				_, err := fmt.Fprintln(out, line)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height of the state in [home], the latest if 0")
+	cmd.Flags().Int64(flagOtherHeight, 0, "The height of the state in [other-home], the latest if 0")
+	cmd.Flags().StringSlice(flagStores, nil, "Compare only the given stores")
+	cmd.Flags().Bool(flagDecode, false, "Decode the values of the known key prefixes of the SDK module stores")
+	cmd.Flags().StringSlice(flagDecoders, nil, "Decode the values of additional key prefixes, as store/hex-prefix=protobuf-message-name")
+	cmd.Flags().String(cli.OutputFlag, "text", "Output format (text|json)")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The backend of the application databases, the one the binary was built with if empty")
+
+	return cmd
+}
+
+// stateDiffEntry is a key whose value differs, as printed by StateDiffCmd.
+type stateDiffEntry struct {
+	Store      string `json:"store"`
+	Key        string `json:"key"`
+	Value      string `json:"value,omitempty"`
+	OtherValue string `json:"other_value,omitempty"`
+}
+
+// openAppDB opens the application database of the given home directory, which
+// must exist, so that a wrong home is not compared as an empty state.
+func openAppDB(home string, backend dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(home, "data")
+	if _, err := os.Stat(dataDir); err != nil {
+		return nil, err
+	}
+
+	return dbm.NewDB("application", backend, dataDir)
+}
+
+// stateDecoder decodes the values of known key prefixes as protobuf messages.
+type stateDecoder struct {
+	cdc      codec.Codec
+	prefixes []statePrefixDecoder
+}
+
+type statePrefixDecoder struct {
+	store   string
+	prefix  []byte
+	msgType reflect.Type
+}
+
+// newStateDecoder returns a decoder of the key prefixes given as
+// store/hex-prefix=protobuf-message-name, the last ones taking precedence.
+func newStateDecoder(cdc codec.Codec, decoders []string) (*stateDecoder, error) {
+	d := &stateDecoder{cdc: cdc}
+
+	for _, decoder := range decoders {
+		prefix, msgName, ok := strings.Cut(decoder, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid decoder %s, expected store/hex-prefix=protobuf-message-name", decoder)
+		}
+		store, hexPrefix, ok := strings.Cut(prefix, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid decoder %s, expected store/hex-prefix=protobuf-message-name", decoder)
+		}
+		bz, err := hex.DecodeString(hexPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decoder %s: %w", decoder, err)
+		}
+
+		msgType := proto.MessageType(msgName)
+		if msgType == nil {
+			return nil, fmt.Errorf("invalid decoder %s: unknown protobuf message %s", decoder, msgName)
+		}
+
+		d.prefixes = append([]statePrefixDecoder{{store: store, prefix: bz, msgType: msgType}}, d.prefixes...)
+	}
+
+	return d, nil
+}
+
+// decode returns the value of a key of a store as JSON if its prefix is known
+// and it can be decoded, or in hex otherwise.
+func (d *stateDecoder) decode(store string, key, value []byte) string {
+	if value == nil {
+		return ""
+	}
+
+	for _, p := range d.prefixes {
+		if p.store != store || !bytes.HasPrefix(key, p.prefix) {
+			continue
+		}
+
+		msg, ok := reflect.New(p.msgType.Elem()).Interface().(codec.ProtoMarshaler)
+		if !ok {
+			break
+		}
+		if err := d.cdc.Unmarshal(value, msg); err != nil {
+			break
+		}
+		bz, err := d.cdc.MarshalJSON(msg)
+		if err != nil {
+			break
+		}
+		return string(bz)
+	}
+
+	return hex.EncodeToString(value)
+}
@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/segment"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagPrefix      = "prefix"
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+)
+
+// StreamReadCmd prints the records written by the file streaming service.
+func StreamReadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-read [dir]",
+		Short: "Print the ABCI messages and state changes written by the file streaming service",
+		Long: fmt.Sprintf(`Print the records of the blocks written to the segment files of [dir] by the file streaming
+service, from --start-height to --end-height, both included, which default to the first and the last
+blocks of the segments.
+
+The records of each block are its ABCI requests and responses in execution order, if the metadata
+was output, followed by its state changes. Each record is printed as '<height> <type> <message>',
+where the message is the record encoded as protobuf JSON.
+
+Example:
+$ %s debug stream-read ~/.simapp/data/file_streamer --start-height 100 --end-height 110
+$ %s debug stream-read ./streams --prefix node1 --stores bank,staking --output json
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, _ := cmd.Flags().GetString(flagPrefix)
+			startHeight, _ := cmd.Flags().GetInt64(flagStartHeight)
+			endHeight, _ := cmd.Flags().GetInt64(flagEndHeight)
+			storeNames, _ := cmd.Flags().GetStringSlice(flagStores)
+			output, _ := cmd.Flags().GetString(cli.OutputFlag)
+
+			r, err := segment.NewReader(args[0], prefix)
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+
+			out := cmd.OutOrStdout()
+			return r.Iterate(startHeight, endHeight, func(block *segment.Block) error {
+				for _, record := range block.Records() {
+					if pair, ok := record.Message.(*types.StoreKVPair); ok && len(storeNames) > 0 && !sdk.SliceContains(storeNames, pair.StoreKey) {
+						continue
+					}
+
+					bz, err := codec.ProtoMarshalJSON(record.Message, nil)
+					if err != nil {
+						return err
+					}
+
+					line := fmt.Sprintf("%d %s %s", block.Height, record.Type, bz)
+					if output == "json" {
+						bz, err = json.Marshal(streamRecord{Height: block.Height, Type: record.Type, Message: bz})
+						if err != nil {
+							return err
+						}
+						line = string(bz)
+					}
+
+					if _, err := fmt.Fprintln(out, line); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, "", "The prefix of the segment files")
+	cmd.Flags().Int64(flagStartHeight, 0, "The height of the first block to print, the first of the segments if 0")
+	cmd.Flags().Int64(flagEndHeight, 0, "The height of the last block to print, the last of the segments if 0")
+	cmd.Flags().StringSlice(flagStores, nil, "Print only the state changes of the given stores")
+	cmd.Flags().String(cli.OutputFlag, "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// streamRecord is a record of a block, as printed by StreamReadCmd.
+type streamRecord struct {
+	Height  int64           `json:"height"`
+	Type    string          `json:"type"`
+	Message json.RawMessage `json:"message"`
+}
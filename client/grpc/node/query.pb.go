@@ -6,6 +6,7 @@ package node
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/store/types"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -110,9 +111,111 @@ func (m *ConfigResponse) GetMinimumGasPrice() string {
 	return ""
 }
 
+// CommitInfoRequest defines the request structure for the CommitInfo gRPC query.
+type CommitInfoRequest struct {
+	// height is the height to query, the latest height if 0.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CommitInfoRequest) Reset()         { *m = CommitInfoRequest{} }
+func (m *CommitInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitInfoRequest) ProtoMessage()    {}
+func (*CommitInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{2}
+}
+func (m *CommitInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitInfoRequest.Merge(m, src)
+}
+func (m *CommitInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitInfoRequest proto.InternalMessageInfo
+
+func (m *CommitInfoRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// CommitInfoResponse defines the response structure for the CommitInfo gRPC query.
+type CommitInfoResponse struct {
+	CommitInfo *types.CommitInfo `protobuf:"bytes,1,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
+	// app_hash is the hash of the commit info, i.e. the app hash of the height.
+	AppHash []byte `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+}
+
+func (m *CommitInfoResponse) Reset()         { *m = CommitInfoResponse{} }
+func (m *CommitInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitInfoResponse) ProtoMessage()    {}
+func (*CommitInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{3}
+}
+func (m *CommitInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitInfoResponse.Merge(m, src)
+}
+func (m *CommitInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitInfoResponse proto.InternalMessageInfo
+
+func (m *CommitInfoResponse) GetCommitInfo() *types.CommitInfo {
+	if m != nil {
+		return m.CommitInfo
+	}
+	return nil
+}
+
+func (m *CommitInfoResponse) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
+	proto.RegisterType((*CommitInfoRequest)(nil), "cosmos.base.node.v1beta1.CommitInfoRequest")
+	proto.RegisterType((*CommitInfoResponse)(nil), "cosmos.base.node.v1beta1.CommitInfoResponse")
 }
 
 func init() {
@@ -120,25 +223,34 @@ func init() {
 }
 
 var fileDescriptor_8324226a07064341 = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xb1, 0x4b, 0xc3, 0x40,
-	0x18, 0xc5, 0x73, 0x0e, 0x15, 0x0f, 0xb4, 0x98, 0xa9, 0x14, 0x39, 0x4a, 0x10, 0x0c, 0x42, 0xef,
-	0xa8, 0xae, 0x4e, 0x3a, 0x74, 0x95, 0xba, 0xb9, 0x94, 0xcb, 0xf5, 0xf3, 0x3c, 0x6c, 0xee, 0x4b,
-	0x73, 0x97, 0x82, 0xab, 0xe0, 0xae, 0xf8, 0x4f, 0x39, 0x16, 0x5c, 0x1c, 0x25, 0xf1, 0x0f, 0x91,
-	0x24, 0xed, 0xe0, 0x50, 0x3a, 0x1d, 0xbc, 0xfb, 0xbd, 0xf7, 0x3d, 0x1e, 0x3d, 0x55, 0xe8, 0x52,
-	0x74, 0x22, 0x91, 0x0e, 0x84, 0xc5, 0x19, 0x88, 0xe5, 0x28, 0x01, 0x2f, 0x47, 0x62, 0x51, 0x40,
-	0xfe, 0xcc, 0xb3, 0x1c, 0x3d, 0x86, 0xbd, 0x96, 0xe2, 0x35, 0xc5, 0x6b, 0x8a, 0xaf, 0xa9, 0xfe,
-	0x89, 0x46, 0xd4, 0x73, 0x10, 0x32, 0x33, 0x42, 0x5a, 0x8b, 0x5e, 0x7a, 0x83, 0xd6, 0xb5, 0xbe,
-	0xa8, 0x4b, 0x0f, 0x6f, 0xd0, 0x3e, 0x18, 0x3d, 0x81, 0x45, 0x01, 0xce, 0x47, 0x57, 0xf4, 0x68,
-	0x23, 0xb8, 0x0c, 0xad, 0x83, 0xf0, 0x9c, 0x1e, 0xa7, 0xc6, 0x9a, 0xb4, 0x48, 0xa7, 0x5a, 0xba,
-	0x69, 0x96, 0x1b, 0x05, 0x3d, 0x32, 0x20, 0xf1, 0xc1, 0xa4, 0xbb, 0xfe, 0x18, 0x4b, 0x77, 0x5b,
-	0xcb, 0x17, 0xef, 0x84, 0xee, 0xdf, 0x41, 0xbe, 0x34, 0x0a, 0xc2, 0x57, 0x42, 0x3b, 0x6d, 0x54,
-	0x78, 0xc6, 0xb7, 0xd5, 0xe3, 0xff, 0xae, 0xf7, 0xe3, 0xdd, 0x60, 0xdb, 0x2a, 0x8a, 0x5f, 0xbe,
-	0x7e, 0x3f, 0xf6, 0xa2, 0x70, 0x20, 0xb6, 0xee, 0xa3, 0x1a, 0xc7, 0xf5, 0xf8, 0xb3, 0x64, 0x64,
-	0x55, 0x32, 0xf2, 0x53, 0x32, 0xf2, 0x56, 0xb1, 0x60, 0x55, 0xb1, 0xe0, 0xbb, 0x62, 0xc1, 0xfd,
-	0x50, 0x1b, 0xff, 0x58, 0x24, 0x5c, 0x61, 0xba, 0x49, 0x69, 0x9f, 0xa1, 0x9b, 0x3d, 0x09, 0x35,
-	0x37, 0x60, 0xbd, 0xd0, 0x79, 0xa6, 0x9a, 0xdc, 0xa4, 0xd3, 0x4c, 0x76, 0xf9, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x46, 0xb4, 0x93, 0x92, 0x01, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0x15, 0xba, 0x3a, 0xab, 0x2e, 0x3b, 0x07, 0xa9, 0x41, 0x42, 0x09, 0x8a, 0xc1,
+	0xba, 0x33, 0xee, 0x0a, 0x9e, 0x3c, 0xb9, 0xe0, 0xea, 0x4d, 0xe2, 0xcd, 0x4b, 0x98, 0xcc, 0x4e,
+	0x93, 0xc1, 0xcd, 0xbc, 0xd9, 0xcc, 0x64, 0x45, 0xc4, 0x8b, 0xe0, 0x5d, 0xf0, 0x03, 0xf8, 0x21,
+	0xfc, 0x12, 0x1e, 0x0b, 0x5e, 0x3c, 0x4a, 0xeb, 0x07, 0x91, 0x66, 0xd2, 0x36, 0x0a, 0xad, 0x9e,
+	0x92, 0x4c, 0x7e, 0xef, 0xff, 0x7f, 0xef, 0xff, 0x06, 0xdf, 0x16, 0x60, 0x4b, 0xb0, 0x2c, 0xe3,
+	0x56, 0x32, 0x0d, 0xa7, 0x92, 0x5d, 0x1c, 0x66, 0xd2, 0xf1, 0x43, 0x76, 0x5e, 0xcb, 0xea, 0x2d,
+	0x35, 0x15, 0x38, 0x20, 0x43, 0x4f, 0xd1, 0x05, 0x45, 0x17, 0x14, 0x6d, 0xa9, 0xe0, 0x56, 0x0e,
+	0x90, 0x9f, 0x49, 0xc6, 0x8d, 0x62, 0x5c, 0x6b, 0x70, 0xdc, 0x29, 0xd0, 0xd6, 0xd7, 0x05, 0xe3,
+	0xae, 0xba, 0x75, 0x50, 0xad, 0xe5, 0x05, 0x94, 0xa5, 0x72, 0xa9, 0xd2, 0x13, 0xf0, 0x70, 0xb4,
+	0x87, 0xaf, 0x1d, 0x83, 0x9e, 0xa8, 0x3c, 0x91, 0xe7, 0xb5, 0xb4, 0x2e, 0x7a, 0x8c, 0xaf, 0x2f,
+	0x0f, 0xac, 0x01, 0x6d, 0x25, 0xb9, 0x87, 0xf7, 0x4b, 0xa5, 0x55, 0x59, 0x97, 0x69, 0xce, 0x6d,
+	0x6a, 0x2a, 0x25, 0xe4, 0x10, 0x8d, 0x50, 0x7c, 0x25, 0xd9, 0x6b, 0x7f, 0x9c, 0x70, 0xfb, 0x62,
+	0x71, 0x1c, 0x8d, 0xf1, 0xfe, 0x71, 0xe3, 0xf1, 0x5c, 0x4f, 0xa0, 0x95, 0x24, 0x37, 0xf0, 0xa0,
+	0x90, 0x2a, 0x2f, 0x5c, 0x53, 0x75, 0x29, 0x69, 0xbf, 0xa2, 0x37, 0x98, 0x74, 0xe1, 0xd6, 0xee,
+	0x29, 0xde, 0xed, 0xb4, 0xd9, 0x94, 0xec, 0x1e, 0xdd, 0xa1, 0xdd, 0x30, 0x9a, 0xa1, 0x96, 0x69,
+	0xd0, 0x8e, 0x06, 0x16, 0xab, 0x77, 0x72, 0x13, 0x5f, 0xe6, 0xc6, 0xa4, 0x05, 0xb7, 0xc5, 0xb0,
+	0x3f, 0x42, 0xf1, 0xd5, 0x64, 0x87, 0x1b, 0xf3, 0x8c, 0xdb, 0xe2, 0xe8, 0x6b, 0x1f, 0xef, 0xbc,
+	0x94, 0xd5, 0x85, 0x12, 0x92, 0x7c, 0x44, 0x78, 0xe0, 0x07, 0x26, 0x77, 0xe9, 0xa6, 0xc4, 0xe9,
+	0x1f, 0x19, 0x05, 0xf1, 0xbf, 0x41, 0x3f, 0x4c, 0x14, 0x7f, 0xf8, 0xfe, 0xeb, 0x73, 0x3f, 0x22,
+	0x23, 0xb6, 0x71, 0xe5, 0xc2, 0x9b, 0x7f, 0x41, 0x18, 0xaf, 0x27, 0x21, 0xe3, 0x6d, 0x16, 0x7f,
+	0x05, 0x1c, 0xdc, 0xff, 0x3f, 0xb8, 0xed, 0xe9, 0x51, 0xd3, 0xd3, 0x03, 0x42, 0xb7, 0xf5, 0xb4,
+	0x5a, 0x00, 0x7b, 0xe7, 0xb7, 0xf5, 0xfe, 0xc9, 0xc9, 0xb7, 0x59, 0x88, 0xa6, 0xb3, 0x10, 0xfd,
+	0x9c, 0x85, 0xe8, 0xd3, 0x3c, 0xec, 0x4d, 0xe7, 0x61, 0xef, 0xc7, 0x3c, 0xec, 0xbd, 0x3a, 0xc8,
+	0x95, 0x2b, 0xea, 0x8c, 0x0a, 0x28, 0x97, 0x9a, 0xfe, 0x71, 0x60, 0x4f, 0x5f, 0x33, 0x71, 0xa6,
+	0xa4, 0x76, 0x2c, 0xaf, 0x8c, 0x68, 0x5c, 0xb2, 0x41, 0x73, 0xf5, 0x1e, 0xfe, 0x1e, 0x00, 0xc1,
+	0x13, 0x49, 0xc3, 0x07, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +267,9 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Config queries for the operator configuration.
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// CommitInfo queries the commit info of the multistore at a retained height,
+	// i.e. the version and hash of each store.
+	CommitInfo(ctx context.Context, in *CommitInfoRequest, opts ...grpc.CallOption) (*CommitInfoResponse, error)
 }
 
 type serviceClient struct {
@@ -174,10 +289,22 @@ func (c *serviceClient) Config(ctx context.Context, in *ConfigRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) CommitInfo(ctx context.Context, in *CommitInfoRequest, opts ...grpc.CallOption) (*CommitInfoResponse, error) {
+	out := new(CommitInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/CommitInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	// CommitInfo queries the commit info of the multistore at a retained height,
+	// i.e. the version and hash of each store.
+	CommitInfo(context.Context, *CommitInfoRequest) (*CommitInfoResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -187,6 +314,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigRequest) (*ConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedServiceServer) CommitInfo(ctx context.Context, req *CommitInfoRequest) (*CommitInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitInfo not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -210,6 +340,24 @@ func _Service_Config_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_CommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CommitInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/CommitInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CommitInfo(ctx, req.(*CommitInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.node.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -218,6 +366,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Service_Config_Handler,
 		},
+		{
+			MethodName: "CommitInfo",
+			Handler:    _Service_CommitInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -276,6 +428,76 @@ func (m *ConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommitInfo != nil {
+		{
+			size, err := m.CommitInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -309,6 +531,35 @@ func (m *ConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *CommitInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CommitInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitInfo != nil {
+		l = m.CommitInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -447,6 +698,195 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitInfo == nil {
+				m.CommitInfo = &types.CommitInfo{}
+			}
+			if err := m.CommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
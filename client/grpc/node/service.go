@@ -5,16 +5,35 @@ import (
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// CommitInfoStore is the multistore serving the CommitInfo query.
+type CommitInfoStore interface {
+	// CommitInfo returns the commit info of the given version, or of the
+	// latest version if it is zero.
+	CommitInfo(version int64) (*storetypes.CommitInfo, error)
+}
+
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
 func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server) {
 	RegisterServiceServer(server, NewQueryServer(clientCtx))
 }
 
+// RegisterNodeServiceWithCommitInfo registers the node gRPC service on the
+// provided gRPC router, serving the CommitInfo query from the given multistore.
+func RegisterNodeServiceWithCommitInfo(clientCtx client.Context, server gogogrpc.Server, store CommitInfoStore) {
+	RegisterServiceServer(server, queryServer{
+		clientCtx: clientCtx,
+		store:     store,
+	})
+}
+
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
 // on the given mux object.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
@@ -25,6 +44,7 @@ var _ ServiceServer = queryServer{}
 
 type queryServer struct {
 	clientCtx client.Context
+	store     CommitInfoStore
 }
 
 func NewQueryServer(clientCtx client.Context) ServiceServer {
@@ -40,3 +60,25 @@ func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigRespo
 		MinimumGasPrice: sdkCtx.MinGasPrices().String(),
 	}, nil
 }
+
+func (s queryServer) CommitInfo(_ context.Context, req *CommitInfoRequest) (*CommitInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.Unimplemented, "commit infos are not served by this node")
+	}
+
+	cInfo, err := s.store.CommitInfo(req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "commit info of height %d: %s", req.Height, err)
+	}
+
+	return &CommitInfoResponse{
+		CommitInfo: cInfo,
+		AppHash:    cInfo.Hash(),
+	}, nil
+}
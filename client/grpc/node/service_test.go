@@ -2,11 +2,15 @@ package node
 
 import (
 	context "context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,3 +26,39 @@ func TestServiceServer_Config(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 }
+
+type commitInfoStore map[int64]*storetypes.CommitInfo
+
+func (s commitInfoStore) CommitInfo(version int64) (*storetypes.CommitInfo, error) {
+	cInfo, ok := s[version]
+	if !ok {
+		return nil, errors.New("no commit info found")
+	}
+	return cInfo, nil
+}
+
+func TestServiceServer_CommitInfo(t *testing.T) {
+	cInfo := &storetypes.CommitInfo{
+		Version: 5,
+		StoreInfos: []storetypes.StoreInfo{
+			{Name: "bank", CommitId: storetypes.CommitID{Version: 5, Hash: []byte("bank hash")}},
+			{Name: "staking", CommitId: storetypes.CommitID{Version: 5, Hash: []byte("staking hash")}},
+		},
+	}
+	svr := queryServer{store: commitInfoStore{5: cInfo}}
+
+	resp, err := svr.CommitInfo(context.Background(), &CommitInfoRequest{Height: 5})
+	require.NoError(t, err)
+	require.Equal(t, cInfo, resp.CommitInfo)
+	require.Equal(t, cInfo.Hash(), resp.AppHash)
+
+	_, err = svr.CommitInfo(context.Background(), &CommitInfoRequest{Height: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svr.CommitInfo(context.Background(), &CommitInfoRequest{Height: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the query is not served without a multistore
+	_, err = NewQueryServer(client.Context{}).CommitInfo(context.Background(), &CommitInfoRequest{Height: 5})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
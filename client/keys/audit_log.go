@@ -0,0 +1,50 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// AuditLogCommand prints the audit log of a keyring using the vault backend.
+func AuditLogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Print the audit log of the keyring vault",
+		Long: `Print the audit log of a keyring using the vault backend, which records when keys are
+created, updated, deleted, used to sign or exported, and when passphrases are changed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := keyring.VaultAuditLog(clientCtx.Keyring)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == OutputFormatJSON {
+				out, err := json.Marshal(entries)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			for _, entry := range entries {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\n", entry.Time.Format(time.RFC3339), entry.Event, entry.Key)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
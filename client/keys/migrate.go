@@ -18,11 +18,13 @@ import (
 // is not needed for importing into the Keyring keystore.
 const migratePassphrase = "NOOP_PASSPHRASE"
 
+const flagFromBackend = "from-backend"
+
 // MigrateCommand migrates key information from legacy keybase to OS secret store.
 func MigrateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate <old_home_dir>",
-		Short: "Migrate keys from the legacy (db-based) Keybase",
+		Short: "Migrate keys from the legacy (db-based) Keybase or from another keyring backend",
 		Long: `Migrate key information from the legacy (db-based) Keybase to the new keyring-based Keyring.
 The legacy Keybase used to persist keys in a LevelDB database stored in a 'keys' sub-directory of
 the old client application's home directory, e.g. $HOME/.gaiacli/keys/.
@@ -30,6 +32,11 @@ For each key material entry, the command will prompt if the key should be skippe
 is not to be skipped, the passphrase must be entered. The key will only be migrated if the passphrase
 is correct. Otherwise, the command will exit and migration must be repeated.
 
+If the --from-backend flag is set, keys are migrated instead from the keyring of the given backend
+in the old home directory, e.g. from the file backend to the vault backend:
+
+$ <appd> keys migrate $HOME/.appd --from-backend file --keyring-backend vault
+
 It is recommended to run in 'dry-run' mode first to verify all key migration material.
 `,
 		Args: cobra.ExactArgs(1),
@@ -37,11 +44,16 @@ It is recommended to run in 'dry-run' mode first to verify all key migration mat
 	}
 
 	cmd.Flags().Bool(flags.FlagDryRun, false, "Run migration without actually persisting any changes to the new Keybase")
+	cmd.Flags().String(flagFromBackend, "", "Migrate keys from the keyring of the given backend (os|file|kwallet|pass|test) instead of the legacy Keybase")
 	return cmd
 }
 
 func runMigrateCmd(cmd *cobra.Command, args []string) error {
-	rootDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	buf := bufio.NewReader(cmd.InOrStdin())
+
+	if fromBackend, _ := cmd.Flags().GetString(flagFromBackend); fromBackend != "" {
+		return runMigrateBackendCmd(cmd, args[0], fromBackend, buf)
+	}
 
 	// instantiate legacy keybase
 	var legacyKb keyring.LegacyKeybase
@@ -58,34 +70,11 @@ func runMigrateCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	buf := bufio.NewReader(cmd.InOrStdin())
-	keyringServiceName := sdk.KeyringServiceName()
-
-	var (
-		tmpDir   string
-		migrator keyring.Importer
-	)
-
-	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun {
-		tmpDir, err = os.MkdirTemp("", "migrator-migrate-dryrun")
-		if err != nil {
-			return errors.Wrap(err, "failed to create temporary directory for dryrun migration")
-		}
-
-		defer func() { _ = os.RemoveAll(tmpDir) }()
-
-		migrator, err = keyring.New(keyringServiceName, keyring.BackendTest, tmpDir, buf)
-	} else {
-		backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
-		migrator, err = keyring.New(keyringServiceName, backend, rootDir, buf)
-	}
-
+	migrator, cleanup, err := newMigrator(cmd, buf)
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf(
-			"failed to initialize keybase for service %s at directory %s",
-			keyringServiceName, rootDir,
-		))
+		return err
 	}
+	defer cleanup()
 
 	if len(oldKeys) == 0 {
 		cmd.PrintErrln("Migration Aborted: no keys to migrate")
@@ -144,3 +133,90 @@ func runMigrateCmd(cmd *cobra.Command, args []string) error {
 
 	return err
 }
+
+// runMigrateBackendCmd migrates the keys of the keyring of the given backend in
+// oldRootDir to the keyring of the --keyring-backend backend.
+func runMigrateBackendCmd(cmd *cobra.Command, oldRootDir, fromBackend string, buf *bufio.Reader) error {
+	keyringServiceName := sdk.KeyringServiceName()
+
+	oldKr, err := keyring.New(keyringServiceName, fromBackend, oldRootDir, buf)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf(
+			"failed to initialize keyring for service %s at directory %s",
+			keyringServiceName, oldRootDir,
+		))
+	}
+
+	oldKeys, err := oldKr.List()
+	if err != nil {
+		return err
+	}
+
+	if len(oldKeys) == 0 {
+		cmd.PrintErrln("Migration Aborted: no keys to migrate")
+		return nil
+	}
+
+	migrator, cleanup, err := newMigrator(cmd, buf)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	infoImporter, ok := migrator.(keyring.LegacyInfoImporter)
+	if !ok {
+		return fmt.Errorf("the Keyring implementation does not support import operations of Info types")
+	}
+
+	for _, oldInfo := range oldKeys {
+		cmd.PrintErrf("Migrating key: '%s (%s)' ...\n", oldInfo.GetName(), oldInfo.GetType())
+
+		// allow user to skip migrating specific keys
+		ok, err := input.GetConfirmation("Skip key migration?", buf, cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		if ok {
+			continue
+		}
+
+		if err := infoImporter.ImportInfo(oldInfo); err != nil {
+			return err
+		}
+	}
+	cmd.PrintErrln("Migration complete.")
+
+	return nil
+}
+
+// newMigrator returns the keyring keys are migrated to, which is a temporary
+// one removed by cleanup in dry-run mode.
+func newMigrator(cmd *cobra.Command, buf *bufio.Reader) (migrator keyring.Importer, cleanup func(), err error) {
+	rootDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	keyringServiceName := sdk.KeyringServiceName()
+	cleanup = func() {}
+
+	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun {
+		tmpDir, err := os.MkdirTemp("", "migrator-migrate-dryrun")
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to create temporary directory for dryrun migration")
+		}
+
+		cleanup = func() { _ = os.RemoveAll(tmpDir) }
+
+		migrator, err = keyring.New(keyringServiceName, keyring.BackendTest, tmpDir, buf)
+	} else {
+		backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+		migrator, err = keyring.New(keyringServiceName, backend, rootDir, buf)
+	}
+
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.Wrap(err, fmt.Sprintf(
+			"failed to initialize keybase for service %s at directory %s",
+			keyringServiceName, rootDir,
+		))
+	}
+
+	return migrator, cleanup, nil
+}
@@ -3,6 +3,7 @@ package keys
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,8 +14,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func Test_runMigrateCmd(t *testing.T) {
@@ -40,3 +43,41 @@ func Test_runMigrateCmd(t *testing.T) {
 	t.Log(mockOut.String())
 	assert.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func Test_runMigrateCmdFromBackend(t *testing.T) {
+	oldHome := t.TempDir()
+	kbHome := t.TempDir()
+	clientCtx := client.Context{}.WithKeyringDir(kbHome)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	oldKb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, oldHome, nil)
+	require.NoError(t, err)
+	info, _, err := oldKb.NewMnemonic("foo", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = oldKb.NewMnemonic("bar", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	cmd := MigrateCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn, _ := testutil.ApplyMockIO(cmd)
+
+	cmd.SetArgs([]string{
+		oldHome,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flagFromBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendVault),
+	})
+
+	// skip bar, migrate foo into a new vault
+	mockIn.Reset("y\nn\npassword1\npassword1\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendVault, kbHome, strings.NewReader("password1\n"))
+	require.NoError(t, err)
+	keys, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, info.GetPubKey(), keys[0].GetPubKey())
+	_, _, err = kb.Sign("foo", []byte("message"))
+	require.NoError(t, err)
+}
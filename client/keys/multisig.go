@@ -0,0 +1,414 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const flagBroadcast = "broadcast"
+
+// MultisigCommands returns the commands coordinating the signatures of the
+// members of a multisig key.
+func MultisigCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Collect the signatures of a multisig key in a session file",
+		Long: `Coordinate the signatures of the members of a multisig key for a transaction
+generated with the --generate-only flag.
+
+A session file is created for the multisig key and the transaction, and passed around the
+members of the key, which add their signature to it with the sign command, or with the
+add-signature command for signatures generated by 'tx sign --multisig'. Once enough members
+have signed, the session is finalized into the signed transaction, which may be broadcast.
+
+Members of the multisig key may be multisig keys themselves. Their signature is then collected
+from their own members in the same session, or added as a whole with the add-signature command.
+
+Example:
+$ <appd> keys multisig create mymultisig tx.json session.json --chain-id mychain
+$ <appd> keys multisig sign session.json alice
+$ <appd> keys multisig sign session.json bob
+$ <appd> keys multisig status session.json
+$ <appd> keys multisig finalize session.json --broadcast
+`,
+	}
+
+	cmd.AddCommand(
+		MultisigCreateCommand(),
+		MultisigSignCommand(),
+		MultisigAddSignatureCommand(),
+		MultisigStatusCommand(),
+		MultisigFinalizeCommand(),
+	)
+
+	return cmd
+}
+
+// MultisigCreateCommand creates a multisig session file.
+func MultisigCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [name] [tx-file] [session-file]",
+		Short: "Create a session collecting the signatures of a multisig key for a transaction",
+		Long: `Create a session file collecting the signatures of the members of the multisig key
+[name] for the unsigned transaction read from [tx-file].
+
+The account number and sequence of the multisig account are queried from the node unless the
+--offline flag is set, in which case they must be set with the --account-number and --sequence flags.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+			}
+
+			info, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			multisigPk, ok := info.GetPubKey().(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[0])
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			stdTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+			if err != nil {
+				return err
+			}
+
+			if err := checkMultisigSigner(stdTx, info.GetAddress()); err != nil {
+				return err
+			}
+
+			accNum, _ := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+			seq, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+			if !clientCtx.Offline {
+				accNum, seq, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, info.GetAddress())
+				if err != nil {
+					return err
+				}
+			}
+
+			session := &multisigSession{
+				pubKey: multisigPk,
+				signerData: authsigning.SignerData{
+					ChainID:       clientCtx.ChainID,
+					AccountNumber: accNum,
+					Sequence:      seq,
+				},
+				tx: stdTx,
+			}
+
+			return session.write(clientCtx, args[2])
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().Uint64P(flags.FlagAccountNumber, "a", 0, "The account number of the multisig account (offline mode only)")
+	cmd.Flags().Uint64P(flags.FlagSequence, "s", 0, "The sequence number of the multisig account (offline mode only)")
+	cmd.Flags().Bool(flags.FlagOffline, false, "Offline mode (does not allow any online functionality)")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+
+	return cmd
+}
+
+// MultisigSignCommand signs a multisig session with a key of the keyring.
+func MultisigSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session-file] [name]",
+		Short: "Sign the transaction of a multisig session with a member key",
+		Long: `Sign the transaction of the multisig session read from [session-file] with the key [name],
+which must be a member of the multisig key or of one of its nested multisig keys, and add the
+signature to the session file.
+
+The transaction is signed in the amino-json sign mode, the only one supported by multisig keys.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			info, err := clientCtx.Keyring.Key(args[1])
+			if err != nil {
+				return err
+			}
+			if info.GetType() == keyring.TypeMulti {
+				return fmt.Errorf("%s is a multisig key, sign with the keys of its members instead", args[1])
+			}
+			if !isMultisigMember(session.pubKey, info.GetPubKey()) {
+				return fmt.Errorf("%s is not a member of multisig %s", args[1], sdk.AccAddress(session.pubKey.Address()))
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(session.tx)
+			if err != nil {
+				return err
+			}
+
+			txFactory := tx.Factory{}.
+				WithTxConfig(clientCtx.TxConfig).
+				WithKeybase(clientCtx.Keyring).
+				WithChainID(session.signerData.ChainID).
+				WithAccountNumber(session.signerData.AccountNumber).
+				WithSequence(session.signerData.Sequence).
+				WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			if err := tx.Sign(txFactory, args[1], txBuilder, true); err != nil {
+				return err
+			}
+
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			if err := session.addSignature(clientCtx, sigs[0]); err != nil {
+				return err
+			}
+
+			return session.write(clientCtx, args[0])
+		},
+	}
+
+	return cmd
+}
+
+// MultisigAddSignatureCommand adds signatures from files to a multisig session.
+func MultisigAddSignatureCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-signature [session-file] [signature-file]...",
+		Short: "Add the signatures of members to a multisig session",
+		Long: `Add the signatures read from the [signature-file] files, e.g. generated by
+'tx sign --multisig --signature-only', to the multisig session read from [session-file].
+
+Signatures must be made by members of the multisig key or of one of its nested multisig keys,
+or by nested multisig keys themselves, and are verified before being added.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, path := range args[1:] {
+				bz, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+
+				sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+				if err != nil {
+					return err
+				}
+
+				for _, sig := range sigs {
+					if err := session.addSignature(clientCtx, sig); err != nil {
+						return err
+					}
+				}
+			}
+
+			return session.write(clientCtx, args[0])
+		},
+	}
+
+	return cmd
+}
+
+// MultisigStatusCommand shows which members of a multisig key have signed.
+func MultisigStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show which members of the multisig key have signed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			status := session.memberStatus(clientCtx, session.pubKey)
+			if clientCtx.OutputFormat == OutputFormatJSON {
+				out, err := json.Marshal(status)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			printMultisigStatus(cmd.OutOrStdout(), status, 0)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// MultisigFinalizeCommand assembles the signature of the multisig key of a
+// session and outputs or broadcasts the signed transaction.
+func MultisigFinalizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Assemble the multisig signature and output or broadcast the signed transaction",
+		Long: `Assemble the signature of the multisig key from the signatures collected in the session
+read from [session-file], once its threshold is met, and print the signed transaction.
+
+If the --broadcast flag is set, the signed transaction is broadcast to the node instead.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			sig := session.signature()
+			if sig == nil {
+				status := session.memberStatus(clientCtx, session.pubKey)
+				return fmt.Errorf("threshold of multisig %s not met: %d/%d signatures", status.Address, status.Signatures, status.Threshold)
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(session.tx)
+			if err != nil {
+				return err
+			}
+			if err := txBuilder.SetSignatures(*sig); err != nil {
+				return err
+			}
+
+			err = authsigning.VerifySignature(sig.PubKey, session.signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			if broadcast, _ := cmd.Flags().GetBool(flagBroadcast); broadcast {
+				txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				res, err := clientCtx.BroadcastTx(txBytes)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				return clientCtx.PrintBytes(bz)
+			}
+
+			fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err2 := fp.Close()
+				if err == nil {
+					err = err2
+				}
+			}()
+
+			_, err = fmt.Fprintf(fp, "%s\n", bz)
+			return err
+		},
+	}
+
+	cmd.Flags().Bool(flagBroadcast, false, "Broadcast the signed transaction instead of printing it")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	cmd.Flags().StringP(flags.FlagBroadcastMode, "b", flags.BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+
+	return cmd
+}
+
+// checkMultisigSigner returns an error if the multisig account is not a signer
+// of the transaction.
+func checkMultisigSigner(stdTx sdk.Tx, addr sdk.AccAddress) error {
+	sigTx, ok := stdTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return fmt.Errorf("expected a SigVerifiableTx, got %T", stdTx)
+	}
+
+	for _, signer := range sigTx.GetSigners() {
+		if signer.Equals(addr) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("multisig %s is not a signer of the transaction", addr)
+}
+
+// printMultisigStatus prints the signing status of a multisig key as a tree
+// of its members.
+func printMultisigStatus(w io.Writer, status multisigMemberStatus, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	mark := "[ ]"
+	if status.Signed {
+		mark = "[x]"
+	}
+
+	line := status.Address
+	if status.Name != "" {
+		line = fmt.Sprintf("%s (%s)", line, status.Name)
+	}
+	if status.Threshold != 0 {
+		line = fmt.Sprintf("%s: %d/%d signatures", line, status.Signatures, status.Threshold)
+	}
+
+	fmt.Fprintf(w, "%s%s %s\n", indent, mark, line)
+	for _, member := range status.Members {
+		printMultisigStatus(w, member, depth+1)
+	}
+}
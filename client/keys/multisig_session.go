@@ -0,0 +1,248 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// multisigSessionFile is the JSON encoding of a multisig signing session.
+type multisigSessionFile struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	PubKey        json.RawMessage `json:"pub_key"`
+	Tx            json.RawMessage `json:"tx"`
+	Signatures    json.RawMessage `json:"signatures,omitempty"`
+}
+
+// multisigSession collects the signatures of the members of a multisig key for
+// a transaction until the threshold of the key is met. Members may be multisig
+// keys themselves, whose signatures are either collected from their own members
+// or added as a whole.
+type multisigSession struct {
+	pubKey     *kmultisig.LegacyAminoPubKey
+	signerData authsigning.SignerData
+	tx         sdk.Tx
+	sigs       []signing.SignatureV2
+}
+
+// readMultisigSession reads the multisig session stored in the given file.
+func readMultisigSession(clientCtx client.Context, path string) (*multisigSession, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file multisigSessionFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("invalid multisig session file %s: %w", path, err)
+	}
+
+	var pk cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(file.PubKey, &pk); err != nil {
+		return nil, err
+	}
+	multisigPk, ok := pk.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid multisig session file %s: %T is not a multisig public key", path, pk)
+	}
+
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(file.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []signing.SignatureV2
+	if len(file.Signatures) != 0 {
+		sigs, err = clientCtx.TxConfig.UnmarshalSignatureJSON(file.Signatures)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &multisigSession{
+		pubKey: multisigPk,
+		signerData: authsigning.SignerData{
+			ChainID:       file.ChainID,
+			AccountNumber: file.AccountNumber,
+			Sequence:      file.Sequence,
+		},
+		tx:   tx,
+		sigs: sigs,
+	}, nil
+}
+
+// write stores the session in the given file.
+func (s *multisigSession) write(clientCtx client.Context, path string) error {
+	pk, err := clientCtx.Codec.MarshalInterfaceJSON(s.pubKey)
+	if err != nil {
+		return err
+	}
+
+	tx, err := clientCtx.TxConfig.TxJSONEncoder()(s.tx)
+	if err != nil {
+		return err
+	}
+
+	file := multisigSessionFile{
+		ChainID:       s.signerData.ChainID,
+		AccountNumber: s.signerData.AccountNumber,
+		Sequence:      s.signerData.Sequence,
+		PubKey:        pk,
+		Tx:            tx,
+	}
+	if len(s.sigs) != 0 {
+		file.Signatures, err = clientCtx.TxConfig.MarshalSignatureJSON(s.sigs)
+		if err != nil {
+			return err
+		}
+	}
+
+	bz, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(bz, '\n'), 0o644)
+}
+
+// addSignature verifies the signature of a member of the multisig key and adds
+// it to the session, replacing a previous signature of the same member.
+func (s *multisigSession) addSignature(clientCtx client.Context, sig signing.SignatureV2) error {
+	if !isMultisigMember(s.pubKey, sig.PubKey) {
+		return fmt.Errorf("%s is not a member of multisig %s", sdk.AccAddress(sig.PubKey.Address()), sdk.AccAddress(s.pubKey.Address()))
+	}
+
+	if sig.Sequence != s.signerData.Sequence {
+		return fmt.Errorf("signature of %s has sequence %d, expected %d", sdk.AccAddress(sig.PubKey.Address()), sig.Sequence, s.signerData.Sequence)
+	}
+
+	err := authsigning.VerifySignature(sig.PubKey, s.signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), s.tx)
+	if err != nil {
+		return fmt.Errorf("couldn't verify signature for address %s: %w", sdk.AccAddress(sig.PubKey.Address()), err)
+	}
+
+	for i, prev := range s.sigs {
+		if prev.PubKey.Equals(sig.PubKey) {
+			s.sigs[i] = sig
+			return nil
+		}
+	}
+	s.sigs = append(s.sigs, sig)
+
+	return nil
+}
+
+// signature returns the signature of the multisig key, which is nil if its
+// threshold is not met yet.
+func (s *multisigSession) signature() *signing.SignatureV2 {
+	data := multisigSignatureData(s.pubKey, s.sigs)
+	if data == nil {
+		return nil
+	}
+
+	return &signing.SignatureV2{
+		PubKey:   s.pubKey,
+		Data:     data,
+		Sequence: s.signerData.Sequence,
+	}
+}
+
+// isMultisigMember returns whether pk is a member of the multisig key or of one
+// of its nested multisig keys.
+func isMultisigMember(multisigPk *kmultisig.LegacyAminoPubKey, pk cryptotypes.PubKey) bool {
+	for _, member := range multisigPk.GetPubKeys() {
+		if member.Equals(pk) {
+			return true
+		}
+		if nested, ok := member.(*kmultisig.LegacyAminoPubKey); ok && isMultisigMember(nested, pk) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// memberSignatureData returns the signature of a member of a multisig key,
+// which is assembled from the signatures of its own members for a nested
+// multisig key, or nil if the member has not signed.
+func memberSignatureData(member cryptotypes.PubKey, sigs []signing.SignatureV2) signing.SignatureData {
+	for _, sig := range sigs {
+		if sig.PubKey.Equals(member) {
+			return sig.Data
+		}
+	}
+
+	if nested, ok := member.(*kmultisig.LegacyAminoPubKey); ok {
+		if data := multisigSignatureData(nested, sigs); data != nil {
+			return data
+		}
+	}
+
+	return nil
+}
+
+// multisigSignatureData assembles the signature of a multisig key from the
+// signatures of its members. It returns nil if the threshold is not met.
+func multisigSignatureData(multisigPk *kmultisig.LegacyAminoPubKey, sigs []signing.SignatureV2) *signing.MultiSignatureData {
+	members := multisigPk.GetPubKeys()
+	mSig := multisig.NewMultisig(len(members))
+
+	for i, member := range members {
+		if data := memberSignatureData(member, sigs); data != nil {
+			multisig.AddSignature(mSig, data, i)
+		}
+	}
+
+	if len(mSig.Signatures) < int(multisigPk.Threshold) {
+		return nil
+	}
+
+	return mSig
+}
+
+// multisigMemberStatus is the signing status of a member of a multisig key.
+type multisigMemberStatus struct {
+	Address    string                 `json:"address"`
+	Name       string                 `json:"name,omitempty"`
+	Signed     bool                   `json:"signed"`
+	Threshold  uint32                 `json:"threshold,omitempty"`
+	Signatures int                    `json:"signatures,omitempty"`
+	Members    []multisigMemberStatus `json:"members,omitempty"`
+}
+
+// memberStatus returns the signing status of a member of the multisig key,
+// which may be the multisig key itself.
+func (s *multisigSession) memberStatus(clientCtx client.Context, member cryptotypes.PubKey) multisigMemberStatus {
+	status := multisigMemberStatus{
+		Address: sdk.AccAddress(member.Address()).String(),
+		Signed:  memberSignatureData(member, s.sigs) != nil,
+	}
+	if clientCtx.Keyring != nil {
+		if info, err := clientCtx.Keyring.KeyByAddress(sdk.AccAddress(member.Address())); err == nil {
+			status.Name = info.GetName()
+		}
+	}
+
+	if nested, ok := member.(*kmultisig.LegacyAminoPubKey); ok {
+		status.Threshold = nested.Threshold
+		for _, pk := range nested.GetPubKeys() {
+			memberStatus := s.memberStatus(clientCtx, pk)
+			if memberStatus.Signed {
+				status.Signatures++
+			}
+			status.Members = append(status.Members, memberStatus)
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,184 @@
+package keys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func execMultisigCmd(ctx context.Context, args ...string) (string, error) {
+	cmd := MultisigCommands()
+	cmd.PersistentFlags().AddFlagSet(Commands("home").PersistentFlags())
+	_, out := testutil.ApplyMockIO(cmd)
+	cmd.SetArgs(args)
+
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestMultisigCommands(t *testing.T) {
+	dir := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, dir, nil)
+	require.NoError(t, err)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithLegacyAmino(encCfg.Amino).
+		WithTxConfig(encCfg.TxConfig).
+		WithKeyringDir(dir).
+		WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	pks := make(map[string]cryptotypes.PubKey)
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		info, _, err := kb.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pks[name] = info.GetPubKey()
+	}
+
+	// alice and the nested multisig of bob and carol must both sign
+	nestedPk := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pks["bob"], pks["carol"]})
+	_, err = kb.SaveMultisig("nested", nestedPk)
+	require.NoError(t, err)
+	multisigPk := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pks["alice"], nestedPk})
+	multisigInfo, err := kb.SaveMultisig("multi", multisigPk)
+	require.NoError(t, err)
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(multisigInfo.GetAddress(), sdk.AccAddress(pks["dave"].Address()), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetGasLimit(200000)
+	txJSON, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	txFile := filepath.Join(dir, "tx.json")
+	require.NoError(t, os.WriteFile(txFile, txJSON, 0o644))
+
+	session := filepath.Join(dir, "session.json")
+	_, err = execMultisigCmd(ctx, "create", "alice", txFile, session, "--offline", "--chain-id=test-chain")
+	require.EqualError(t, err, "alice is not a multisig key")
+	_, err = execMultisigCmd(ctx, "create", "nested", txFile, session, "--offline", "--chain-id=test-chain")
+	require.Error(t, err)
+	_, err = execMultisigCmd(ctx, "create", "multi", txFile, session, "--offline", "--chain-id=test-chain",
+		fmt.Sprintf("--%s=1", flags.FlagAccountNumber), fmt.Sprintf("--%s=2", flags.FlagSequence))
+	require.NoError(t, err)
+
+	status := func() multisigMemberStatus {
+		out, err := execMultisigCmd(ctx, "status", session, fmt.Sprintf("--%s=json", cli.OutputFlag))
+		require.NoError(t, err)
+		var status multisigMemberStatus
+		require.NoError(t, json.Unmarshal([]byte(out), &status))
+		return status
+	}
+	require.Equal(t, multisigMemberStatus{
+		Address:   multisigInfo.GetAddress().String(),
+		Name:      "multi",
+		Threshold: 2,
+		Members: []multisigMemberStatus{
+			{Address: sdk.AccAddress(pks["alice"].Address()).String(), Name: "alice"},
+			{
+				Address:   sdk.AccAddress(nestedPk.Address()).String(),
+				Name:      "nested",
+				Threshold: 2,
+				Members: []multisigMemberStatus{
+					{Address: sdk.AccAddress(pks["bob"].Address()).String(), Name: "bob"},
+					{Address: sdk.AccAddress(pks["carol"].Address()).String(), Name: "carol"},
+				},
+			},
+		},
+	}, status())
+
+	// only leaf members sign
+	_, err = execMultisigCmd(ctx, "sign", session, "dave")
+	require.Error(t, err)
+	_, err = execMultisigCmd(ctx, "sign", session, "nested")
+	require.Error(t, err)
+
+	_, err = execMultisigCmd(ctx, "sign", session, "alice")
+	require.NoError(t, err)
+	_, err = execMultisigCmd(ctx, "sign", session, "bob")
+	require.NoError(t, err)
+
+	s := status()
+	require.False(t, s.Signed)
+	require.Equal(t, 1, s.Signatures)
+	require.True(t, s.Members[0].Signed)
+	require.False(t, s.Members[1].Signed)
+	require.Equal(t, 1, s.Members[1].Signatures)
+
+	out, err := execMultisigCmd(ctx, "status", session)
+	require.NoError(t, err)
+	require.Contains(t, out, "[ ] "+multisigInfo.GetAddress().String()+" (multi): 1/2 signatures\n")
+	require.Contains(t, out, "  [x] "+sdk.AccAddress(pks["alice"].Address()).String()+" (alice)\n")
+	require.Contains(t, out, "    [ ] "+sdk.AccAddress(pks["carol"].Address()).String()+" (carol)\n")
+
+	signed := filepath.Join(dir, "signed.json")
+	_, err = execMultisigCmd(ctx, "finalize", session, fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, signed))
+	require.EqualError(t, err, fmt.Sprintf("threshold of multisig %s not met: 1/2 signatures", multisigInfo.GetAddress()))
+
+	// carol signs offline, with the wrong sequence first
+	sigFile := filepath.Join(dir, "carol.json")
+	signOffline := func(sequence uint64) {
+		txBuilder, err := encCfg.TxConfig.WrapTxBuilder(txBuilder.GetTx())
+		require.NoError(t, err)
+		txFactory := tx.Factory{}.
+			WithTxConfig(encCfg.TxConfig).
+			WithKeybase(kb).
+			WithChainID("test-chain").
+			WithAccountNumber(1).
+			WithSequence(sequence).
+			WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		require.NoError(t, tx.Sign(txFactory, "carol", txBuilder, true))
+		sigs, err := txBuilder.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		bz, err := encCfg.TxConfig.MarshalSignatureJSON(sigs)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(sigFile, bz, 0o644))
+	}
+	signOffline(3)
+	_, err = execMultisigCmd(ctx, "add-signature", session, sigFile)
+	require.Error(t, err)
+	signOffline(2)
+	_, err = execMultisigCmd(ctx, "add-signature", session, sigFile)
+	require.NoError(t, err)
+
+	s = status()
+	require.True(t, s.Signed)
+	require.Equal(t, 2, s.Signatures)
+	require.Equal(t, 2, s.Members[1].Signatures)
+
+	_, err = execMultisigCmd(ctx, "finalize", session, fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, signed))
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(signed)
+	require.NoError(t, err)
+	signedTx, err := encCfg.TxConfig.TxJSONDecoder()(bz)
+	require.NoError(t, err)
+	sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, multisigPk.Equals(sigs[0].PubKey))
+	require.Equal(t, uint64(2), sigs[0].Sequence)
+
+	signerData := authsigning.SignerData{ChainID: "test-chain", AccountNumber: 1, Sequence: 2}
+	require.NoError(t, authsigning.VerifySignature(sigs[0].PubKey, signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), signedTx))
+}
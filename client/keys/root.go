@@ -27,6 +27,11 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    vault       Stores keys in a single file within the app's configuration directory, encrypted
+                with a key derived from a passphrase with Argon2id. The passphrase is requested
+                once per command. Keys may be protected by their own passphrase in addition, and
+                key use is recorded in an audit log. See the rotate-passphrase and audit-log
+                commands.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -47,11 +52,14 @@ The pass backend requires GnuPG: https://gnupg.org/
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		RotatePassphraseCommand(),
+		AuditLogCommand(),
+		MultisigCommands(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|vault)")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
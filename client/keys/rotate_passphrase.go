@@ -0,0 +1,56 @@
+package keys
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const flagRemove = "remove"
+
+// RotatePassphraseCommand changes the passphrase of a keyring using the vault
+// backend, or the passphrase of one of its keys.
+func RotatePassphraseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-passphrase [name]",
+		Short: "Change the passphrase of the keyring vault or of one of its keys",
+		Long: `Change the passphrase of a keyring using the vault backend. The vault is re-encrypted
+with a key derived from the new passphrase.
+
+If a key name is given, the private key of that key is protected by its own passphrase instead,
+which is asked for each time the key is used in addition to the vault passphrase. Use the --remove
+flag to protect the key by the vault passphrase only.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				if err := keyring.RotateVaultPassphrase(clientCtx.Keyring); err != nil {
+					return err
+				}
+				cmd.PrintErrln("Keyring vault passphrase changed")
+				return nil
+			}
+
+			remove, _ := cmd.Flags().GetBool(flagRemove)
+			if err := keyring.RotateKeyPassphrase(clientCtx.Keyring, args[0], remove); err != nil {
+				return err
+			}
+			if remove {
+				cmd.PrintErrf("Passphrase of key %s removed\n", args[0])
+				return nil
+			}
+			cmd.PrintErrf("Passphrase of key %s changed\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagRemove, false, "Remove the passphrase of the given key")
+
+	return cmd
+}
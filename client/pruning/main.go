@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -34,10 +35,13 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 		default: the last 362880 states are kept
 		nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 		everything: 2 latest states will be kept
-		custom: allow pruning options to be manually specified through 'pruning-keep-recent'.
+		custom: allow pruning options to be manually specified through 'pruning-keep-recent' and 'pruning-keep-every'.
 		besides pruning options, database home directory and database backend type should also be specified via flags
 		'--home' and '--app-db-backend'.
 		valid app-db-backend type includes 'goleveldb', 'cleveldb', 'rocksdb', 'boltdb', and 'badgerdb'.
+
+		The pruning options of the stores set under the 'pruning-stores' section of the app.toml file of the home
+		directory override the ones above for these stores.
 		`,
 		Example: `prune --home './' --app-db-backend 'goleveldb' --pruning 'custom' --pruning-keep-recent 100 --
 		pruning-keep-every 10, --pruning-interval 10`,
@@ -52,11 +56,24 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fmt.Printf("get pruning options from command flags, keep-recent: %v\n",
-				pruningOptions.KeepRecent,
+			fmt.Printf("get pruning options from command flags, keep-recent: %v, keep-every: %v\n",
+				pruningOptions.KeepRecent, pruningOptions.KeepEvery,
 			)
 
 			home := vp.GetString(flags.FlagHome)
+
+			// the app reads the pruning options of the stores from the app configuration
+			storePruningOptions, err := readStorePruningOptions(vp, home)
+			if err != nil {
+				return err
+			}
+			for _, storeName := range sortedStoreNames(storePruningOptions) {
+				opts := storePruningOptions[storeName]
+				fmt.Printf("get pruning options of store %s from app config, keep-recent: %v, keep-every: %v\n",
+					storeName, opts.KeepRecent, opts.KeepEvery,
+				)
+			}
+
 			db, err := openDB(home)
 			if err != nil {
 				return err
@@ -76,9 +93,10 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				return fmt.Errorf("the database has no valid heights to prune, the latest height: %v", latestHeight)
 			}
 
+			// each store only prunes the heights its pruning options do not retain
 			var pruningHeights []int64
 			for height := int64(1); height < latestHeight; height++ {
-				if height < latestHeight-int64(pruningOptions.KeepRecent) {
+				if !pruningOptions.Retains(height, latestHeight) || storesPrune(storePruningOptions, height, latestHeight) {
 					pruningHeights = append(pruningHeights, height)
 				}
 			}
@@ -106,8 +124,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd.Flags().String(server.FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(server.FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(server.FlagPruningKeepEvery, 0,
-		`Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom'),
-		this is not used by this command but kept for compatibility with the complete pruning options`)
+		`Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')`)
 	cmd.Flags().Uint64(server.FlagPruningInterval, 10,
 		`Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom'), 
 		this is not used by this command but kept for compatibility with the complete pruning options`)
@@ -115,6 +132,43 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	return cmd
 }
 
+// readStorePruningOptions sets the pruning-stores section of the app.toml file
+// of the home directory, if any, on the given viper and returns the pruning
+// options of the stores it defines.
+func readStorePruningOptions(vp *viper.Viper, home string) (map[string]storetypes.PruningOptions, error) {
+	appConfigPath := filepath.Join(home, "config", "app.toml")
+	if _, err := os.Stat(appConfigPath); err == nil {
+		appConfig := viper.New()
+		appConfig.SetConfigFile(appConfigPath)
+		if err := appConfig.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", appConfigPath, err)
+		}
+		vp.Set(server.FlagPruningStores, appConfig.Get(server.FlagPruningStores))
+	}
+
+	return server.GetStorePruningOptionsFromFlags(vp)
+}
+
+// storesPrune returns true if the pruning options of any of the stores do not
+// retain the given height.
+func storesPrune(storePruningOptions map[string]storetypes.PruningOptions, height, latestHeight int64) bool {
+	for _, opts := range storePruningOptions {
+		if !opts.Retains(height, latestHeight) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedStoreNames(storePruningOptions map[string]storetypes.PruningOptions) []string {
+	names := make([]string, 0, len(storePruningOptions))
+	for name := range storePruningOptions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func openDB(rootDir string) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return sdk.NewLevelDB("application", dataDir)
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+)
+
+// CommitInfoCommand returns the commit info of the multistore at a given height,
+// i.e. the version and hash of each store.
+func CommitInfoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-info [height]",
+		Short: "Get the version and hash of each store of the application at the given height",
+		Long: `Get the commit info of the application at the given height, i.e. the name, version and
+hash of each store, as well as the app hash they make up. Defaults to the latest height.
+Comparing the commit infos of two nodes tells which store diverged on an app hash mismatch.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// optional height
+			var height int64
+			if len(args) > 0 {
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := node.NewServiceClient(clientCtx).CommitInfo(context.Background(), &node.CommitInfoRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// DeleteSnapshotCmd deletes a local snapshot.
+func DeleteSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <height>",
+		Short:   "Delete a local snapshot",
+		Example: "delete 1000 --home ./",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			format, _ := cmd.Flags().GetUint32(flagFormat)
+
+			store, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := store.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("no snapshot for height %d format %d", height, format)
+			}
+
+			return store.Delete(height, format)
+		},
+	}
+
+	addFlags(cmd, defaultNodeHome)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// ExportSnapshotCmd writes a local snapshot to an archive file, taking the
+// snapshot from the application state first if it does not exist.
+func ExportSnapshotCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <height>",
+		Short: "Export a snapshot to an archive file",
+		Long: fmt.Sprintf(`Export the local snapshot of the given height to a zstd compressed tar archive, written to
+--%s, which defaults to <height>-<format>.tar.zst. If there is no such snapshot, it is taken from the
+application state of the stopped node in --home first, which must still hold the height, and kept
+in the local snapshots.`, flagOut),
+		Example: "export 1000 --home ./ --out snap.tar.zst",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			format, _ := cmd.Flags().GetUint32(flagFormat)
+			out, _ := cmd.Flags().GetString(flagOut)
+			if out == "" {
+				out = fmt.Sprintf("%d-%d.tar.zst", height, format)
+			}
+
+			_, manager, db, err := newApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			snapshot, err := manager.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				if format != snapshottypes.CurrentFormat {
+					return fmt.Errorf("no snapshot for height %d format %d", height, format)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "taking the snapshot of height %d\n", height)
+				if snapshot, err = manager.Create(height); err != nil {
+					return err
+				}
+			}
+
+			f, err := os.Create(out)
+			if err != nil {
+				return err
+			}
+			if err := manager.ExportArchive(height, format, f); err != nil {
+				f.Close()
+				os.Remove(out)
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "exported the snapshot of height %d format %d with %d chunks to %s\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks, out)
+			return nil
+		},
+	}
+
+	addAppFlags(cmd, defaultNodeHome)
+	cmd.Flags().String(flagOut, "", "The archive file (default <height>-<format>.tar.zst)")
+
+	return cmd
+}
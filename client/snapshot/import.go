@@ -0,0 +1,48 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// ImportSnapshotCmd loads a snapshot archive file into the local snapshots.
+func ImportSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <archive>",
+		Short: "Import a snapshot from an archive file",
+		Long: `Import the snapshot of an archive file written by the export command into the local snapshots,
+from which it can be restored or served to the peers state syncing. The chunks of the snapshot are
+verified against the hashes of its metadata.`,
+		Example: "import snap.tar.zst --home ./",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			store, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := store.Import(f)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "imported the snapshot of height %d format %d with %d chunks\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node home directory")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// ListSnapshotsCmd lists the local snapshots.
+func ListSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the local snapshots",
+		Example: "list --home ./",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			store, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := store.List()
+			if err != nil {
+				return err
+			}
+			for _, snapshot := range snapshots {
+				fmt.Fprintf(cmd.OutOrStdout(), "height: %d format: %d chunks: %d hash: %X\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node home directory")
+
+	return cmd
+}
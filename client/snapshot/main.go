@@ -0,0 +1,107 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	flagFormat = "format"
+	flagOut    = "out"
+
+	appDBName = "application"
+)
+
+// Cmd creates the main CLI command of the tools operating on the local
+// snapshots of a stopped node, stored under <home>/data/snapshots.
+func Cmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Tools for managing the local state sync snapshots of a stopped node",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(defaultNodeHome),
+		DeleteSnapshotCmd(defaultNodeHome),
+		ExportSnapshotCmd(appCreator, defaultNodeHome),
+		ImportSnapshotCmd(defaultNodeHome),
+		RestoreSnapshotCmd(appCreator, defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// snapshotManagerApp is implemented by the applications embedding a BaseApp.
+type snapshotManagerApp interface {
+	SnapshotManager() *snapshots.Manager
+}
+
+// openStore opens the snapshot store of the node home directory.
+func openStore(cmd *cobra.Command) (*snapshots.Store, error) {
+	home, _ := cmd.Flags().GetString(flags.FlagHome)
+	vp := viper.New()
+	vp.Set(flags.FlagHome, home)
+
+	return server.GetSnapshotStore(vp)
+}
+
+// newApp creates the application of the node home directory, configured by
+// its app.toml file, and returns its snapshot manager and its database, which
+// the caller must close.
+func newApp(cmd *cobra.Command, appCreator servertypes.AppCreator) (servertypes.Application, *snapshots.Manager, dbm.DB, error) {
+	home, _ := cmd.Flags().GetString(flags.FlagHome)
+
+	vp := viper.New()
+	vp.SetDefault(server.FlagPruning, storetypes.PruningOptionDefault)
+	appConfigPath := filepath.Join(home, "config", "app.toml")
+	if _, err := os.Stat(appConfigPath); err == nil {
+		vp.SetConfigFile(appConfigPath)
+		if err := vp.ReadInConfig(); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to read %s: %w", appConfigPath, err)
+		}
+	}
+	vp.Set(flags.FlagHome, home)
+	if backend, _ := cmd.Flags().GetString(server.FlagAppDBBackend); backend != "" {
+		vp.Set(server.FlagAppDBBackend, backend)
+	}
+
+	db, err := dbm.NewDB(appDBName, server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	app := appCreator(log.NewNopLogger(), db, nil, vp)
+	smApp, ok := app.(snapshotManagerApp)
+	if !ok || smApp.SnapshotManager() == nil {
+		db.Close()
+		return nil, nil, nil, fmt.Errorf("the application has no snapshot manager")
+	}
+
+	return app, smApp.SnapshotManager(), db, nil
+}
+
+func addFlags(cmd *cobra.Command, defaultNodeHome string) {
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node home directory")
+	cmd.Flags().Uint32(flagFormat, snapshottypes.CurrentFormat, "The snapshot format")
+}
+
+// addAppFlags adds the flags of the commands creating the application.
+func addAppFlags(cmd *cobra.Command, defaultNodeHome string) {
+	addFlags(cmd, defaultNodeHome)
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The backend of the application database, the one of app.toml or the binary if empty")
+}
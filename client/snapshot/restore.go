@@ -0,0 +1,57 @@
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RestoreSnapshotCmd restores the application state from a local snapshot.
+func RestoreSnapshotCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <height>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application database of the stopped node in --home from the local snapshot of the
+given height, e.g. one loaded by the import command, without state syncing from peers. The
+application database must be empty.
+
+Only the application state is restored: the node must still be given the consensus state of the
+height, e.g. by the state sync of tendermint, before it can start.`,
+		Example: "restore 1000 --home ./",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			format, _ := cmd.Flags().GetUint32(flagFormat)
+
+			app, manager, db, err := newApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms := app.CommitMultiStore()
+			if version := cms.LastCommitID().Version; version != 0 {
+				return fmt.Errorf("the application database is not empty, its latest height is %d", version)
+			}
+
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+
+			id := cms.LastCommitID()
+			fmt.Fprintf(cmd.OutOrStdout(), "restored the application state at height %d, the app hash is %X\n",
+				id.Version, id.Hash)
+			return nil
+		},
+	}
+
+	addAppFlags(cmd, defaultNodeHome)
+
+	return cmd
+}
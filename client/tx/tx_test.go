@@ -283,6 +283,41 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignTextual(t *testing.T) {
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	requireT.NoError(err)
+
+	info, _, err := kr.NewMnemonic("test_key", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+
+	txCfg := NewTestTxConfig()
+	txf := tx.Factory{}.
+		WithTxConfig(txCfg).
+		WithKeybase(kr).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+
+	msg := banktypes.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txb, err := tx.BuildUnsignedTx(txf, msg)
+	requireT.NoError(err)
+	requireT.NoError(tx.Sign(txf, "test_key", txb, true))
+
+	sigs := testSigners(requireT, txb.GetTx(), info.GetPubKey())
+	requireT.Equal(signingtypes.SignMode_SIGN_MODE_TEXTUAL, sigs[0].Data.(*signingtypes.SingleSignatureData).SignMode)
+
+	signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 50, Sequence: 23}
+	requireT.NoError(signing.VerifySignature(info.GetPubKey(), signerData, sigs[0].Data, txCfg.SignModeHandler(), txb.GetTx()))
+
+	// the signature does not verify for another sequence
+	signerData.Sequence = 24
+	requireT.Error(signing.VerifySignature(info.GetPubKey(), signerData, sigs[0].Data, txCfg.SignModeHandler(), txb.GetTx()))
+}
+
 func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.PubKey) []signingtypes.SignatureV2 {
 	sigs, err := tr.GetSignaturesV2()
 	require.Len(sigs, len(pks))
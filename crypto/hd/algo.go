@@ -3,6 +3,7 @@ package hd
 import (
 	bip39 "github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -15,6 +16,9 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// EthSecp256k1Type uses the secp256k1 ECDSA parameters with Ethereum
+	// Keccak256 hashing and addressing.
+	EthSecp256k1Type = PubKeyType("eth_secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
@@ -25,6 +29,10 @@ const (
 // Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 var Secp256k1 = secp256k1Algo{}
 
+// EthSecp256k1 uses the secp256k1 ECDSA parameters with Ethereum Keccak256
+// hashing and addressing.
+var EthSecp256k1 = ethSecp256k1Algo{}
+
 type (
 	DeriveFn   func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
 	GenerateFn func(bz []byte) types.PrivKey
@@ -68,3 +76,26 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ethSecp256k1Algo struct{}
+
+func (s ethSecp256k1Algo) Name() PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive derives and returns the eth_secp256k1 private key for the given seed
+// and HD path. The derivation is the same as for secp256k1 keys, Ethereum
+// wallets use the HD path m/44'/60'/0'/0/0.
+func (s ethSecp256k1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates an eth_secp256k1 private key from the given bytes.
+func (s ethSecp256k1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &ethsecp256k1.PrivKey{Key: bzArr}
+	}
+}
@@ -34,6 +34,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendVault   = "vault"
 )
 
 const (
@@ -161,7 +162,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "vault".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -183,6 +184,8 @@ func New(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendVault:
+		db = newVaultKeyring(rootDir, userInput)
 	default:
 		return nil, fmt.Errorf("unknown keyring backend %v", backend)
 	}
@@ -202,7 +205,7 @@ type keystore struct {
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.EthSecp256k1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -265,7 +268,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 			return nil, err
 		}
 
-		priv, err = legacy.PrivKeyFromBytes([]byte(linfo.PrivKeyArmor))
+		priv, err = ks.privKeyFromLocalInfo(linfo)
 		if err != nil {
 			return nil, err
 		}
@@ -274,6 +277,10 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 		return nil, errors.New("only works on local private keys")
 	}
 
+	if err := ks.auditKeyUse(VaultEventExport, uid); err != nil {
+		return nil, err
+	}
+
 	return priv, nil
 }
 
@@ -350,7 +357,7 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 			return nil, nil, fmt.Errorf("private key not available")
 		}
 
-		priv, err = legacy.PrivKeyFromBytes([]byte(i.PrivKeyArmor))
+		priv, err = ks.privKeyFromLocalInfo(i)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -367,9 +374,40 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 		return nil, nil, err
 	}
 
+	if err := ks.auditKeyUse(VaultEventSign, uid); err != nil {
+		return nil, nil, err
+	}
+
 	return sig, priv.PubKey(), nil
 }
 
+// privKeyFromLocalInfo decodes the private key of a local key, prompting for its
+// passphrase if it is protected by its own passphrase in the vault backend.
+func (ks keystore) privKeyFromLocalInfo(info localInfo) (types.PrivKey, error) {
+	privArmor := info.PrivKeyArmor
+	if vault, ok := ks.db.(*vaultKeyring); ok {
+		var err error
+		if privArmor, err = vault.unsealPrivKeyArmor(info.Name, privArmor); err != nil {
+			return nil, err
+		}
+	} else if isSealedPrivKeyArmor(privArmor) {
+		return nil, fmt.Errorf("private key is sealed by the %s backend", BackendVault)
+	}
+
+	return legacy.PrivKeyFromBytes([]byte(privArmor))
+}
+
+// auditKeyUse records the use of a private key in the audit log of the vault
+// backend.
+func (ks keystore) auditKeyUse(event, uid string) error {
+	vault, ok := ks.db.(*vaultKeyring)
+	if !ok {
+		return nil
+	}
+
+	return vault.audit(event, uid)
+}
+
 func (ks keystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
 	key, err := ks.KeyByAddress(address)
 	if err != nil {
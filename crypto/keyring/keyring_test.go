@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -1141,6 +1142,36 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAltKeyring_EthSecp256k1(t *testing.T) {
+	dir := t.TempDir()
+	kr, err := New(t.Name(), BackendTest, dir, nil)
+	require.NoError(t, err)
+
+	// the first account of the Hardhat development mnemonic on the Ethereum
+	// HD path
+	mnemonic := "test test test test test test test test test test test junk"
+	info, err := kr.NewAccount("eth", mnemonic, DefaultBIP39Passphrase, "m/44'/60'/0'/0/0", hd.EthSecp256k1)
+	require.NoError(t, err)
+	require.Equal(t, hd.EthSecp256k1Type, info.GetAlgo())
+	require.IsType(t, &ethsecp256k1.PubKey{}, info.GetPubKey())
+	require.Equal(t, "f39fd6e51aad88f6f4ce6ab8827279cfffb92266", hex.EncodeToString(info.GetAddress()))
+
+	// the private key is restored from the keyring when signing
+	kr, err = New(t.Name(), BackendTest, dir, nil)
+	require.NoError(t, err)
+	msg := []byte("message")
+	sig, pubKey, err := kr.Sign("eth", msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.True(t, info.GetPubKey().Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	algos, _ := kr.SupportedAlgorithms()
+	algo, err := NewSigningAlgoFromString(string(hd.EthSecp256k1Type), algos)
+	require.NoError(t, err)
+	require.Equal(t, hd.EthSecp256k1, algo)
+}
+
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
 	require.Equal(t, []keyring.BackendType{keyring.KWalletBackend}, backend.AllowedBackends)
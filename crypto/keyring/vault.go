@@ -0,0 +1,611 @@
+package keyring
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/keyring"
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/argon2"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+)
+
+const (
+	keyringVaultDirName   = "keyring-vault"
+	vaultFileName         = "vault.json"
+	vaultAuditLogFileName = "audit.log"
+	vaultVersion          = 1
+
+	// sealedPrivKeyPrefix prefixes the private key armor of keys protected by
+	// their own passphrase in the vault backend.
+	sealedPrivKeyPrefix = "vault-sealed:"
+)
+
+// Vault audit log events.
+const (
+	VaultEventCreate           = "create"
+	VaultEventUpdate           = "update"
+	VaultEventDelete           = "delete"
+	VaultEventSign             = "sign"
+	VaultEventExport           = "export"
+	VaultEventUnsealFailed     = "unseal-failed"
+	VaultEventRotatePassphrase = "rotate-passphrase"
+	VaultEventKeyPassphrase    = "set-key-passphrase"
+)
+
+// vaultKDF holds the Argon2id parameters used to derive an encryption key from
+// a passphrase. They are stored along the encrypted data so that they can be
+// strengthened without breaking existing vaults.
+type vaultKDF struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+// defaultVaultKDF holds the Argon2id parameters of new vaults and sealed keys,
+// as recommended by RFC 9106 for memory constrained environments.
+var defaultVaultKDF = vaultKDF{Time: 3, Memory: 64 * 1024, Threads: 4}
+
+func newVaultKDF() vaultKDF {
+	kdf := defaultVaultKDF
+	kdf.Salt = tmcrypto.CRandBytes(16)
+	return kdf
+}
+
+func (kdf vaultKDF) deriveKey(passphrase string) []byte {
+	return argon2.IDKey([]byte(passphrase), kdf.Salt, kdf.Time, kdf.Memory, kdf.Threads, 32)
+}
+
+// sealedBox is data encrypted with AES-256-GCM using an Argon2id derived key.
+type sealedBox struct {
+	KDF        vaultKDF `json:"kdf"`
+	Nonce      []byte   `json:"nonce"`
+	Ciphertext []byte   `json:"ciphertext"`
+}
+
+func sealWithKey(key []byte, kdf vaultKDF, plaintext, additionalData []byte) (sealedBox, error) {
+	aead, err := newVaultAEAD(key)
+	if err != nil {
+		return sealedBox{}, err
+	}
+
+	nonce := tmcrypto.CRandBytes(aead.NonceSize())
+	return sealedBox{
+		KDF:        kdf,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, additionalData),
+	}, nil
+}
+
+func (box sealedBox) openWithKey(key, additionalData []byte) ([]byte, error) {
+	aead, err := newVaultAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(box.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce size")
+	}
+
+	return aead.Open(nil, box.Nonce, box.Ciphertext, additionalData)
+}
+
+func newVaultAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// vaultFile is the on-disk format of the vault.
+type vaultFile struct {
+	Version int       `json:"version"`
+	Vault   sealedBox `json:"vault"`
+}
+
+type vaultEntry struct {
+	Data             []byte    `json:"data"`
+	ModificationTime time.Time `json:"modification_time"`
+}
+
+// VaultAuditEntry is an entry of the audit log of the vault backend.
+type VaultAuditEntry struct {
+	Time  time.Time `json:"time"`
+	Event string    `json:"event"`
+	Key   string    `json:"key,omitempty"`
+}
+
+var _ keyring.Keyring = &vaultKeyring{}
+
+// vaultKeyring is a keyring.Keyring storing all its items in a single file
+// encrypted with a key derived from a passphrase with Argon2id. The vault is
+// decrypted on first use and kept in memory, every change rewrites the file.
+// A new vault is created, and its passphrase asked for, on its first write.
+type vaultKeyring struct {
+	dir string
+	buf *bufio.Reader
+
+	mtx     sync.Mutex
+	key     []byte
+	kdf     vaultKDF
+	entries map[string]vaultEntry
+}
+
+func newVaultKeyring(dir string, buf io.Reader) *vaultKeyring {
+	return &vaultKeyring{
+		dir: filepath.Join(dir, keyringVaultDirName),
+		buf: bufio.NewReader(buf),
+	}
+}
+
+func (v *vaultKeyring) vaultPath() string    { return filepath.Join(v.dir, vaultFileName) }
+func (v *vaultKeyring) auditLogPath() string { return filepath.Join(v.dir, vaultAuditLogFileName) }
+
+// Get implements keyring.Keyring.
+func (v *vaultKeyring) Get(key string) (keyring.Item, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if err := v.unlock(); err != nil {
+		return keyring.Item{}, err
+	}
+
+	entry, ok := v.entries[key]
+	if !ok {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	}
+	return keyring.Item{Key: key, Data: entry.Data}, nil
+}
+
+// GetMetadata implements keyring.Keyring.
+func (v *vaultKeyring) GetMetadata(key string) (keyring.Metadata, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if err := v.unlock(); err != nil {
+		return keyring.Metadata{}, err
+	}
+
+	entry, ok := v.entries[key]
+	if !ok {
+		return keyring.Metadata{}, keyring.ErrKeyNotFound
+	}
+	return keyring.Metadata{
+		Item:             &keyring.Item{Key: key},
+		ModificationTime: entry.ModificationTime,
+	}, nil
+}
+
+// Set implements keyring.Keyring.
+func (v *vaultKeyring) Set(item keyring.Item) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return v.set(item, "")
+}
+
+// set writes an item and audits the change of a key as event, or as its
+// creation or update if event is empty. The caller must hold the lock.
+func (v *vaultKeyring) set(item keyring.Item, event string) error {
+	if err := v.unlock(); err != nil {
+		return err
+	}
+
+	_, exists := v.entries[item.Key]
+	v.entries[item.Key] = vaultEntry{Data: item.Data, ModificationTime: time.Now().UTC()}
+	if err := v.save(); err != nil {
+		return err
+	}
+
+	if !strings.HasSuffix(item.Key, infoSuffix) {
+		return nil
+	}
+	if event == "" {
+		event = VaultEventCreate
+		if exists {
+			event = VaultEventUpdate
+		}
+	}
+	return v.appendAudit(event, strings.TrimSuffix(item.Key, "."+infoSuffix))
+}
+
+// Remove implements keyring.Keyring.
+func (v *vaultKeyring) Remove(key string) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if err := v.unlock(); err != nil {
+		return err
+	}
+
+	if _, ok := v.entries[key]; !ok {
+		return keyring.ErrKeyNotFound
+	}
+	delete(v.entries, key)
+	if err := v.save(); err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(key, infoSuffix) {
+		return v.appendAudit(VaultEventDelete, strings.TrimSuffix(key, "."+infoSuffix))
+	}
+	return nil
+}
+
+// Keys implements keyring.Keyring.
+func (v *vaultKeyring) Keys() ([]string, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if err := v.unlock(); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(v.entries))
+	for key := range v.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// unlock decrypts the vault, prompting for its passphrase. A vault that does
+// not exist yet is empty, its passphrase is asked for by save.
+func (v *vaultKeyring) unlock() error {
+	if v.entries != nil {
+		return nil
+	}
+
+	bz, err := os.ReadFile(v.vaultPath())
+	if os.IsNotExist(err) {
+		v.entries = map[string]vaultEntry{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", v.vaultPath(), err)
+	}
+
+	var file vaultFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", v.vaultPath(), err)
+	}
+	if file.Version != vaultVersion {
+		return fmt.Errorf("unsupported keyring vault version %d", file.Version)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > maxPassphraseEntryAttempts {
+			return fmt.Errorf("too many failed passphrase attempts")
+		}
+
+		pass, err := input.GetPassword("Enter keyring vault passphrase:", v.buf)
+		if err != nil {
+			// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
+			// but we only log the error.
+			//
+			// lgtm [go/clear-text-logging]
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+
+		key := file.Vault.KDF.deriveKey(pass)
+		plaintext, err := file.Vault.openWithKey(key, []byte(vaultFileName))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "incorrect passphrase")
+			continue
+		}
+
+		entries := map[string]vaultEntry{}
+		if err := json.Unmarshal(plaintext, &entries); err != nil {
+			return fmt.Errorf("failed to decode keyring vault: %w", err)
+		}
+
+		v.kdf, v.key, v.entries = file.Vault.KDF, key, entries
+		return nil
+	}
+}
+
+// save encrypts the vault and atomically replaces the vault file, prompting
+// for the passphrase of the vault if it is created.
+func (v *vaultKeyring) save() error {
+	if v.key == nil {
+		pass, err := v.promptNewPassphrase("Enter keyring vault passphrase:")
+		if err != nil {
+			return err
+		}
+		v.kdf = newVaultKDF()
+		v.key = v.kdf.deriveKey(pass)
+	}
+
+	plaintext, err := json.Marshal(v.entries)
+	if err != nil {
+		return err
+	}
+
+	box, err := sealWithKey(v.key, v.kdf, plaintext, []byte(vaultFileName))
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(vaultFile{Version: vaultVersion, Vault: box})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(v.dir, 0o700); err != nil {
+		return err
+	}
+
+	tmpPath := v.vaultPath() + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, v.vaultPath())
+}
+
+// audit appends an event to the audit log of the vault.
+func (v *vaultKeyring) audit(event, key string) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return v.appendAudit(event, key)
+}
+
+// appendAudit appends an event to the audit log of the vault. The caller must
+// hold the lock.
+func (v *vaultKeyring) appendAudit(event, key string) error {
+	bz, err := json.Marshal(VaultAuditEntry{Time: time.Now().UTC(), Event: event, Key: key})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(v.dir, 0o700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(v.auditLogPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(bz, '\n'))
+	return err
+}
+
+// auditLog returns the entries of the audit log of the vault.
+func (v *vaultKeyring) auditLog() ([]VaultAuditEntry, error) {
+	bz, err := os.ReadFile(v.auditLogPath())
+	if os.IsNotExist(err) {
+		return []VaultAuditEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []VaultAuditEntry{}
+	for _, line := range strings.Split(strings.TrimSpace(string(bz)), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry VaultAuditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", v.auditLogPath(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// rotatePassphrase prompts for a new passphrase and re-encrypts the vault with
+// a key derived from it.
+func (v *vaultKeyring) rotatePassphrase() error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if err := v.unlock(); err != nil {
+		return err
+	}
+
+	pass, err := v.promptNewPassphrase("Enter new keyring vault passphrase:")
+	if err != nil {
+		return err
+	}
+
+	v.kdf = newVaultKDF()
+	v.key = v.kdf.deriveKey(pass)
+	if err := v.save(); err != nil {
+		return err
+	}
+
+	return v.appendAudit(VaultEventRotatePassphrase, "")
+}
+
+// promptNewPassphrase prompts for a passphrase and its confirmation.
+func (v *vaultKeyring) promptNewPassphrase(prompt string) (string, error) {
+	for attempt := 1; ; attempt++ {
+		if attempt > maxPassphraseEntryAttempts {
+			return "", fmt.Errorf("too many failed passphrase attempts")
+		}
+
+		pass, err := input.GetPassword(prompt, v.buf)
+		if err != nil {
+			// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
+			// but we only log the error.
+			//
+			// lgtm [go/clear-text-logging]
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+
+		reEnteredPass, err := input.GetPassword("Re-enter passphrase:", v.buf)
+		if err != nil {
+			// lgtm [go/clear-text-logging]
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+
+		if pass != reEnteredPass {
+			fmt.Fprintln(os.Stderr, "passphrase do not match")
+			continue
+		}
+
+		return pass, nil
+	}
+}
+
+// sealPrivKeyArmor encrypts the private key armor of a key with a key derived
+// from its own passphrase. The name of the key is authenticated so that sealed
+// keys cannot be swapped.
+func sealPrivKeyArmor(name, privArmor, passphrase string) (string, error) {
+	kdf := newVaultKDF()
+	box, err := sealWithKey(kdf.deriveKey(passphrase), kdf, []byte(privArmor), []byte(name))
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := json.Marshal(box)
+	if err != nil {
+		return "", err
+	}
+	return sealedPrivKeyPrefix + base64.StdEncoding.EncodeToString(bz), nil
+}
+
+func isSealedPrivKeyArmor(privArmor string) bool {
+	return strings.HasPrefix(privArmor, sealedPrivKeyPrefix)
+}
+
+// unsealPrivKeyArmor prompts for the passphrase of a key protected by its own
+// passphrase and returns its decrypted private key armor.
+func (v *vaultKeyring) unsealPrivKeyArmor(name, privArmor string) (string, error) {
+	if !isSealedPrivKeyArmor(privArmor) {
+		return privArmor, nil
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(privArmor, sealedPrivKeyPrefix))
+	if err != nil {
+		return "", err
+	}
+	var box sealedBox
+	if err := json.Unmarshal(bz, &box); err != nil {
+		return "", err
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for attempt := 1; attempt <= maxPassphraseEntryAttempts; attempt++ {
+		pass, err := input.GetPassword(fmt.Sprintf("Enter passphrase of key %s:", name), v.buf)
+		if err != nil {
+			// lgtm [go/clear-text-logging]
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+
+		plaintext, err := box.openWithKey(box.KDF.deriveKey(pass), []byte(name))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "incorrect passphrase")
+			if err := v.appendAudit(VaultEventUnsealFailed, name); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		return string(plaintext), nil
+	}
+
+	return "", fmt.Errorf("too many failed passphrase attempts")
+}
+
+// vaultOf returns the vault of a keyring using the vault backend.
+func vaultOf(kr Keyring) (*vaultKeyring, keystore, error) {
+	ks, ok := kr.(keystore)
+	if !ok {
+		return nil, keystore{}, fmt.Errorf("unsupported keyring implementation %T", kr)
+	}
+
+	vault, ok := ks.db.(*vaultKeyring)
+	if !ok {
+		return nil, keystore{}, fmt.Errorf("keyring backend is not %s", BackendVault)
+	}
+	return vault, ks, nil
+}
+
+// RotateVaultPassphrase prompts for the current and a new passphrase of a
+// keyring using the vault backend, and re-encrypts the vault with the new one.
+func RotateVaultPassphrase(kr Keyring) error {
+	vault, _, err := vaultOf(kr)
+	if err != nil {
+		return err
+	}
+
+	return vault.rotatePassphrase()
+}
+
+// RotateKeyPassphrase protects the private key of a key of a keyring using the
+// vault backend with its own passphrase, prompting for the current passphrase
+// of the key if it already has one and for the new one. If remove is true, the
+// key is only protected by the passphrase of the vault afterwards.
+func RotateKeyPassphrase(kr Keyring, uid string, remove bool) error {
+	vault, ks, err := vaultOf(kr)
+	if err != nil {
+		return err
+	}
+
+	info, err := ks.Key(uid)
+	if err != nil {
+		return err
+	}
+	linfo, ok := info.(localInfo)
+	if !ok {
+		return errors.New("only works on local private keys")
+	}
+	if linfo.PrivKeyArmor == "" {
+		return fmt.Errorf("private key not available")
+	}
+
+	privArmor, err := vault.unsealPrivKeyArmor(uid, linfo.PrivKeyArmor)
+	if err != nil {
+		return err
+	}
+
+	if !remove {
+		pass, err := vault.promptNewPassphrase(fmt.Sprintf("Enter new passphrase of key %s:", uid))
+		if err != nil {
+			return err
+		}
+		if privArmor, err = sealPrivKeyArmor(uid, privArmor, pass); err != nil {
+			return err
+		}
+	}
+
+	linfo.PrivKeyArmor = privArmor
+	vault.mtx.Lock()
+	defer vault.mtx.Unlock()
+
+	return vault.set(keyring.Item{Key: infoKey(uid), Data: marshalInfo(linfo)}, VaultEventKeyPassphrase)
+}
+
+// VaultAuditLog returns the audit log of a keyring using the vault backend.
+func VaultAuditLog(kr Keyring) ([]VaultAuditEntry, error) {
+	vault, _, err := vaultOf(kr)
+	if err != nil {
+		return nil, err
+	}
+
+	return vault.auditLog()
+}
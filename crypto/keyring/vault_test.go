@@ -0,0 +1,174 @@
+package keyring
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	// keep the tests fast
+	defaultVaultKDF = vaultKDF{Time: 1, Memory: 1024, Threads: 1}
+}
+
+func newTestVaultKeyring(t *testing.T, dir string, input ...string) Keyring {
+	kr, err := New(t.Name(), BackendVault, dir, strings.NewReader(strings.Join(input, "\n")+"\n"))
+	require.NoError(t, err)
+	return kr
+}
+
+func TestVaultKeyring(t *testing.T) {
+	dir := t.TempDir()
+
+	// the passphrase of a new vault is asked twice
+	kr := newTestVaultKeyring(t, dir, "password1", "password1")
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("bar", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the vault is a single encrypted file
+	files, err := os.ReadDir(filepath.Join(dir, keyringVaultDirName))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	bz, err := os.ReadFile(filepath.Join(dir, keyringVaultDirName, vaultFileName))
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "foo")
+
+	// the vault is decrypted with its passphrase, after failed attempts
+	kr = newTestVaultKeyring(t, dir, "password2", "password1")
+	keys, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	key, err := kr.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), key.GetPubKey())
+	_, _, err = kr.Sign("foo", []byte("message"))
+	require.NoError(t, err)
+
+	// the passphrase is asked once per keyring
+	require.NoError(t, kr.Delete("bar"))
+	_, err = kr.Key("bar")
+	require.Error(t, err)
+
+	// too many failed attempts
+	kr = newTestVaultKeyring(t, dir, "password2", "password3", "password4", "password1")
+	_, err = kr.List()
+	require.EqualError(t, err, "too many failed passphrase attempts")
+}
+
+func TestVaultKeyringCreatedOnWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	// reading a missing vault neither prompts for a passphrase nor creates it
+	kr := newTestVaultKeyring(t, dir)
+	keys, err := kr.List()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	_, err = kr.Key("foo")
+	require.Error(t, err)
+	_, err = os.Stat(filepath.Join(dir, keyringVaultDirName, vaultFileName))
+	require.True(t, os.IsNotExist(err))
+
+	// the passphrase of the vault is asked on its first write
+	kr = newTestVaultKeyring(t, dir, "password1", "password1")
+	_, _, err = kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr = newTestVaultKeyring(t, dir, "password1")
+	_, err = kr.Key("foo")
+	require.NoError(t, err)
+}
+
+func TestVaultKeyringRotatePassphrase(t *testing.T) {
+	dir := t.TempDir()
+
+	kr := newTestVaultKeyring(t, dir, "password1", "password1")
+	_, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the current passphrase is asked first, then the new one twice
+	kr = newTestVaultKeyring(t, dir, "password1", "password2", "password2")
+	require.NoError(t, RotateVaultPassphrase(kr))
+
+	kr = newTestVaultKeyring(t, dir, "password1", "password1", "password1")
+	_, err = kr.Key("foo")
+	require.Error(t, err)
+
+	kr = newTestVaultKeyring(t, dir, "password2")
+	_, err = kr.Key("foo")
+	require.NoError(t, err)
+
+	// only vault keyrings have a passphrase to rotate
+	require.Error(t, RotateVaultPassphrase(NewInMemory()))
+}
+
+func TestVaultKeyringKeyPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	msg := []byte("message")
+
+	kr := newTestVaultKeyring(t, dir, "password1", "password1")
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kr.SavePubKey("offline", info.GetPubKey(), hd.Secp256k1Type)
+	require.Error(t, err)
+
+	// set the passphrase of the key
+	kr = newTestVaultKeyring(t, dir, "password1", "keypass1", "keypass1")
+	require.NoError(t, RotateKeyPassphrase(kr, "foo", false))
+
+	// the key passphrase is asked when signing, not when listing keys
+	kr = newTestVaultKeyring(t, dir, "password1", "keypass1")
+	keys, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	sig, pubKey, err := kr.Sign("foo", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	kr = newTestVaultKeyring(t, dir, "password1", "password1", "password1", "password1")
+	_, _, err = kr.Sign("foo", msg)
+	require.EqualError(t, err, "too many failed passphrase attempts")
+
+	// the key passphrase is needed to change it
+	kr = newTestVaultKeyring(t, dir, "password1", "keypass1", "keypass2", "keypass2")
+	require.NoError(t, RotateKeyPassphrase(kr, "foo", false))
+	kr = newTestVaultKeyring(t, dir, "password1", "keypass2")
+	_, err = NewUnsafe(kr).UnsafeExportPrivKeyHex("foo")
+	require.NoError(t, err)
+
+	// and to remove it
+	kr = newTestVaultKeyring(t, dir, "password1", "keypass2")
+	require.NoError(t, RotateKeyPassphrase(kr, "foo", true))
+	kr = newTestVaultKeyring(t, dir, "password1")
+	_, _, err = kr.Sign("foo", msg)
+	require.NoError(t, err)
+
+	// key use is audited
+	entries, err := VaultAuditLog(kr)
+	require.NoError(t, err)
+	events := make([]string, len(entries))
+	for i, entry := range entries {
+		require.Equal(t, "foo", entry.Key)
+		events[i] = entry.Event
+	}
+	require.Equal(t, []string{
+		VaultEventCreate,
+		VaultEventKeyPassphrase,
+		VaultEventSign,
+		VaultEventUnsealFailed, VaultEventUnsealFailed, VaultEventUnsealFailed,
+		VaultEventKeyPassphrase,
+		VaultEventExport,
+		VaultEventKeyPassphrase,
+		VaultEventSign,
+	}, events)
+
+	_, err = VaultAuditLog(NewInMemory())
+	require.Error(t, err)
+}
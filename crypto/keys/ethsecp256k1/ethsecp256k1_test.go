@@ -0,0 +1,136 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+type keyData struct {
+	priv string
+	addr string
+}
+
+var ethDataTable = []keyData{
+	{
+		// keccak256("cow"), the signer of the EIP-712 example
+		priv: "c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4",
+		addr: "cd2a3d9f938e13cd947ec05abc7fe734df8dd826",
+	},
+	{
+		// the sender of the EIP-155 example
+		priv: "4646464646464646464646464646464646464646464646464646464646464646",
+		addr: "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f",
+	},
+}
+
+func TestPubKeyEthSecp256k1Address(t *testing.T) {
+	for _, d := range ethDataTable {
+		privB, err := hex.DecodeString(d.priv)
+		require.NoError(t, err)
+		addrB, err := hex.DecodeString(d.addr)
+		require.NoError(t, err)
+
+		priv := ethsecp256k1.PrivKey{Key: privB}
+		pubKey := priv.PubKey()
+
+		require.Equal(t, crypto.Address(addrB), pubKey.Address())
+		// the public key is the same as the secp256k1 one, the address differs
+		cosmosPubKey := (&secp256k1.PrivKey{Key: privB}).PubKey()
+		require.Equal(t, cosmosPubKey.Bytes(), pubKey.Bytes())
+		require.NotEqual(t, cosmosPubKey.Address(), pubKey.Address())
+	}
+}
+
+func TestSignAndValidateEthSecp256k1(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.Contains(t, []byte{0, 1}, sig[64])
+
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	// the recovery id may be omitted or offset by 27
+	require.True(t, pubKey.VerifySignature(msg, sig[:64]))
+	require.True(t, pubKey.VerifySignature(msg, append(append([]byte{}, sig[:64]...), sig[64]+27)))
+	// invalid recovery id
+	require.False(t, pubKey.VerifySignature(msg, append(append([]byte{}, sig[:64]...), 2)))
+
+	// the signature is over the Keccak256 hash, not the SHA256 one
+	cosmosSig, err := (&secp256k1.PrivKey{Key: privKey.Key}).Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, cosmosSig))
+
+	// mutate the signature, it should fail
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+	sig[7] ^= byte(0x01)
+
+	// malleable signatures with a high S are rejected
+	var s btcec.ModNScalar
+	s.SetByteSlice(sig[32:64])
+	highS := s.Negate().Bytes()
+	malleable := append(append(append([]byte{}, sig[:32]...), highS[:]...), sig[64]^1)
+	require.False(t, pubKey.VerifySignature(msg, malleable))
+
+	// another key cannot verify the signature
+	require.False(t, ethsecp256k1.GenPrivKey().PubKey().VerifySignature(msg, sig))
+}
+
+func TestEthSecp256k1Keccak256(t *testing.T) {
+	require.Equal(t,
+		"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		hex.EncodeToString(ethsecp256k1.Keccak256(nil)),
+	)
+}
+
+func TestEthSecp256k1AminoMarshalling(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(cdc)
+
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	bz, err := cdc.MarshalJSON(pubKey)
+	require.NoError(t, err)
+	var decodedPubKey cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decodedPubKey))
+	require.True(t, pubKey.Equals(decodedPubKey))
+
+	bz, err = cdc.Marshal(privKey)
+	require.NoError(t, err)
+	var decodedPrivKey cryptotypes.PrivKey
+	require.NoError(t, cdc.Unmarshal(bz, &decodedPrivKey))
+	require.True(t, privKey.Equals(decodedPrivKey))
+
+	require.Error(t, (&ethsecp256k1.PubKey{}).UnmarshalAmino([]byte{1, 2, 3}))
+	require.Error(t, (&ethsecp256k1.PrivKey{}).UnmarshalAmino([]byte{1, 2, 3}))
+}
+
+func TestEthSecp256k1ProtoMarshalling(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	pubKey := ethsecp256k1.GenPrivKey().PubKey()
+	bz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+
+	var decoded cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.IsType(t, &ethsecp256k1.PubKey{}, decoded)
+	require.True(t, pubKey.Equals(decoded))
+}
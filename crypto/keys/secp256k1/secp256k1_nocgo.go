@@ -76,6 +76,22 @@ func (pubKey *PubKey) VerifySignatureEip191(msg []byte, sigStr []byte) bool {
 	return signature.Verify(keccak256(msg), pub)
 }
 
+// VerifySignatureEip712 verifies an Ethereum signature of the form R || S or
+// R || S || V over the Keccak256 hash of the EIP-712 encoded msg.
+// It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) VerifySignatureEip712(msg []byte, sigStr []byte) bool {
+	if len(sigStr) == 65 {
+		// the recovery id is not needed as the public key is known
+		switch sigStr[64] {
+		case 0, 1, 27, 28:
+		default:
+			return false
+		}
+		sigStr = sigStr[:64]
+	}
+	return pubKey.VerifySignatureEip191(msg, sigStr)
+}
+
 func keccak256(bytes []byte) []byte {
 	hasher := sha3.NewLegacyKeccak256()
 	hasher.Write(bytes)
@@ -1,9 +1,11 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -43,6 +45,14 @@ type BaseConfig struct {
 	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningAsync deletes the pruned heights in the background instead of on
+	// commit.
+	PruningAsync bool `mapstructure:"pruning-async"`
+
+	// PruningStores overrides the pruning options above for the IAVL stores
+	// with the given names.
+	PruningStores map[string]StorePruningConfig `mapstructure:"pruning-stores"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -84,6 +94,25 @@ type BaseConfig struct {
 
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
+
+	// CommitDumpDir defines the directory to which the commit info and the
+	// writes of the latest committed block are dumped when an app hash mismatch
+	// is reported. A relative path is relative to the node home. If empty,
+	// nothing is dumped.
+	CommitDumpDir string `mapstructure:"commit-dump-dir"`
+
+	// CommitDumpKeepRecent defines the number of recent commit dumps to keep,
+	// all of them being kept if it is 0.
+	CommitDumpKeepRecent uint32 `mapstructure:"commit-dump-keep-recent"`
+}
+
+// StorePruningConfig defines the pruning options of a store, which override
+// the pruning options of the base configuration.
+type StorePruningConfig struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -193,14 +222,29 @@ type (
 	// fields are required to be set when state streaming is enabled via a non-empty
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
-		File FileStreamerConfig `mapstructure:"file"`
+		File   FileStreamerConfig   `mapstructure:"file"`
+		Index  IndexStreamerConfig  `mapstructure:"index"`
+		Plugin PluginStreamerConfig `mapstructure:"plugin"`
+	}
+
+	// StreamerFilterConfig defines the data forwarded by a streaming service.
+	StreamerFilterConfig struct {
+		// KeyPrefixes, given as store/hex-prefix, restrict the writes forwarded
+		// for the stores they name to the keys with one of the prefixes.
+		KeyPrefixes []string `mapstructure:"key-prefixes"`
+		// MessageTypes and EventTypes, if any, restrict the DeliverTx messages
+		// forwarded to the transactions with a message of one of the type URLs,
+		// or with an event of one of the types.
+		MessageTypes []string `mapstructure:"message-types"`
+		EventTypes   []string `mapstructure:"event-types"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
 	FileStreamerConfig struct {
-		Keys     []string `mapstructure:"keys"`
-		WriteDir string   `mapstructure:"write_dir"`
-		Prefix   string   `mapstructure:"prefix"`
+		Keys                 []string `mapstructure:"keys"`
+		StreamerFilterConfig `mapstructure:",squash"`
+		WriteDir             string `mapstructure:"write_dir"`
+		Prefix               string `mapstructure:"prefix"`
 		// OutputMetadata specifies if output the block metadata file which includes
 		// the abci requests/responses, otherwise only the data file is outputted.
 		OutputMetadata bool `mapstructure:"output-metadata"`
@@ -210,6 +254,42 @@ type (
 		// Fsync specifies if calling fsync after writing the files, it slows down
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
+		// Compression is the compression of the segment files, "zstd" or "none".
+		Compression string `mapstructure:"compression"`
+		// BlocksPerSegment is the number of blocks of a segment file.
+		BlocksPerSegment int `mapstructure:"blocks-per-segment"`
+		// KeepSegments is the number of segment files kept, the oldest ones
+		// being removed when a new one is started. All are kept if it is 0.
+		KeepSegments int `mapstructure:"keep-segments"`
+	}
+
+	// IndexStreamerConfig defines the configuration options of the historical
+	// index serving the queries at past heights.
+	IndexStreamerConfig struct {
+		// Keys must include all the IAVL stores.
+		Keys []string `mapstructure:"keys"`
+		// Dir is the directory of the index database, relative to the node home.
+		Dir string `mapstructure:"dir"`
+	}
+
+	// PluginStreamerConfig defines the configuration options of the streaming
+	// plugin, a process started by the node to which the ABCI messages and the
+	// state changes are forwarded over gRPC.
+	PluginStreamerConfig struct {
+		Keys                 []string `mapstructure:"keys"`
+		StreamerFilterConfig `mapstructure:",squash"`
+		// Path is the path of the plugin executable.
+		Path string `mapstructure:"path"`
+		// Args are the arguments the plugin is started with.
+		Args []string `mapstructure:"args"`
+		// Delivery is "block" to halt the node when the plugin fails to receive a
+		// message, or "drop" to never wait for the plugin and drop the messages
+		// it fails to receive.
+		Delivery string `mapstructure:"delivery"`
+		// BufferSize is the number of messages buffered with the "drop" delivery.
+		BufferSize int `mapstructure:"buffer-size"`
+		// Timeout bounds the time to forward a message to the plugin.
+		Timeout time.Duration `mapstructure:"timeout"`
 	}
 )
 
@@ -259,20 +339,22 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			InterBlockCache:     true,
-			Pruning:             storetypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningKeepEvery:    "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250, // 50 MB
-			IAVLDisableFastNode: true,
+			MinGasPrices:         defaultMinGasPrices,
+			InterBlockCache:      true,
+			Pruning:              storetypes.PruningOptionDefault,
+			PruningKeepRecent:    "0",
+			PruningKeepEvery:     "0",
+			PruningInterval:      "0",
+			MinRetainBlocks:      0,
+			IndexEvents:          make([]string, 0),
+			IAVLCacheSize:        781250, // 50 MB
+			IAVLDisableFastNode:  true,
+			CommitDumpKeepRecent: 10,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
+			StoreMetrics: []string{},
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -310,18 +392,57 @@ func DefaultConfig() *Config {
 		},
 		Streamers: StreamersConfig{
 			File: FileStreamerConfig{
-				Keys:            []string{"*"},
+				Keys: []string{"*"},
+				StreamerFilterConfig: StreamerFilterConfig{
+					KeyPrefixes:  []string{},
+					MessageTypes: []string{},
+					EventTypes:   []string{},
+				},
 				WriteDir:        "",
 				OutputMetadata:  true,
 				StopNodeOnError: true,
 				// NOTICE: The default config doesn't protect the streamer data integrity
 				// in face of system crash.
-				Fsync: false,
+				Fsync:            false,
+				Compression:      "zstd",
+				BlocksPerSegment: 1000,
+				KeepSegments:     0,
+			},
+			Index: IndexStreamerConfig{
+				Keys: []string{"*"},
+				Dir:  "data",
+			},
+			Plugin: PluginStreamerConfig{
+				Keys: []string{"*"},
+				StreamerFilterConfig: StreamerFilterConfig{
+					KeyPrefixes:  []string{},
+					MessageTypes: []string{},
+					EventTypes:   []string{},
+				},
+				Args:       []string{},
+				Delivery:   "block",
+				BufferSize: 1000,
+				Timeout:    10 * time.Second,
 			},
 		},
 	}
 }
 
+// validate checks the syntax of the key prefixes.
+func (f StreamerFilterConfig) validate() error {
+	for _, keyPrefix := range f.KeyPrefixes {
+		storeName, hexPrefix, ok := strings.Cut(keyPrefix, "/")
+		if !ok || storeName == "" {
+			return fmt.Errorf("key prefix '%s' is not store/hex-prefix", keyPrefix)
+		}
+		if _, err := hex.DecodeString(hexPrefix); err != nil {
+			return fmt.Errorf("key prefix '%s' is not hex encoded", keyPrefix)
+		}
+	}
+
+	return nil
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	conf := DefaultConfig()
@@ -341,6 +462,37 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", storetypes.PruningOptionEverything,
 		)
 	}
+	for storeName, storeConfig := range c.PruningStores {
+		switch storeConfig.Pruning {
+		case storetypes.PruningOptionDefault, storetypes.PruningOptionNothing, storetypes.PruningOptionCustom:
+		case storetypes.PruningOptionEverything:
+			if c.StateSync.SnapshotInterval > 0 {
+				return sdkerrors.ErrAppConfig.Wrapf(
+					"cannot enable state sync snapshots with '%s' pruning setting of store %s", storetypes.PruningOptionEverything, storeName,
+				)
+			}
+		default:
+			return sdkerrors.ErrAppConfig.Wrapf("unknown pruning strategy '%s' of store %s", storeConfig.Pruning, storeName)
+		}
+	}
+	if compression := c.Streamers.File.Compression; sdk.SliceContains(c.Store.Streamers, "file") && compression != "zstd" && compression != "none" {
+		return sdkerrors.ErrAppConfig.Wrapf("unknown file streamer compression '%s'", compression)
+	}
+	if delivery := c.Streamers.Plugin.Delivery; sdk.SliceContains(c.Store.Streamers, "plugin") && delivery != "block" && delivery != "drop" {
+		return sdkerrors.ErrAppConfig.Wrapf("unknown streaming plugin delivery '%s'", delivery)
+	}
+	filters := []StreamerFilterConfig{c.Streamers.File.StreamerFilterConfig, c.Streamers.Plugin.StreamerFilterConfig}
+	for i, streamer := range []string{"file", "plugin"} {
+		if !sdk.SliceContains(c.Store.Streamers, streamer) {
+			continue
+		}
+		if err := filters[i].validate(); err != nil {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid %s streamer filter: %s", streamer, err)
+		}
+	}
+	if len(c.Telemetry.StoreMetrics) > 0 && !c.Telemetry.Enabled {
+		return sdkerrors.ErrAppConfig.Wrap("cannot enable store metrics with telemetry disabled")
+	}
 
 	return nil
 }
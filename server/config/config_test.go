@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,97 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestPruningStoresConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.PruningStores = map[string]StorePruningConfig{
+		"bank": {Pruning: "nothing"},
+		"ibc":  {Pruning: "custom", PruningKeepRecent: "100", PruningKeepEvery: "0", PruningInterval: "10"},
+	}
+	require.NoError(t, cfg.ValidateBasic())
+
+	// the store pruning options survive a round trip through the config file
+	configPath := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+	readCfg, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.PruningStores, readCfg.PruningStores)
+
+	cfg.PruningStores["bank"] = StorePruningConfig{Pruning: "unknown"}
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestStoreMetricsConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Telemetry.StoreMetrics = []string{"bank", "staking"}
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Telemetry.Enabled = true
+	require.NoError(t, cfg.ValidateBasic())
+
+	// the store names survive a round trip through the config file
+	configPath := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+	readCfg, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.Telemetry.StoreMetrics, readCfg.Telemetry.StoreMetrics)
+}
+
+func TestPluginStreamerConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Store.Streamers = []string{"plugin"}
+	cfg.Streamers.Plugin.Delivery = "drop"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Streamers.Plugin.Delivery = "unknown"
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestFileStreamerConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Store.Streamers = []string{"file"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Streamers.File.Compression = "gzip"
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestStreamerFilterConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Store.Streamers = []string{"file", "plugin"}
+	cfg.Streamers.File.KeyPrefixes = []string{"bank/02", "acc/01"}
+	cfg.Streamers.File.MessageTypes = []string{"/cosmos.bank.v1beta1.MsgSend"}
+	cfg.Streamers.Plugin.EventTypes = []string{"transfer"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	// the filters survive a round trip through the config file, and are read
+	// from the streamers.<name> sections
+	configPath := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, []interface{}{"bank/02", "acc/01"}, v.Get("streamers.file.key-prefixes"))
+	readCfg, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.Streamers.File.StreamerFilterConfig, readCfg.Streamers.File.StreamerFilterConfig)
+	require.Equal(t, cfg.Streamers.Plugin.StreamerFilterConfig, readCfg.Streamers.Plugin.StreamerFilterConfig)
+
+	cfg.Streamers.Plugin.KeyPrefixes = []string{"bank"}
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Streamers.Plugin.KeyPrefixes = []string{"bank/0x02"}
+	require.Error(t, cfg.ValidateBasic())
+}
@@ -31,6 +31,11 @@ pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningAsync deletes the pruned states in a background routine instead of when committing
+# a block, which avoids commit latency spikes at pruning intervals. States being queried or
+# snapshotted are not deleted until they are no longer in use.
+pruning-async = {{ .BaseConfig.PruningAsync }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -78,6 +83,47 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is true.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# CommitDumpDir defines the directory to which the commit info (the version and
+# hash of each store) and the writes of the latest committed block are dumped, as
+# a commit-<height>.json file, when Tendermint rejects the next block because of
+# an app hash mismatch. The dump is to be compared with the commit info of the
+# same height on another node. A relative path is relative to the node home. If
+# empty, nothing is dumped.
+commit-dump-dir = "{{ .BaseConfig.CommitDumpDir }}"
+
+# CommitDumpKeepRecent defines the number of recent commit dumps to keep, all of
+# them being kept if 0.
+commit-dump-keep-recent = {{ .BaseConfig.CommitDumpKeepRecent }}
+
+###############################################################################
+###                        Store Pruning Configuration                      ###
+###############################################################################
+
+# Each [pruning-stores.<store>] table overrides the pruning options above for
+# the IAVL store with the given name, e.g. to keep the full history of some
+# stores only. The pruning-* options of a store are applied if and only if its
+# pruning strategy is custom.
+#
+# Note: the per-store options live under 'pruning-stores' rather than under
+# 'pruning', which is already the pruning strategy above.
+#
+# Example:
+# [pruning-stores.bank]
+# pruning = "nothing"
+#
+# [pruning-stores.ibc]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-keep-every = "0"
+# pruning-interval = "10"
+{{ range $name, $store := .BaseConfig.PruningStores }}
+[pruning-stores.{{ $name }}]
+pruning = "{{ $store.Pruning }}"
+pruning-keep-recent = "{{ $store.PruningKeepRecent }}"
+pruning-keep-every = "{{ $store.PruningKeepEvery }}"
+pruning-interval = "{{ $store.PruningInterval }}"
+{{ end }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -113,6 +159,15 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# StoreMetrics defines the names of the stores whose accesses are measured,
+# i.e. the latency of their operations, the lifetime of their iterators and
+# the sizes of the keys and values read and written. "*" measures all the
+# stores. Measuring the accesses slows them down.
+#
+# Example:
+# ["bank", "staking"]
+store-metrics = [{{ range .Telemetry.StoreMetrics }}"{{ . }}", {{ end }}]
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -231,6 +286,13 @@ streamers = [{{ range .Store.Streamers }}{{ printf "%q, " . }}{{end}}]
 [streamers]
 [streamers.file]
 keys = [{{ range .Streamers.File.Keys }}{{ printf "%q, " . }}{{end}}]
+# key-prefixes, given as "store/hex-prefix", restrict the writes forwarded for the stores they
+# name to the keys with one of the prefixes, e.g. ["bank/02"].
+key-prefixes = [{{ range .Streamers.File.KeyPrefixes }}{{ printf "%q, " . }}{{end}}]
+# message-types and event-types, if any, restrict the DeliverTx messages forwarded to the
+# transactions with a message of one of the type URLs, or with an event of one of the types.
+message-types = [{{ range .Streamers.File.MessageTypes }}{{ printf "%q, " . }}{{end}}]
+event-types = [{{ range .Streamers.File.EventTypes }}{{ printf "%q, " . }}{{end}}]
 write_dir = "{{ .Streamers.File.WriteDir }}"
 prefix = "{{ .Streamers.File.Prefix }}"
 
@@ -243,6 +305,47 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+# compression is the compression of the segment files, "zstd" or "none".
+compression = "{{ .Streamers.File.Compression }}"
+
+# blocks-per-segment is the number of blocks written to a segment file before a new one is started.
+blocks-per-segment = {{ .Streamers.File.BlocksPerSegment }}
+
+# keep-segments is the number of segment files kept, the oldest ones being removed when a new
+# one is started. All are kept if it is 0.
+keep-segments = {{ .Streamers.File.KeepSegments }}
+
+# The index streamer records the changes of the stores in a database to serve the gRPC
+# queries at past heights, even if pruned. It is seeded with the latest state on start.
+[streamers.index]
+# keys must include all the IAVL stores.
+keys = [{{ range .Streamers.Index.Keys }}{{ printf "%q, " . }}{{end}}]
+# dir is the directory of the index database, relative to the node home if not absolute.
+dir = "{{ .Streamers.Index.Dir }}"
+
+# The plugin streamer forwards the ABCI messages and the state changes of each block to a
+# plugin, a process started by the node and serving the ABCIListenerService over gRPC.
+[streamers.plugin]
+keys = [{{ range .Streamers.Plugin.Keys }}{{ printf "%q, " . }}{{end}}]
+# key-prefixes, given as "store/hex-prefix", restrict the writes forwarded for the stores they
+# name to the keys with one of the prefixes, e.g. ["bank/02"].
+key-prefixes = [{{ range .Streamers.Plugin.KeyPrefixes }}{{ printf "%q, " . }}{{end}}]
+# message-types and event-types, if any, restrict the DeliverTx messages forwarded to the
+# transactions with a message of one of the type URLs, or with an event of one of the types.
+message-types = [{{ range .Streamers.Plugin.MessageTypes }}{{ printf "%q, " . }}{{end}}]
+event-types = [{{ range .Streamers.Plugin.EventTypes }}{{ printf "%q, " . }}{{end}}]
+# path is the path of the plugin executable.
+path = "{{ .Streamers.Plugin.Path }}"
+# args are the arguments the plugin is started with.
+args = [{{ range .Streamers.Plugin.Args }}{{ printf "%q, " . }}{{end}}]
+# delivery is "block" to halt the node when the plugin fails to receive a message, or
+# "drop" to never wait for the plugin and drop the messages it fails to receive.
+delivery = "{{ .Streamers.Plugin.Delivery }}"
+# buffer-size is the number of messages buffered with the "drop" delivery.
+buffer-size = {{ .Streamers.Plugin.BufferSize }}
+# timeout bounds the time to forward a message to the plugin.
+timeout = "{{ .Streamers.Plugin.Timeout }}"
 `
 
 var configTemplate *template.Template
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"strings"
+
 	"github.com/rs/zerolog"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 )
@@ -41,6 +43,36 @@ func (z ZeroLogWrapper) With(keyVals ...interface{}) tmlog.Logger {
 	return ZeroLogWrapper{z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
 }
 
+// appHashMismatchErr is the error logged by Tendermint when a block is rejected
+// because its app hash differs from the one of the latest committed block.
+const appHashMismatchErr = "wrong Block.Header.AppHash"
+
+var _ tmlog.Logger = appHashMismatchLogger{}
+
+// appHashMismatchLogger wraps the logger given to Tendermint to report the app
+// hash mismatches it logs, Tendermint not reporting them to the application.
+type appHashMismatchLogger struct {
+	tmlog.Logger
+	report func()
+}
+
+// Error implements Tendermint's Logger interface, reporting the app hash
+// mismatch errors.
+func (l appHashMismatchLogger) Error(msg string, keyVals ...interface{}) {
+	l.Logger.Error(msg, keyVals...)
+	for _, v := range keyVals {
+		if err, ok := v.(error); ok && strings.Contains(err.Error(), appHashMismatchErr) {
+			l.report()
+			return
+		}
+	}
+}
+
+// With implements Tendermint's Logger interface.
+func (l appHashMismatchLogger) With(keyVals ...interface{}) tmlog.Logger {
+	return appHashMismatchLogger{Logger: l.Logger.With(keyVals...), report: l.report}
+}
+
 func getLogFields(keyVals ...interface{}) map[string]interface{} {
 	if len(keyVals)%2 != 0 {
 		return nil
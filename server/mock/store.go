@@ -117,6 +117,18 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetStorePruning(storeName string, opts sdk.PruningOptions) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetAsyncPruning(async bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetStoreMetrics(storeName string, enabled bool) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetIAVLCacheSize(size int) {
 	panic("not implemented")
 }
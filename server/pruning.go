@@ -38,3 +38,41 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (storetypes.PruningOpt
 		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// GetStorePruningOptionsFromFlags parses the pruning options of the stores set
+// under the pruning-stores section of the app configuration. Each store takes
+// the same pruning options as the ones parsed by GetPruningOptionsFromFlags.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]storetypes.PruningOptions, error) {
+	opts := make(map[string]storetypes.PruningOptions)
+
+	v := appOpts.Get(FlagPruningStores)
+	if v == nil {
+		return opts, nil
+	}
+	stores, err := cast.ToStringMapE(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", FlagPruningStores, err)
+	}
+
+	for storeName, storeOpts := range stores {
+		m, err := cast.ToStringMapE(storeOpts)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", storeName, err)
+		}
+
+		po, err := GetPruningOptionsFromFlags(storePruningOptions(m))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", storeName, err)
+		}
+		opts[storeName] = po
+	}
+
+	return opts, nil
+}
+
+// storePruningOptions exposes the pruning options of a store as AppOptions.
+type storePruningOptions map[string]interface{}
+
+func (o storePruningOptions) Get(key string) interface{} {
+	return o[key]
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -71,3 +72,39 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+pruning = "default"
+
+[pruning-stores.bank]
+pruning = "nothing"
+
+[pruning-stores.ibc]
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-keep-every = "0"
+pruning-interval = "10"
+`)))
+
+	opts, err := GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]types.PruningOptions{
+		"bank": types.PruneNothing,
+		"ibc":  types.NewPruningOptions(100, 0, 10),
+	}, opts)
+
+	// no store pruning options
+	opts, err = GetStorePruningOptionsFromFlags(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	// invalid store pruning options
+	v.Set(FlagPruningStores, map[string]interface{}{
+		"bank": map[string]interface{}{FlagPruning: "unknown"},
+	})
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.EqualError(t, err, "invalid pruning options of store bank: unknown pruning strategy unknown")
+}
@@ -1,15 +1,36 @@
 package server
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
 
-	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/server/types"
+	tmdb "github.com/cometbft/cometbft-db"
 	"github.com/spf13/cobra"
 	tmcmd "github.com/tendermint/tendermint/cmd/cometbft/commands"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/node"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/proto/tendermint/store"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/version"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
-// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
+const flagToHeight = "to-height"
+
+// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height,
+// or to a given height.
 func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
@@ -21,6 +42,16 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also roll back to height n - 1. No blocks are removed, so upon
 restarting Tendermint the transactions in block n will be re-executed against the
 application.
+
+If the --to-height flag is set, the state is rolled back over several heights to the
+given height, which must be retained by every store of the application. The rollback
+is refused if a store lacks the target version, or if the app hash of the application
+at the target height differs from the one agreed upon in the next block. The blocks
+above the block following the target height are removed, so that upon restarting
+Tendermint that block is re-executed and the later blocks are fetched again.
+
+If the --dry-run flag is set, the versions of the stores and the app hash of each
+height from the target height are reported, and no state is modified.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
@@ -30,7 +61,15 @@ application.
 			if err != nil {
 				return err
 			}
+			defer db.Close()
 			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			toHeight, _ := cmd.Flags().GetInt64(flagToHeight)
+			dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+			if toHeight != 0 || dryRun {
+				return rollbackToHeight(cmd.OutOrStdout(), cfg, app.CommitMultiStore(), toHeight, dryRun)
+			}
+
 			// rollback tendermint state
 			height, hash, err := tmcmd.RollbackState(ctx.Config)
 			if err != nil {
@@ -48,5 +87,261 @@ application.
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagToHeight, 0, "Rollback the state to the given height instead of by one height")
+	cmd.Flags().Bool(flags.FlagDryRun, false, "Report the store versions and app hashes of the heights to roll back without modifying any state")
 	return cmd
 }
+
+// rollbackToHeight rolls back the tendermint state and the multistore to the
+// target height, which defaults to one height below the tendermint state, after
+// checking both can be rolled back consistently, and removes the blocks above
+// the next one. In dry-run mode, it only reports the store versions and app
+// hashes from the target height.
+func rollbackToHeight(out io.Writer, config *tmcfg.Config, cms storetypes.CommitMultiStore, target int64, dryRun bool) error {
+	rs, ok := cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("rollback to a height is not supported by multistore %T", cms)
+	}
+
+	blockStoreDB, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB)
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if state.IsEmpty() {
+		return errors.New("no state found")
+	}
+	if target == 0 {
+		target = state.LastBlockHeight - 1
+	}
+
+	if dryRun {
+		appHeight := rs.LastCommitID().Version
+		fmt.Fprintf(out, "Tendermint state height: %d, block store height: %d, application height: %d\n",
+			state.LastBlockHeight, blockStore.Height(), appHeight)
+
+		for height := target; height <= appHeight; height++ {
+			reportRollbackHeight(out, blockStore, rs, height)
+		}
+	}
+
+	rolledBackState, err := rollbackState(blockStore, stateStore, state, target)
+	if err != nil {
+		return fmt.Errorf("cannot rollback tendermint state to height %d: %w", target, err)
+	}
+
+	cInfo, err := rs.RollbackCommitInfo(target)
+	if err != nil {
+		return fmt.Errorf("cannot rollback the multistore to version %d: %w", target, err)
+	}
+	if !bytes.Equal(cInfo.Hash(), rolledBackState.AppHash) {
+		return fmt.Errorf("app hash %X of version %d differs from the app hash %X of block %d",
+			cInfo.Hash(), target, rolledBackState.AppHash, target+1)
+	}
+
+	if dryRun {
+		fmt.Fprintf(out, "State can be rolled back to height %d and hash %X\n", target, rolledBackState.AppHash)
+		if height := blockStore.Height(); height > target+1 {
+			fmt.Fprintf(out, "Blocks %d to %d would be removed\n", target+2, height)
+		}
+		return nil
+	}
+
+	if err := stateStore.Save(rolledBackState); err != nil {
+		return fmt.Errorf("failed to save rolled back tendermint state: %w", err)
+	}
+	if err := rs.RollbackToVersion(target); err != nil {
+		return fmt.Errorf("failed to rollback to version: %w", err)
+	}
+	// tendermint only replays the block following its state on restart
+	if err := deleteBlocksAbove(blockStoreDB, blockStore, target+1); err != nil {
+		return fmt.Errorf("failed to remove the blocks above height %d: %w", target+1, err)
+	}
+
+	fmt.Fprintf(out, "Rolled back state to height %d and hash %X\n", target, rolledBackState.AppHash)
+	return nil
+}
+
+// reportRollbackHeight writes the stores lacking the version of the given
+// height, or the app hash the multistore would have after a rollback to it.
+func reportRollbackHeight(out io.Writer, blockStore *store.BlockStore, rs *rootmulti.Store, height int64) {
+	if missing := rs.StoresMissingVersion(height); len(missing) != 0 {
+		fmt.Fprintf(out, "height %d: version missing in stores %s\n", height, strings.Join(missing, ", "))
+		return
+	}
+
+	cInfo, err := rs.RollbackCommitInfo(height)
+	if err != nil {
+		fmt.Fprintf(out, "height %d: %s\n", height, err)
+		return
+	}
+
+	line := fmt.Sprintf("height %d: app hash %X", height, cInfo.Hash())
+	if meta := blockStore.LoadBlockMeta(height + 1); meta != nil {
+		if bytes.Equal(meta.Header.AppHash, cInfo.Hash()) {
+			line += fmt.Sprintf(", matches block %d", height+1)
+		} else {
+			line += fmt.Sprintf(", differs from the app hash %X of block %d", meta.Header.AppHash, height+1)
+		}
+	}
+	fmt.Fprintln(out, line)
+}
+
+// rollbackState returns the tendermint state at the target height, obtained by
+// rolling back the given state one height at a time as tendermint does.
+func rollbackState(bs *store.BlockStore, ss sm.Store, state sm.State, target int64) (sm.State, error) {
+	if target > state.LastBlockHeight {
+		return state, fmt.Errorf("target height is above the state height %d", state.LastBlockHeight)
+	}
+	if target < bs.Base() {
+		return state, fmt.Errorf("target height is below the lowest retained block %d", bs.Base())
+	}
+	if bs.Height() < state.LastBlockHeight {
+		return state, fmt.Errorf("block store height (%d) is below the state height (%d)", bs.Height(), state.LastBlockHeight)
+	}
+
+	for state.LastBlockHeight > target {
+		var err error
+		state, err = previousState(bs, ss, state)
+		if err != nil {
+			return state, err
+		}
+	}
+
+	return state, nil
+}
+
+// previousState returns the tendermint state at the height below the given
+// state, as built by the tendermint rollback.
+func previousState(bs *store.BlockStore, ss sm.Store, invalidState sm.State) (sm.State, error) {
+	rollbackHeight := invalidState.LastBlockHeight - 1
+	rollbackBlock := bs.LoadBlockMeta(rollbackHeight)
+	if rollbackBlock == nil {
+		return invalidState, fmt.Errorf("block at height %d not found", rollbackHeight)
+	}
+	// the app hash and last results hash are only agreed upon in the following block
+	latestBlock := bs.LoadBlockMeta(invalidState.LastBlockHeight)
+	if latestBlock == nil {
+		return invalidState, fmt.Errorf("block at height %d not found", invalidState.LastBlockHeight)
+	}
+
+	previousLastValidatorSet, err := ss.LoadValidators(rollbackHeight)
+	if err != nil {
+		return invalidState, err
+	}
+
+	previousParams, err := ss.LoadConsensusParams(rollbackHeight + 1)
+	if err != nil {
+		return invalidState, err
+	}
+
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	if valChangeHeight > rollbackHeight {
+		valChangeHeight = rollbackHeight + 1
+	}
+
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	if paramsChangeHeight > rollbackHeight {
+		paramsChangeHeight = rollbackHeight + 1
+	}
+
+	return sm.State{
+		Version: tmstate.Version{
+			Consensus: tmversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   previousParams.Version.AppVersion,
+			},
+			Software: version.TMCoreSemVer,
+		},
+		ChainID:       invalidState.ChainID,
+		InitialHeight: invalidState.InitialHeight,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		NextValidators:              invalidState.Validators,
+		Validators:                  invalidState.LastValidators,
+		LastValidators:              previousLastValidatorSet,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  previousParams,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: latestBlock.Header.LastResultsHash,
+		AppHash:         latestBlock.Header.AppHash,
+	}, nil
+}
+
+// deleteBlocksAbove removes the blocks above the given height from the block
+// store. As when tendermint prunes blocks, the new store height is saved first,
+// so that the store never covers a missing block. The keys are those of the
+// tendermint block store, which does not provide a way to remove the latest
+// blocks.
+func deleteBlocksAbove(db tmdb.DB, bs *store.BlockStore, height int64) error {
+	top := bs.Height()
+	if top <= height {
+		return nil
+	}
+
+	store.SaveBlockStoreState(&tmstore.BlockStoreState{Base: bs.Base(), Height: height}, db)
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	for h := height + 1; h <= top; h++ {
+		meta := bs.LoadBlockMeta(h)
+		if meta == nil {
+			continue
+		}
+		keys := [][]byte{
+			[]byte(fmt.Sprintf("H:%v", h)),
+			[]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)),
+			[]byte(fmt.Sprintf("C:%v", h)),
+			[]byte(fmt.Sprintf("SC:%v", h)),
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", h, p)))
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// loadStateAndBlockStore opens the tendermint block store database and the
+// state store.
+func loadStateAndBlockStore(config *tmcfg.Config) (tmdb.DB, sm.Store, error) {
+	for _, name := range []string{"blockstore", "state"} {
+		if !tmos.FileExists(filepath.Join(config.DBDir(), name+".db")) {
+			return nil, nil, fmt.Errorf("no %s found in %v", name, config.DBDir())
+		}
+	}
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+
+	return blockStoreDB, sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	}), nil
+}
@@ -0,0 +1,108 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmconsensus "github.com/tendermint/tendermint/consensus"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRollbackToHeight(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	newApp := func(logger log.Logger, db dbm.DB, _ io.Writer, appOpts types.AppOptions) types.Application {
+		return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, "", 0, encCfg, appOpts,
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(storetypes.PruningOptionNothing)))
+	}
+
+	// run a node with its application database on disk until a few blocks are
+	// committed
+	var appDB dbm.DB
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+	cfg.AppConstructor = func(val network.Validator) types.Application {
+		var err error
+		appDB, err = sdk.NewLevelDB("application", filepath.Join(val.Ctx.Config.RootDir, "data"))
+		require.NoError(t, err)
+		return newApp(val.Ctx.Logger, appDB, nil, simapp.EmptyAppOptions{})
+	}
+	cfg.CleanupDir = false
+	net := network.New(t, cfg)
+	t.Cleanup(func() { require.NoError(t, os.RemoveAll(net.BaseDir)) })
+	val := net.Validators[0]
+	_, err := net.WaitForHeight(6)
+	require.NoError(t, err)
+	net.Cleanup()
+	require.NoError(t, appDB.Close())
+
+	tmCfg := val.Ctx.Config
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config = tmCfg
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: tmCfg})
+	require.NoError(t, err)
+	height := store.NewBlockStore(blockStoreDB).Height()
+	require.NoError(t, blockStoreDB.Close())
+	target := height - 4
+
+	out := &bytes.Buffer{}
+	cmd := server.NewRollbackCmd(newApp, tmCfg.RootDir)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--to-height", strconv.FormatInt(target, 10)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "Rolled back state to height")
+
+	// reopening the node replays the block following the rolled back state
+	blockStoreDB, err = node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: tmCfg})
+	require.NoError(t, err)
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+	require.Equal(t, target+1, blockStore.Height())
+	require.Nil(t, blockStore.LoadBlockMeta(target+2))
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: tmCfg})
+	require.NoError(t, err)
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, target, state.LastBlockHeight)
+
+	appDB, err = sdk.NewLevelDB("application", filepath.Join(tmCfg.RootDir, "data"))
+	require.NoError(t, err)
+	defer appDB.Close()
+	app := newApp(log.NewNopLogger(), appDB, nil, simapp.EmptyAppOptions{})
+	require.Equal(t, target, app.CommitMultiStore().LastCommitID().Version)
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck
+
+	genDoc, err := tmtypes.GenesisDocFromFile(tmCfg.GenesisFile())
+	require.NoError(t, err)
+	handshaker := tmconsensus.NewHandshaker(stateStore, state, blockStore, genDoc)
+	handshaker.SetLogger(log.NewNopLogger())
+	require.NoError(t, handshaker.Handshake(proxyApp))
+	require.Equal(t, target+1, app.CommitMultiStore().LastCommitID().Version)
+}
@@ -67,6 +67,16 @@ type (
 		RegisterNodeService(client.Context)
 	}
 
+	// AppHashMismatchReporter defines an extension of the Application interface
+	// for the applications diagnosing the app hash mismatches detected by
+	// Tendermint.
+	AppHashMismatchReporter interface {
+		// ReportAppHashMismatch is called when Tendermint rejects a block because
+		// the app hash of the latest committed block differs from the one agreed
+		// upon by the network.
+		ReportAppHashMismatch()
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer, AppOptions) Application
@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -27,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -380,6 +382,31 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
+// GetAppDBBackend returns the backend of the application database, given by
+// the FlagAppDBBackend app option, or the backend the binary was built with.
+func GetAppDBBackend(appOpts types.AppOptions) dbm.BackendType {
+	if backend := cast.ToString(appOpts.Get(FlagAppDBBackend)); len(backend) != 0 {
+		return dbm.BackendType(backend)
+	}
+	if len(sdk.DBBackend) != 0 {
+		return dbm.BackendType(sdk.DBBackend)
+	}
+
+	return dbm.GoLevelDBBackend
+}
+
+// GetSnapshotStore opens the snapshot store of the node home directory given
+// by the flags.FlagHome app option, under data/snapshots.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
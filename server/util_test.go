@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -400,3 +402,15 @@ func TestInterceptConfigsWithBadPermissions(t *testing.T) {
 		t.Fatalf("Failed to catch permissions error, got: [%T] %v", err, err)
 	}
 }
+
+func TestGetAppDBBackend(t *testing.T) {
+	v := viper.New()
+	if backend := server.GetAppDBBackend(v); backend != dbm.GoLevelDBBackend {
+		t.Errorf("expected the default backend, got %s", backend)
+	}
+
+	v.Set(server.FlagAppDBBackend, string(dbm.MemDBBackend))
+	if backend := server.GetAppDBBackend(v); backend != dbm.MemDBBackend {
+		t.Errorf("expected the configured backend, got %s", backend)
+	}
+}
@@ -0,0 +1,174 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// archiveMetadataName is the name of the archive entry holding the snapshot
+// metadata, the chunks being held by the following entries named by their
+// index.
+const archiveMetadataName = "metadata"
+
+// Export writes a snapshot to w as a zstd compressed tar archive, holding the
+// protobuf encoded snapshot metadata followed by the chunks in order.
+func (s *Store) Export(height uint64, format uint32, w io.Writer) error {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(zw)
+
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	if err := writeArchiveEntry(tw, archiveMetadataName, int64(len(metadata)), bytes.NewReader(metadata)); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if err := s.exportChunk(tw, height, format, i); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func (s *Store) exportChunk(tw *tar.Writer, height uint64, format uint32, chunk uint32) error {
+	file, err := os.Open(s.pathChunk(height, format, chunk))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to open snapshot chunk %v", chunk)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	return writeArchiveEntry(tw, strconv.FormatUint(uint64(chunk), 10), info.Size(), file)
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: size}); err != nil {
+		return sdkerrors.Wrapf(err, "failed to write archive entry %s", name)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return sdkerrors.Wrapf(err, "failed to write archive entry %s", name)
+	}
+
+	return nil
+}
+
+// Import saves the snapshot of an archive written by Export, returning it. The
+// snapshot is removed if its chunks do not match the hashes of its metadata.
+func (s *Store) Import(r io.Reader) (*types.Snapshot, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	header, err := tr.Next()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read archive")
+	}
+	if header.Name != archiveMetadataName {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "unexpected archive entry %s", header.Name)
+	}
+	metadata, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot metadata")
+	}
+	expected := &types.Snapshot{}
+	if err := proto.Unmarshal(metadata, expected); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode snapshot metadata")
+	}
+
+	// stream the chunks of the archive to Save, which stops reading them on
+	// the first error
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < expected.Chunks; i++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+
+			header, err := tr.Next()
+			if err == nil && header.Name != strconv.FormatUint(uint64(i), 10) {
+				err = sdkerrors.Wrapf(types.ErrInvalidMetadata, "unexpected archive entry %s, expected chunk %v", header.Name, i)
+			}
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			if err == nil {
+				_, err = io.Copy(pw, tr)
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			pw.Close()
+		}
+	}()
+
+	snapshot, err := s.Save(expected.Height, expected.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyImport(expected, snapshot); err != nil {
+		if delErr := s.Delete(snapshot.Height, snapshot.Format); delErr != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to delete snapshot: %v", delErr)
+		}
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// verifyImport checks that an imported snapshot matches the metadata of the
+// archive.
+func verifyImport(expected, snapshot *types.Snapshot) error {
+	if snapshot.Chunks != expected.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "archive has %v chunks, expected %v", snapshot.Chunks, expected.Chunks)
+	}
+	if len(expected.Metadata.ChunkHashes) != len(snapshot.Metadata.ChunkHashes) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(expected.Metadata.ChunkHashes), expected.Chunks)
+	}
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, expected.Metadata.ChunkHashes[i]) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x", i, expected.Metadata.ChunkHashes[i], hash)
+		}
+	}
+	if !bytes.Equal(snapshot.Hash, expected.Hash) {
+		return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "snapshot hash: expected %x, got %x", expected.Hash, snapshot.Hash)
+	}
+
+	return nil
+}
@@ -37,6 +37,11 @@ type restoreDone struct {
 // long-running operation is in progress at any given time, and provides convenience methods
 // mirroring the ABCI interface.
 //
+// The chunks of a restore are saved to the store as they are received, so that a restore
+// interrupted by a crash continues from the last received chunk when the same snapshot is
+// offered again: the saved chunks are replayed to the restore while the chunks fed again are
+// only verified.
+//
 // Although the ABCI interface (and this manager) passes chunks as byte slices, the internal
 // snapshot/restore APIs use IO streams (i.e. chan io.ReadCloser), for two reasons:
 //
@@ -57,6 +62,10 @@ type Manager struct {
 	chRestoreDone      <-chan restoreDone
 	restoreChunkHashes [][]byte
 	restoreChunkIndex  uint32
+	// the number of chunks replayed from an interrupted restore, and a channel closed once
+	// they are passed to the restore
+	restoreReplayed   uint32
+	chRestoreReplayed <-chan struct{}
 }
 
 // NewManager creates a new manager.
@@ -122,12 +131,20 @@ func (m *Manager) end() {
 func (m *Manager) endLocked() {
 	m.operation = opNone
 	if m.chRestore != nil {
+		<-m.chRestoreReplayed
 		close(m.chRestore)
 		m.chRestore = nil
 	}
+	if m.chRestoreReplayed != nil {
+		// the saved chunks are only useful to resume an interrupted restore, removing them is
+		// best effort
+		_ = m.store.endRestore()
+	}
 	m.chRestoreDone = nil
 	m.restoreChunkHashes = nil
 	m.restoreChunkIndex = 0
+	m.restoreReplayed = 0
+	m.chRestoreReplayed = nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -207,6 +224,11 @@ func (m *Manager) List() ([]*types.Snapshot, error) {
 	return m.store.List()
 }
 
+// Get returns a snapshot, or nil if it does not exist. It can be concurrent with other operations.
+func (m *Manager) Get(height uint64, format uint32) (*types.Snapshot, error) {
+	return m.store.Get(height, format)
+}
+
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
 // concurrently with other operations. If the chunk does not exist, nil is returned.
 func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
@@ -263,9 +285,16 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return err
 	}
 
+	replayed, err := m.store.beginRestore(&snapshot)
+	if err != nil {
+		m.endLocked()
+		return err
+	}
+
 	// Start an asynchronous snapshot restoration, passing chunks and completion status via channels.
 	chChunks := make(chan io.ReadCloser, chunkBufferSize)
 	chDone := make(chan restoreDone, 1)
+	chReplayed := make(chan struct{})
 
 	go func() {
 		err := m.restoreSnapshot(snapshot, chChunks)
@@ -276,17 +305,81 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		close(chDone)
 	}()
 
+	// Replay the chunks saved by an interrupted restore, concurrently with the chunks being fed again.
+	go func() {
+		defer close(chReplayed)
+		for i := uint32(0); i < replayed; i++ {
+			chunk, err := m.store.loadRestoreChunk(i)
+			if err != nil {
+				pr, pw := io.Pipe()
+				pw.CloseWithError(err)
+				chChunks <- pr
+				return
+			}
+			chChunks <- chunk
+		}
+	}()
+
 	m.chRestore = chChunks
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+	m.restoreReplayed = replayed
+	m.chRestoreReplayed = chReplayed
 	return nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot of the local
+// store, blocking until the restore is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+
+	if snapshot.Format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if snapshot.Height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
+	}
+
+	// verify all the chunks before restoring any
+	if err := m.store.verifyChunks(snapshot); err != nil {
+		return err
+	}
+
+	if err := m.begin(opRestore); err != nil {
+		return err
+	}
+	defer m.end()
+
+	return m.restoreSnapshot(*snapshot, chChunks)
+}
+
+// ExportArchive writes a snapshot of the local store to w as an archive, see
+// Store.Export.
+func (m *Manager) ExportArchive(height uint64, format uint32, w io.Writer) error {
+	return m.store.Export(height, format, w)
+}
+
+// ImportArchive saves the snapshot of an archive to the local store, see
+// Store.Import.
+func (m *Manager) ImportArchive(r io.Reader) (*types.Snapshot, error) {
+	return m.store.Import(r)
+}
+
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
+		// the chunks are drained, like on the other errors, so that their senders never block
+		DrainChunks(chChunks)
 		return err
 	}
 	defer streamReader.Close()
@@ -348,14 +441,23 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	expected := m.restoreChunkHashes[m.restoreChunkIndex]
 	if !bytes.Equal(hash[:], expected) {
 		return false, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
-			"expected %x, got %x", hash, expected)
+			"expected %x, got %x", expected, hash)
 	}
 
-	// Pass the chunk to the restore, and wait for completion if it was the final one.
-	m.chRestore <- io.NopCloser(bytes.NewReader(chunk))
+	// Save the chunk and pass it to the restore, after the replayed chunks, unless it was
+	// replayed. Then wait for completion if it was the final one.
+	if m.restoreChunkIndex >= m.restoreReplayed {
+		if err := m.store.saveRestoreChunk(m.restoreChunkIndex, chunk); err != nil {
+			m.endLocked()
+			return false, err
+		}
+		<-m.chRestoreReplayed
+		m.chRestore <- io.NopCloser(bytes.NewReader(chunk))
+	}
 	m.restoreChunkIndex++
 
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
+		<-m.chRestoreReplayed
 		close(m.chRestore)
 		m.chRestore = nil
 		done := <-m.chRestoreDone
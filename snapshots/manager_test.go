@@ -2,13 +2,17 @@ package snapshots_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -210,3 +214,103 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	expectItems := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	_, err := store.Save(4, types.CurrentFormat, makeChunks(snapshotItems(expectItems)))
+	require.NoError(t, err)
+
+	// Restoring a missing snapshot or an unknown format fails
+	err = manager.RestoreLocalSnapshot(5, types.CurrentFormat)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	err = manager.RestoreLocalSnapshot(3, 2)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	require.NoError(t, manager.RestoreLocalSnapshot(4, types.CurrentFormat))
+	assert.Equal(t, expectItems, target.items)
+
+	// The restore is complete, other operations can proceed
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
+
+func TestManager_Restore_Resume(t *testing.T) {
+	store := setupStore(t)
+
+	expectItems := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	// the stream of items is split into 3 chunks
+	stream := snapshotItems(expectItems)[0]
+	third := len(stream) / 3
+	chunks := [][]byte{stream[:third], stream[third : 2*third], stream[2*third:]}
+	snapshot := types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Hash:     hash(chunks),
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}
+
+	// the node crashes after applying 2 chunks
+	manager := snapshots.NewManager(store, &mockSnapshotter{})
+	require.NoError(t, manager.Restore(snapshot))
+	for _, chunk := range chunks[:2] {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.False(t, done)
+	}
+
+	// after a restart, the saved chunks are replayed when the same snapshot is
+	// offered again, the chunks fed again being only verified
+	target := &mockSnapshotter{}
+	manager = snapshots.NewManager(store, target)
+	require.NoError(t, manager.Restore(snapshot))
+
+	_, err := manager.RestoreChunk(chunks[1])
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+	for i, chunk := range chunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == len(chunks)-1, done)
+	}
+	assert.Equal(t, expectItems, target.items)
+
+	// the saved chunks are removed once the restore is complete
+	target = &mockSnapshotter{}
+	manager = snapshots.NewManager(store, target)
+	require.NoError(t, manager.Restore(snapshot))
+	for _, chunk := range chunks {
+		_, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, expectItems, target.items)
+}
+
+func TestManager_RestoreLocalSnapshot_Corrupted(t *testing.T) {
+	dir := t.TempDir()
+	store, err := snapshots.NewStore(db.NewMemDB(), dir)
+	require.NoError(t, err)
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	chunks := snapshotItems([][]byte{{1, 2, 3}})
+	_, err = store.Save(4, types.CurrentFormat, makeChunks(chunks))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "4", "1", "0"), []byte{9, 9, 9}, 0o600))
+
+	// the chunks are verified before any is restored
+	err = manager.RestoreLocalSnapshot(4, types.CurrentFormat)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+	require.Nil(t, target.items)
+}
@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"io"
@@ -20,6 +21,11 @@ import (
 const (
 	// keyPrefixSnapshot is the prefix for snapshot database keys
 	keyPrefixSnapshot byte = 0x01
+	// keyRestoreProgress is the database key of the snapshot being restored
+	keyRestoreProgress byte = 0x02
+
+	// restoreDirName is the directory of the chunks of the snapshot being restored
+	restoreDirName = "restore"
 )
 
 // Store is a snapshot store, containing snapshot metadata and binary chunks.
@@ -303,6 +309,106 @@ func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	return sdkerrors.Wrap(err, "failed to store snapshot")
 }
 
+// beginRestore records the snapshot being restored, whose chunks are saved by
+// saveRestoreChunk as they are received. If it is the snapshot of an
+// interrupted restore, it returns the number of chunks saved by that restore,
+// verified against the chunk hashes, otherwise the chunks of the interrupted
+// restore are removed.
+func (s *Store) beginRestore(snapshot *types.Snapshot) (uint32, error) {
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	interrupted, err := s.db.Get([]byte{keyRestoreProgress})
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "failed to load restore progress")
+	}
+	if bytes.Equal(interrupted, value) {
+		return s.countRestoreChunks(snapshot), nil
+	}
+
+	if err := os.RemoveAll(s.pathRestore()); err != nil {
+		return 0, sdkerrors.Wrap(err, "failed to remove restore directory")
+	}
+	if err := os.MkdirAll(s.pathRestore(), 0o755); err != nil {
+		return 0, sdkerrors.Wrap(err, "failed to create restore directory")
+	}
+	err = s.db.SetSync([]byte{keyRestoreProgress}, value)
+	return 0, sdkerrors.Wrap(err, "failed to store restore progress")
+}
+
+// countRestoreChunks returns the number of consecutive chunks of the snapshot
+// being restored saved with a valid hash.
+func (s *Store) countRestoreChunks(snapshot *types.Snapshot) uint32 {
+	var count uint32
+	for ; count < snapshot.Chunks; count++ {
+		chunk, err := os.ReadFile(s.pathRestoreChunk(count))
+		if err != nil {
+			break
+		}
+		hash := sha256.Sum256(chunk)
+		if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[count]) {
+			break
+		}
+	}
+	return count
+}
+
+// saveRestoreChunk saves a chunk of the snapshot being restored.
+func (s *Store) saveRestoreChunk(index uint32, chunk []byte) error {
+	err := os.WriteFile(s.pathRestoreChunk(index), chunk, 0o644)
+	return sdkerrors.Wrapf(err, "failed to save restore chunk %v", index)
+}
+
+// loadRestoreChunk loads a chunk of the snapshot being restored.
+func (s *Store) loadRestoreChunk(index uint32) (io.ReadCloser, error) {
+	return os.Open(s.pathRestoreChunk(index))
+}
+
+// endRestore removes the record and the chunks of the snapshot being restored.
+func (s *Store) endRestore() error {
+	if err := s.db.DeleteSync([]byte{keyRestoreProgress}); err != nil {
+		return sdkerrors.Wrap(err, "failed to delete restore progress")
+	}
+	return os.RemoveAll(s.pathRestore())
+}
+
+// pathRestore generates the path to the chunks of the snapshot being restored.
+func (s *Store) pathRestore() string {
+	return filepath.Join(s.dir, restoreDirName)
+}
+
+// pathRestoreChunk generates the path to a chunk of the snapshot being restored.
+func (s *Store) pathRestoreChunk(index uint32) string {
+	return filepath.Join(s.pathRestore(), strconv.FormatUint(uint64(index), 10))
+}
+
+// verifyChunks verifies the chunks of a snapshot against its chunk hashes.
+func (s *Store) verifyChunks(snapshot *types.Snapshot) error {
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+	hasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := s.loadChunkFile(snapshot.Height, snapshot.Format, i)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to open snapshot chunk %v", i)
+		}
+		hasher.Reset()
+		_, err = io.Copy(hasher, chunk)
+		chunk.Close()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", i)
+		}
+		if hash := hasher.Sum(nil); !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x",
+				i, snapshot.Metadata.ChunkHashes[i], hash)
+		}
+	}
+	return nil
+}
+
 // pathHeight generates the path to a height, containing multiple snapshot formats.
 func (s *Store) pathHeight(height uint64) string {
 	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
@@ -341,3 +341,46 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_ExportImport(t *testing.T) {
+	dir := t.TempDir()
+	store, err := snapshots.NewStore(db.NewMemDB(), dir)
+	require.NoError(t, err)
+	expected, err := store.Save(2, 1, makeChunks([][]byte{{2, 1, 0}, {2, 1, 1}, {2, 1, 2}}))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, store.Export(2, 1, &archive))
+	require.Error(t, store.Export(9, 1, &bytes.Buffer{}))
+
+	target, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	// a truncated archive fails
+	_, err = target.Import(bytes.NewReader(archive.Bytes()[:archive.Len()-10]))
+	require.Error(t, err)
+
+	snapshot, err := target.Import(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, expected, snapshot)
+	snapshot, chunks, err := target.Load(2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, expected, snapshot)
+	assert.Equal(t, [][]byte{{2, 1, 0}, {2, 1, 1}, {2, 1, 2}}, readChunks(chunks))
+
+	// importing an existing snapshot fails
+	_, err = target.Import(bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+
+	// a corrupted chunk fails the hash verification, and the snapshot is removed
+	_, err = store.Save(3, 1, makeChunks([][]byte{{3, 1, 0}, {3, 1, 1}}))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "3", "1", "1"), []byte{9, 9, 9}, 0o600))
+	archive.Reset()
+	require.NoError(t, store.Export(3, 1, &archive))
+	_, err = target.Import(&archive)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+	snapshot, err = target.Get(3, 1)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
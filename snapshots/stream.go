@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"compress/zlib"
 	"io"
+	"sync"
 
 	protoio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
@@ -17,6 +18,10 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+
+	// the restore decompresses up to readAheadBlocks blocks of readAheadBlockSize bytes ahead
+	readAheadBlockSize = 1 << 20
+	readAheadBlocks    = 8
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
@@ -74,10 +79,15 @@ func (sw *StreamWriter) CloseWithError(err error) {
 }
 
 // StreamReader set up a restore stream pipeline
-// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+// chan io.ReadCloser -> chunkReader -> zlib -> read-ahead -> delimited Protobuf -> ExportNode
+//
+// The zlib stream spans the chunks, so they cannot be decompressed independently, but the
+// upcoming chunks are decompressed by a separate goroutine, concurrently with the import of
+// the items already decompressed.
 type StreamReader struct {
 	chunkReader *ChunkReader
 	zReader     io.ReadCloser
+	readAhead   *readAheadReader
 	protoReader protoio.ReadCloser
 }
 
@@ -88,10 +98,12 @@ func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "zlib failure")
 	}
-	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	readAhead := newReadAheadReader(zReader, readAheadBlockSize, readAheadBlocks)
+	protoReader := protoio.NewDelimitedReader(readAhead, snapshotMaxItemSize)
 	return &StreamReader{
 		chunkReader: chunkReader,
 		zReader:     zReader,
+		readAhead:   readAhead,
 		protoReader: protoReader,
 	}, nil
 }
@@ -104,6 +116,77 @@ func (sr *StreamReader) ReadMsg(msg proto.Message) error {
 // Close implements io.Closer interface
 func (sr *StreamReader) Close() error {
 	sr.protoReader.Close()
+	// the read-ahead goroutine is stopped before the readers it reads from are closed
+	sr.readAhead.Close()
 	sr.zReader.Close()
 	return sr.chunkReader.Close()
 }
+
+// readAheadBlock is a block of data read ahead, with the error that ended the
+// read, if any.
+type readAheadBlock struct {
+	data []byte
+	err  error
+}
+
+// readAheadReader reads ahead of its consumer, in a separate goroutine, up to
+// the given number of blocks.
+type readAheadReader struct {
+	blocks  chan readAheadBlock
+	current readAheadBlock
+	done    chan struct{}
+	stopped chan struct{}
+	close   sync.Once
+}
+
+func newReadAheadReader(r io.Reader, blockSize, blocks int) *readAheadReader {
+	ra := &readAheadReader{
+		blocks:  make(chan readAheadBlock, blocks),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(ra.stopped)
+		for {
+			data := make([]byte, blockSize)
+			n, err := io.ReadFull(r, data)
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+
+			select {
+			case ra.blocks <- readAheadBlock{data: data[:n], err: err}:
+			case <-ra.done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return ra
+}
+
+// Read implements io.Reader.
+func (ra *readAheadReader) Read(p []byte) (int, error) {
+	for len(ra.current.data) == 0 {
+		if ra.current.err != nil {
+			return 0, ra.current.err
+		}
+		ra.current = <-ra.blocks
+	}
+
+	n := copy(p, ra.current.data)
+	ra.current.data = ra.current.data[n:]
+	return n, nil
+}
+
+// Close stops the read-ahead goroutine, waiting for it to return. It can be
+// called several times, the protobuf reader closing it too.
+func (ra *readAheadReader) Close() error {
+	ra.close.Do(func() { close(ra.done) })
+	<-ra.stopped
+	return nil
+}
@@ -0,0 +1,77 @@
+package snapshots_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func TestStreamReader(t *testing.T) {
+	// enough incompressible items to span several read ahead blocks
+	items := make([][]byte, 64)
+	for i := range items {
+		items[i] = make([]byte, 100_000)
+		for j := range items[i] {
+			items[i][j] = byte((i*31 + j*j*7) % 251)
+		}
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		writer := snapshots.NewStreamWriter(ch)
+		for _, item := range items {
+			if err := types.WriteExtensionItem(writer, item); err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+		writer.Close()
+	}()
+	chunks := makeChunks(readChunks(ch))
+
+	reader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+	for _, expect := range items {
+		item := &types.SnapshotItem{}
+		require.NoError(t, reader.ReadMsg(item))
+		require.True(t, bytes.Equal(expect, item.GetExtensionPayload().Payload))
+	}
+	require.Equal(t, io.EOF, reader.ReadMsg(&types.SnapshotItem{}))
+	require.NoError(t, reader.Close())
+}
+
+func TestStreamReader_Close(t *testing.T) {
+	items := make([][]byte, 64)
+	for i := range items {
+		items[i] = bytes.Repeat([]byte{byte(i)}, 100_000)
+	}
+
+	// closing the reader before the end of the stream drains the chunks, so
+	// that the writer is not blocked
+	ch := make(chan io.ReadCloser)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		writer := snapshots.NewStreamWriter(ch)
+		for _, item := range items {
+			if err := types.WriteExtensionItem(writer, item); err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+		writer.Close()
+	}()
+
+	reader, err := snapshots.NewStreamReader(ch)
+	require.NoError(t, err)
+	item := &types.SnapshotItem{}
+	require.NoError(t, reader.ReadMsg(item))
+	require.Equal(t, items[0], item.GetExtensionPayload().Payload)
+	require.NoError(t, reader.Close())
+	<-done
+}
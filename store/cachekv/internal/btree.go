@@ -15,11 +15,13 @@ const (
 
 var errKeyEmpty = errors.New("key cannot be empty")
 
-// BTree implements the sorted cache for cachekv store,
-// we don't use MemDB here because cachekv is used extensively in sdk core path,
+// BTree implements the sorted cache for cachekv store, holding the values to
+// write to the parent, where a nil value marks a deleted key.
+// We don't use MemDB here because cachekv is used extensively in sdk core path,
 // we need it to be as fast as possible, while `MemDB` is mainly used as a mocking db in unit tests.
 //
-// We choose tidwall/btree over google/btree here because it provides API to implement step iterator directly.
+// We choose tidwall/btree over google/btree here because it provides API to implement step iterator directly,
+// and copy-on-write copies which let the iterators read a snapshot of the cache while it is written.
 type BTree struct {
 	tree btree.BTreeG[item]
 }
@@ -28,7 +30,8 @@ type BTree struct {
 func NewBTree() *BTree {
 	return &BTree{tree: *btree.NewBTreeGOptions(byKeys, btree.Options{
 		Degree: bTreeDegree,
-		// Contract: cachekv store must not be called concurrently
+		// Contract: the cachekv store synchronizes the writes to its tree, and
+		// the copies of it are read-only
 		NoLocks: true,
 	})}
 }
@@ -49,20 +52,29 @@ func (bt *BTree) Delete(key []byte) {
 	bt.tree.Delete(newItem(key, nil))
 }
 
+// Scan calls fn with the items in ascending key order, until fn returns false.
+func (bt *BTree) Scan(fn func(key, value []byte) bool) {
+	bt.tree.Scan(func(i item) bool {
+		return fn(i.key, i.value)
+	})
+}
+
 func (bt *BTree) Iterator(start, end []byte) (*memIterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, errKeyEmpty
 	}
-	return NewMemIterator(start, end, bt, make(map[string]struct{}), true), nil
+	return NewMemIterator(start, end, bt, true), nil
 }
 
 func (bt *BTree) ReverseIterator(start, end []byte) (*memIterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, errKeyEmpty
 	}
-	return NewMemIterator(start, end, bt, make(map[string]struct{}), false), nil
+	return NewMemIterator(start, end, bt, false), nil
 }
 
+// Copy returns a copy-on-write copy of the tree in constant time, which can be
+// read concurrently with the writes to the tree.
 func (bt *BTree) Copy() *BTree {
 	return &BTree{
 		tree: *bt.tree.Copy(),
@@ -142,3 +142,93 @@ func BenchmarkSetKeySize32(b *testing.B) {
 func BenchmarkIteratorOnParentWith1MDeletes(b *testing.B) {
 	benchmarkIteratorOnParentWithManyDeletes(b, 1_000_000)
 }
+
+// newBenchmarkStore returns a store caching numKeys dirty keys over a parent
+// holding numKeys other keys, interleaved with the cached ones.
+func newBenchmarkStore(numKeys int) (*cachekv.Store, [][]byte) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := randSlice(32)
+	keys := generateSequentialKeys(make([]byte, 8), 2*numKeys)
+	for i, k := range keys {
+		if i%2 == 0 {
+			mem.Set(k, value)
+		}
+	}
+
+	kvstore := cachekv.NewStore(mem)
+	for i, k := range keys {
+		if i%2 == 1 {
+			kvstore.Set(k, value)
+		}
+	}
+
+	return kvstore, keys
+}
+
+// Benchmark iterating over ranges of a store from concurrent goroutines, as
+// the gRPC queries do on the store of a height.
+func benchmarkParallelIterator(b *testing.B, numKeys, rangeSize int) {
+	kvstore, keys := newBenchmarkStore(numKeys)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			start := (i * 7919) % (len(keys) - rangeSize)
+			iter := kvstore.Iterator(keys[start], keys[start+rangeSize])
+			for ; iter.Valid(); iter.Next() {
+				sink = iter.Value()
+			}
+			iter.Close()
+			i++
+		}
+	})
+}
+
+// Benchmark reading a store from concurrent goroutines.
+func benchmarkParallelGet(b *testing.B, numKeys int) {
+	kvstore, keys := newBenchmarkStore(numKeys)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			sink = kvstore.Get(keys[(i*7919)%len(keys)])
+			i++
+		}
+	})
+}
+
+// Benchmark creating iterators between writes, which sorted the written keys
+// on each iterator creation before the cache was kept sorted.
+func benchmarkIteratorInterleavedWrites(b *testing.B, numKeys int) {
+	kvstore, keys := newBenchmarkStore(numKeys)
+	value := randSlice(32)
+	newKeys := generateRandomKeys(32, b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		kvstore.Set(newKeys[i], value)
+		iter := kvstore.Iterator(keys[0], nil)
+		sink = iter.Key()
+		iter.Close()
+	}
+}
+
+func BenchmarkParallelIterator10KKeysRange100(b *testing.B) {
+	benchmarkParallelIterator(b, 10_000, 100)
+}
+
+func BenchmarkParallelGet10KKeys(b *testing.B) {
+	benchmarkParallelGet(b, 10_000)
+}
+
+func BenchmarkIteratorInterleavedWrites10KKeys(b *testing.B) {
+	benchmarkIteratorInterleavedWrites(b, 10_000)
+}
@@ -3,8 +3,11 @@ package cachekv_test
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	dbm "github.com/tendermint/tm-db"
@@ -448,6 +451,140 @@ func TestIteratorDeadlock(t *testing.T) {
 	defer it2.Close()
 }
 
+// TestIteratorSnapshot checks that an iterator does not see the writes
+// following its creation.
+func TestIteratorSnapshot(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(0), valFmt(0))
+	parent := cachekv.NewStore(mem)
+	store := cachekv.NewStore(parent)
+	store.Set(keyFmt(1), valFmt(1))
+	store.Set(keyFmt(2), valFmt(2))
+
+	it := store.Iterator(nil, nil)
+	store.Delete(keyFmt(0))
+	store.Delete(keyFmt(1))
+	store.Set(keyFmt(3), valFmt(3))
+	store.Write()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+		require.NotNil(t, it.Value())
+	}
+	require.Equal(t, [][]byte{keyFmt(0), keyFmt(1), keyFmt(2)}, keys)
+	require.NoError(t, it.Close())
+
+	parent.Write()
+	assertIterateDomainCheck(t, store, mem.DB, []keyRange{{2, 4}})
+}
+
+// TestConcurrentAccess reads and iterates over a store from many goroutines
+// while it is written, each iteration must see a consistent state.
+func TestConcurrentAccess(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent := cachekv.NewStore(mem)
+	store := cachekv.NewStore(parent)
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				// the keys are written and deleted in order, so the keys of a
+				// consistent state are contiguous
+				it := store.Iterator(nil, nil)
+				first := -1
+				for n := 0; it.Valid(); it.Next() {
+					i, err := strconv.Atoi(string(it.Key()[len("key"):]))
+					assert.NoError(t, err)
+					if first < 0 {
+						first = i
+					}
+					assert.Equal(t, keyFmt(first+n), it.Key())
+					assert.Equal(t, valFmt(first+n), it.Value())
+					n++
+				}
+				assert.NoError(t, it.Close())
+
+				k := randInt(1000)
+				if v := store.Get(keyFmt(k)); v != nil {
+					assert.Equal(t, valFmt(k), v)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 1000; i++ {
+		store.Set(keyFmt(i), valFmt(i))
+		if i >= 300 {
+			store.Delete(keyFmt(i - 300))
+		}
+		if i%100 == 0 {
+			store.Write()
+		}
+	}
+	close(done)
+	wg.Wait()
+
+	store.Write()
+	parent.Write()
+	assertIterateDomainCheck(t, store, mem.DB, []keyRange{{700, 1000}})
+}
+
+// blockingStore is a parent store whose reads block until released.
+type blockingStore struct {
+	types.KVStore
+	once    sync.Once
+	reading chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStore) Get(key []byte) []byte {
+	s.once.Do(func() { close(s.reading) })
+	<-s.release
+	return s.KVStore.Get(key)
+}
+
+func TestGetConcurrentWrite(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(1), valFmt(1))
+	parent := &blockingStore{KVStore: mem, reading: make(chan struct{}), release: make(chan struct{})}
+	store := cachekv.NewStore(parent)
+
+	got := make(chan []byte)
+	go func() { got <- store.Get(keyFmt(1)) }()
+	<-parent.reading
+
+	written := make(chan struct{})
+	go func() {
+		store.Set(keyFmt(1), valFmt(2))
+		store.Write()
+		close(written)
+	}()
+
+	// the parent is not written while it is read
+	select {
+	case <-written:
+		t.Fatal("parent written while read")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(parent.release)
+	require.Equal(t, valFmt(1), <-got)
+	<-written
+
+	// the value read before the write is not cached
+	require.Equal(t, valFmt(2), store.Get(keyFmt(1)))
+}
+
 //-------------------------------------------------------------------------------------------
 // do some random ops
 
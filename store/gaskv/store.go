@@ -16,14 +16,22 @@ type Store struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.KVStore
+	stats     *types.StoreGasStats
 }
 
 // NewStore returns a reference to a new GasKVStore.
 func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig) *Store {
+	return NewStoreWithStats(parent, gasMeter, gasConfig, nil)
+}
+
+// NewStoreWithStats returns a reference to a new GasKVStore recording its
+// accesses and the gas they consume to stats, unless it is nil.
+func NewStoreWithStats(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig, stats *types.StoreGasStats) *Store {
 	kvs := &Store{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
 		parent:    parent,
+		stats:     stats,
 	}
 	return kvs
 }
@@ -35,12 +43,13 @@ func (gs *Store) GetStoreType() types.StoreType {
 
 // Implements KVStore.
 func (gs *Store) Get(key []byte) (value []byte) {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
 	value = gs.parent.Get(key)
 
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasReadPerByteDesc)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasReadPerByteDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	recordRead(gs.stats, len(key)+len(value))
 
 	return value
 }
@@ -49,17 +58,19 @@ func (gs *Store) Get(key []byte) (value []byte) {
 func (gs *Store) Set(key []byte, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	recordWrite(gs.stats, len(key)+len(value))
 	gs.parent.Set(key, value)
 }
 
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "has")
-	gs.gasMeter.ConsumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.HasCost, types.GasHasDesc)
+	recordRead(gs.stats, len(key))
 	return gs.parent.Has(key)
 }
 
@@ -67,7 +78,8 @@ func (gs *Store) Has(key []byte) bool {
 func (gs *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "delete")
 	// charge gas to prevent certain attack vectors even though space is being freed
-	gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	consumeGas(gs.gasMeter, gs.stats, gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	recordWrite(gs.stats, len(key))
 	gs.parent.Delete(key)
 }
 
@@ -104,8 +116,9 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = gs.parent.ReverseIterator(start, end)
 	}
 
-	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent)
+	gi := newGasIterator(gs.gasMeter, gs.gasConfig, gs.stats, parent)
 	gi.(*gasIterator).consumeSeekGas()
+	gi.(*gasIterator).recordRead()
 
 	return gi
 }
@@ -113,13 +126,15 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 type gasIterator struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
+	stats     *types.StoreGasStats
 	parent    types.Iterator
 }
 
-func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator) types.Iterator {
+func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, stats *types.StoreGasStats, parent types.Iterator) types.Iterator {
 	return &gasIterator{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
+		stats:     stats,
 		parent:    parent,
 	}
 }
@@ -140,6 +155,7 @@ func (gi *gasIterator) Valid() bool {
 func (gi *gasIterator) Next() {
 	gi.consumeSeekGas()
 	gi.parent.Next()
+	gi.recordRead()
 }
 
 // Key implements the Iterator interface. It returns the current key and it does
@@ -173,9 +189,40 @@ func (gi *gasIterator) consumeSeekGas() {
 		key := gi.Key()
 		value := gi.Value()
 
-		gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasValuePerByteDesc)
-		gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
+		consumeGas(gi.gasMeter, gi.stats, gi.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasValuePerByteDesc)
+		consumeGas(gi.gasMeter, gi.stats, gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
+	}
+
+	consumeGas(gi.gasMeter, gi.stats, gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
+}
+
+// recordRead records the read of the current key/value pair, once per pair
+// while the gas of a pair is consumed on both the seek and the next step.
+func (gi *gasIterator) recordRead() {
+	if gi.stats != nil && gi.Valid() {
+		recordRead(gi.stats, len(gi.Key())+len(gi.Value()))
+	}
+}
+
+// consumeGas consumes the given amount of gas, and adds it to the stats of
+// the store if they are recorded.
+func consumeGas(gasMeter types.GasMeter, stats *types.StoreGasStats, amount types.Gas, descriptor string) {
+	gasMeter.ConsumeGas(amount, descriptor)
+	if stats != nil {
+		stats.Gas += amount
 	}
+}
 
-	gi.gasMeter.ConsumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
+func recordRead(stats *types.StoreGasStats, size int) {
+	if stats != nil {
+		stats.Reads++
+		stats.ReadBytes += uint64(size)
+	}
+}
+
+func recordWrite(stats *types.StoreGasStats, size int) {
+	if stats != nil {
+		stats.Writes++
+		stats.WriteBytes += uint64(size)
+	}
 }
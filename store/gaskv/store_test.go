@@ -121,3 +121,41 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreStats(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(100000)
+	stats := types.NewGasStats()
+	st := gaskv.NewStoreWithStats(mem, meter, types.KVGasConfig(), stats.Store("store1"))
+
+	st.Set(keyFmt(1), valFmt(1))
+	st.Set(keyFmt(2), valFmt(2))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	require.True(t, st.Has(keyFmt(2)))
+	st.Delete(keyFmt(2))
+
+	iterator := st.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+	}
+	require.NoError(t, iterator.Close())
+
+	// another store recording to the same stats
+	other := gaskv.NewStoreWithStats(mem, meter, types.TransientGasConfig(), stats.Store("store2"))
+	other.Get(keyFmt(3))
+
+	require.Equal(t, []string{"store1", "store2"}, stats.StoreNames())
+	require.Equal(t, types.StoreGasStats{
+		// get, has and iterated key
+		Reads:     3,
+		ReadBytes: 2*uint64(len(keyFmt(1))+len(valFmt(1))) + uint64(len(keyFmt(2))),
+		// sets and delete
+		Writes:     3,
+		WriteBytes: 2*uint64(len(keyFmt(1))+len(valFmt(1))) + uint64(len(keyFmt(2))),
+		Gas:        meter.GasConsumed() - types.TransientGasConfig().ReadCostFlat - types.TransientGasConfig().ReadCostPerByte*uint64(len(keyFmt(3))),
+	}, *stats.Store("store1"))
+	require.Equal(t, types.StoreGasStats{
+		Reads:     1,
+		ReadBytes: uint64(len(keyFmt(3))),
+		Gas:       types.TransientGasConfig().ReadCostFlat,
+	}, *stats.Store("store2"))
+}
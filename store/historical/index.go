@@ -0,0 +1,485 @@
+package historical
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	changelogPrefix = []byte{0x00} // changelogPrefix | store name length | store name | escaped key | height -> op | value
+	latestHeightKey = []byte{0x01} // the latest indexed height
+	firstHeightKey  = []byte{0x02} // the first height whose state is fully indexed, zero if none
+
+	// keyTerminator ends the escaped keys of the changelog, see escapeKey.
+	keyTerminator = []byte{0x00, 0x00}
+)
+
+const (
+	opSet    byte = 0
+	opDelete byte = 1
+
+	// syncBatchSize is the number of keys written at once when seeding the index.
+	syncBatchSize = 10000
+)
+
+// Index stores the changelog of the keys of a set of stores, i.e. the height
+// and value of each of their writes, in a flat key-value database, to serve the
+// state of these stores at any past height since the index was seeded, even
+// when the multistore pruned it.
+//
+// Index implements the StreamingService of the BaseApp: the writes to the stores
+// are recorded by its listeners and indexed on commit.
+type Index struct {
+	db        dbm.DB
+	listeners []*types.MemoryListener
+
+	// currentHeight is the height of the block being executed.
+	currentHeight int64
+
+	mtx    sync.RWMutex
+	first  int64
+	latest int64
+	synced bool
+}
+
+// NewIndex returns an Index of the writes to the given stores, backed by db.
+func NewIndex(db dbm.DB, storeKeys []types.StoreKey) (*Index, error) {
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	first, err := getHeight(db, firstHeightKey)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := getHeight(db, latestHeightKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Index{
+		db:        db,
+		listeners: listeners,
+		first:     first,
+		latest:    latest,
+	}, nil
+}
+
+// Stream implements the StreamingService interface. The writes are indexed on
+// commit, so no streaming loop is needed.
+func (idx *Index) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements the StreamingService interface.
+func (idx *Index) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(idx.listeners))
+	for _, listener := range idx.listeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock implements the ABCIListener interface. It sets the height
+// of the writes indexed on commit.
+func (idx *Index) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	idx.currentHeight = req.Header.Height
+	return nil
+}
+
+// ListenEndBlock implements the ABCIListener interface.
+func (idx *Index) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements the ABCIListener interface.
+func (idx *Index) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It indexes the writes of
+// the committed block.
+func (idx *Index) ListenCommit(_ context.Context, _ abci.ResponseCommit) error {
+	var pairs []types.StoreKVPair
+	for _, listener := range idx.listeners {
+		pairs = append(pairs, listener.PopStateCache()...)
+	}
+
+	return idx.Write(idx.currentHeight, pairs)
+}
+
+// Close implements the StreamingService interface.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// Write indexes the writes of the given height, which must follow the latest
+// indexed height. Otherwise the index is no longer able to serve the state of
+// any height until it is seeded again by Sync.
+func (idx *Index) Write(height int64, pairs []types.StoreKVPair) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range pairs {
+		if err := setChange(batch, pair.StoreKey, pair.Key, height, pair.Value, pair.Delete); err != nil {
+			return err
+		}
+	}
+
+	first := idx.first
+	switch {
+	case !idx.synced || (idx.latest > 0 && height != idx.latest+1):
+		// the changes of the missing heights are unknown
+		first = 0
+	case first == 0 && idx.latest == 0:
+		// the index was synced with an empty multistore
+		first = height
+	}
+
+	if err := setHeights(batch, first, height); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	idx.first, idx.latest = first, height
+	return nil
+}
+
+// Sync brings the index in line with the latest version of the given
+// multistore, which must be loaded. Unless the index already holds that
+// version, it is seeded with the state of that version, after removing the
+// changes it holds if they do not directly precede that version.
+func (idx *Index) Sync(cms types.CommitMultiStore) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	version := cms.LastCommitID().Version
+	switch {
+	case idx.latest == version && (idx.first > 0 || version == 0):
+		// up to date
+
+	case idx.latest > version && idx.first > 0 && idx.first <= version:
+		// the multistore was rolled back
+		if err := idx.truncate(version); err != nil {
+			return err
+		}
+
+	default:
+		if err := idx.seed(cms, version); err != nil {
+			return err
+		}
+	}
+
+	idx.synced = true
+	return nil
+}
+
+// truncate removes the changes after the given height.
+func (idx *Index) truncate(height int64) error {
+	if err := idx.deleteChanges(func(h int64) bool { return h > height }); err != nil {
+		return err
+	}
+	if err := idx.saveHeights(idx.first, height); err != nil {
+		return err
+	}
+
+	idx.latest = height
+	return nil
+}
+
+// seed replaces the content of the index with the state of the stores at the
+// given version.
+func (idx *Index) seed(cms types.CommitMultiStore, version int64) error {
+	if err := idx.deleteChanges(func(int64) bool { return true }); err != nil {
+		return err
+	}
+
+	for _, listener := range idx.listeners {
+		// drop the writes recorded while loading the multistore, if any
+		listener.PopStateCache()
+
+		store := cms.GetCommitKVStore(listener.StoreKey())
+		if store == nil {
+			return fmt.Errorf("indexed store %s is not mounted", listener.StoreKey().Name())
+		}
+
+		if err := idx.seedStore(listener.StoreKey().Name(), store, version); err != nil {
+			return err
+		}
+	}
+
+	first := version
+	if version == 0 {
+		// the first height will be the one of the first commit
+		first = 0
+	}
+	if err := idx.saveHeights(first, version); err != nil {
+		return err
+	}
+
+	idx.first, idx.latest = first, version
+	return nil
+}
+
+func (idx *Index) seedStore(storeName string, store types.KVStore, version int64) error {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for it.Valid() {
+		batch := idx.db.NewBatch()
+		for n := 0; n < syncBatchSize && it.Valid(); n++ {
+			if err := setChange(batch, storeName, it.Key(), version, it.Value(), false); err != nil {
+				batch.Close()
+				return err
+			}
+			it.Next()
+		}
+
+		err := batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// deleteChanges removes the changes of the heights matching the given filter.
+// The keys are collected in chunks, each deleted once its iterator is closed,
+// as the database must not be written while it is being iterated over.
+func (idx *Index) deleteChanges(filter func(height int64) bool) error {
+	start, end := changelogPrefix, types.PrefixEndBytes(changelogPrefix)
+	for start != nil {
+		var keys [][]byte
+		var err error
+		keys, start, err = idx.collectChanges(start, end, filter)
+		if err != nil {
+			return err
+		}
+
+		batch := idx.db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectChanges returns the keys of the changes of the heights matching the
+// given filter among at most syncBatchSize keys from start, and the key to
+// resume from, nil once end is reached.
+func (idx *Index) collectChanges(start, end []byte, filter func(height int64) bool) (keys [][]byte, next []byte, err error) {
+	it, err := idx.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	for n := 0; it.Valid(); n++ {
+		key := it.Key()
+		if n == syncBatchSize {
+			return keys, append([]byte{}, key...), it.Error()
+		}
+		if filter(int64(binary.BigEndian.Uint64(key[len(key)-8:]))) {
+			keys = append(keys, append([]byte{}, key...))
+		}
+		it.Next()
+	}
+
+	return keys, nil, it.Error()
+}
+
+func (idx *Index) saveHeights(first, latest int64) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if err := setHeights(batch, first, latest); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Heights returns the range of heights whose state the index serves. The first
+// height is zero if there is none.
+func (idx *Index) Heights() (first, latest int64) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.first, idx.latest
+}
+
+// HasVersion returns true if the index serves the state of the given height.
+func (idx *Index) HasVersion(version int64) bool {
+	first, latest := idx.Heights()
+	return first > 0 && first <= version && version <= latest
+}
+
+// StoreNames returns the names of the indexed stores.
+func (idx *Index) StoreNames() []string {
+	names := make([]string, len(idx.listeners))
+	for i, listener := range idx.listeners {
+		names[i] = listener.StoreKey().Name()
+	}
+
+	return names
+}
+
+// CacheMultiStoreWithVersion returns a branch of the given stores of a
+// multistore at the given version, which the index must serve. The IAVL stores
+// are read from the index and must all be indexed, while the other stores are
+// used as is since they are not versioned.
+func (idx *Index) CacheMultiStoreWithVersion(stores map[types.StoreKey]types.CommitKVStore, version int64) (types.CacheMultiStore, error) {
+	if !idx.HasVersion(version) {
+		return nil, fmt.Errorf("version %d is not indexed", version)
+	}
+
+	indexed := make(map[string]bool, len(idx.listeners))
+	for _, name := range idx.StoreNames() {
+		indexed[name] = true
+	}
+
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper, len(stores))
+	keysByName := make(map[string]types.StoreKey, len(stores))
+	for key, store := range stores {
+		keysByName[key.Name()] = key
+
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			cachedStores[key] = store
+			continue
+		}
+		if !indexed[key.Name()] {
+			return nil, fmt.Errorf("store %s is not indexed", key.Name())
+		}
+		cachedStores[key] = NewStore(idx.db, key.Name(), version)
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), cachedStores, keysByName, nil, nil), nil
+}
+
+// setChange adds the change of a key at the given height to the batch.
+func setChange(batch dbm.Batch, storeName string, key []byte, height int64, value []byte, delete bool) error {
+	if delete {
+		return batch.Set(changeKey(storeName, key, height), []byte{opDelete})
+	}
+
+	return batch.Set(changeKey(storeName, key, height), append([]byte{opSet}, value...))
+}
+
+func setHeights(batch dbm.Batch, first, latest int64) error {
+	if err := batch.Set(firstHeightKey, heightBytes(first)); err != nil {
+		return err
+	}
+
+	return batch.Set(latestHeightKey, heightBytes(latest))
+}
+
+func getHeight(db dbm.DB, key []byte) (int64, error) {
+	bz, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, errors.New("invalid height in the historical index")
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+// storePrefix returns the prefix of the changelog of a store.
+func storePrefix(storeName string) []byte {
+	prefix := make([]byte, 0, len(changelogPrefix)+1+len(storeName))
+	prefix = append(prefix, changelogPrefix...)
+	prefix = append(prefix, byte(len(storeName)))
+	return append(prefix, storeName...)
+}
+
+// changeKey returns the changelog key of the change of a key at a height.
+func changeKey(storeName string, key []byte, height int64) []byte {
+	bz := storePrefix(storeName)
+	bz = append(bz, escapeKey(key)...)
+	bz = append(bz, keyTerminator...)
+	return append(bz, heightBytes(height)...)
+}
+
+// escapeKey escapes the zero bytes of a key as 0x00 0xff, so that the escaped
+// keys followed by keyTerminator sort like the keys and none of them is a
+// prefix of another.
+func escapeKey(key []byte) []byte {
+	escaped := make([]byte, 0, len(key))
+	for _, b := range key {
+		escaped = append(escaped, b)
+		if b == 0x00 {
+			escaped = append(escaped, 0xff)
+		}
+	}
+
+	return escaped
+}
+
+// splitChangeKey returns the key and height of a changelog key, without its
+// store prefix.
+func splitChangeKey(bz []byte) (key []byte, height int64, err error) {
+	key = make([]byte, 0, len(bz))
+	for i := 0; i < len(bz)-1; i++ {
+		if bz[i] != 0x00 {
+			key = append(key, bz[i])
+			continue
+		}
+
+		switch bz[i+1] {
+		case 0xff:
+			key = append(key, 0x00)
+			i++
+		case 0x00:
+			if rest := bz[i+2:]; len(rest) == 8 {
+				return key, int64(binary.BigEndian.Uint64(rest)), nil
+			}
+			return nil, 0, errors.New("invalid changelog key")
+		default:
+			return nil, 0, errors.New("invalid changelog key")
+		}
+	}
+
+	return nil, 0, errors.New("invalid changelog key")
+}
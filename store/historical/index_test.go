@@ -0,0 +1,175 @@
+package historical
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+func newMultiStore(t *testing.T, db dbm.DB) *rootmulti.Store {
+	ms := rootmulti.NewStore(db, log.NewNopLogger())
+	ms.MountStoreWithDB(storeKey1, types.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(storeKey2, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	return ms
+}
+
+// commit writes the given pairs of store1 to the multistore and the index.
+func commit(t *testing.T, ms *rootmulti.Store, idx *Index, writes map[string]string) {
+	cms := ms.CacheMultiStore()
+	store := cms.GetKVStore(storeKey1)
+	for key, value := range writes {
+		if value == "" {
+			store.Delete([]byte(key))
+		} else {
+			store.Set([]byte(key), []byte(value))
+		}
+	}
+	cms.Write()
+	id := ms.Commit()
+
+	require.NoError(t, idx.ListenBeginBlock(context.Background(), abci.RequestBeginBlock{Header: tmproto.Header{Height: id.Version}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, idx.ListenCommit(context.Background(), abci.ResponseCommit{}))
+}
+
+func requireState(t *testing.T, store types.KVStore, expected []string) {
+	var pairs []string
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, string(it.Key())+"="+string(it.Value()))
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, expected, pairs)
+
+	// the reverse iterator yields the same pairs
+	pairs = nil
+	it = store.ReverseIterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		pairs = append([]string{string(it.Key()) + "=" + string(it.Value())}, pairs...)
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, expected, pairs)
+}
+
+func TestIndex(t *testing.T) {
+	ms := newMultiStore(t, dbm.NewMemDB())
+	db := dbm.NewMemDB()
+	idx, err := NewIndex(db, []types.StoreKey{storeKey1, storeKey2})
+	require.NoError(t, err)
+	for key, listeners := range idx.Listeners() {
+		ms.AddListeners(key, listeners)
+	}
+
+	// the first write is not served until the index is synced
+	commit(t, ms, idx, map[string]string{"a": "1"})
+	require.False(t, idx.HasVersion(1))
+
+	// the index is seeded with the latest state
+	require.NoError(t, idx.Sync(ms))
+	require.True(t, idx.HasVersion(1))
+
+	commit(t, ms, idx, map[string]string{"a": "2", "a\x00": "3", "b": "4"})
+	commit(t, ms, idx, map[string]string{"a": "", "ab": "5"})
+	commit(t, ms, idx, map[string]string{"b": "6"})
+
+	first, latest := idx.Heights()
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), latest)
+
+	requireState(t, NewStore(db, "store1", 1), []string{"a=1"})
+	requireState(t, NewStore(db, "store1", 2), []string{"a=2", "a\x00=3", "b=4"})
+	requireState(t, NewStore(db, "store1", 3), []string{"a\x00=3", "ab=5", "b=4"})
+	requireState(t, NewStore(db, "store1", 4), []string{"a\x00=3", "ab=5", "b=6"})
+	requireState(t, NewStore(db, "store2", 4), nil)
+
+	store := NewStore(db, "store1", 3)
+	require.Nil(t, store.Get([]byte("a")))
+	require.Equal(t, []byte("3"), store.Get([]byte("a\x00")))
+	require.Panics(t, func() { store.Set([]byte("a"), []byte("1")) })
+
+	// domains are bounded like the ones of the IAVL stores
+	it := store.Iterator([]byte("a"), []byte("b"))
+	require.Equal(t, []byte("a\x00"), it.Key())
+	it.Next()
+	require.Equal(t, []byte("ab"), it.Key())
+	it.Next()
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	// the state of the indexed heights is served as a multistore
+	cms, err := idx.CacheMultiStoreWithVersion(ms.GetStores(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), cms.GetKVStore(storeKey1).Get([]byte("a")))
+	_, err = idx.CacheMultiStoreWithVersion(ms.GetStores(), 5)
+	require.Error(t, err)
+
+	// a rollback removes the changes of the later heights
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.NoError(t, idx.Sync(ms))
+	require.False(t, idx.HasVersion(4))
+	commit(t, ms, idx, map[string]string{"c": "7"})
+	requireState(t, NewStore(db, "store1", 4), []string{"a\x00=3", "ab=5", "b=4", "c=7"})
+
+	// a gap in the heights invalidates the index until it is seeded again
+	require.NoError(t, idx.Write(6, nil))
+	require.False(t, idx.HasVersion(4))
+	idx, err = NewIndex(db, []types.StoreKey{storeKey1, storeKey2})
+	require.NoError(t, err)
+	require.False(t, idx.HasVersion(4))
+	require.NoError(t, idx.Sync(ms))
+	first, latest = idx.Heights()
+	require.Equal(t, int64(4), first)
+	require.Equal(t, int64(4), latest)
+	requireState(t, NewStore(db, "store1", 4), []string{"a\x00=3", "ab=5", "b=4", "c=7"})
+	requireState(t, NewStore(db, "store1", 3), nil)
+}
+
+func TestIndexTruncateManyChanges(t *testing.T) {
+	ms := newMultiStore(t, dbm.NewMemDB())
+	db := dbm.NewMemDB()
+	idx, err := NewIndex(db, []types.StoreKey{storeKey1, storeKey2})
+	require.NoError(t, err)
+	for key, listeners := range idx.Listeners() {
+		ms.AddListeners(key, listeners)
+	}
+
+	commit(t, ms, idx, map[string]string{"a": "1"})
+	require.NoError(t, idx.Sync(ms))
+
+	// more changes than a batch and the items buffered by the iterators of a
+	// MemDB, which cannot be written while iterating over it
+	writes := make(map[string]string)
+	for i := 0; i < syncBatchSize+100; i++ {
+		writes[fmt.Sprintf("key%05d", i)] = "2"
+	}
+	commit(t, ms, idx, writes)
+	require.True(t, idx.HasVersion(2))
+
+	require.NoError(t, ms.RollbackToVersion(1))
+	require.NoError(t, idx.Sync(ms))
+	require.False(t, idx.HasVersion(2))
+	requireState(t, NewStore(db, "store1", 1), []string{"a=1"})
+
+	it, err := dbm.IteratePrefix(db, changelogPrefix)
+	require.NoError(t, err)
+	n := 0
+	for ; it.Valid(); it.Next() {
+		n++
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, 1, n)
+}
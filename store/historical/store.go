@@ -0,0 +1,224 @@
+package historical
+
+import (
+	"bytes"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = (*Store)(nil)
+
+// Store is a read-only KVStore serving the state of a store at a given height
+// from the changelog of an Index.
+type Store struct {
+	db      dbm.DB
+	prefix  []byte
+	version int64
+}
+
+// NewStore returns the state of the store with the given name at the given
+// height, read from the changelog of the index in db.
+func NewStore(db dbm.DB, storeName string, version int64) *Store {
+	return &Store{
+		db:      db,
+		prefix:  storePrefix(storeName),
+		version: version,
+	}
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// Get implements KVStore. It returns the value of the latest change of the key
+// at or before the height of the store.
+func (s *Store) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	start := append(append(append([]byte{}, s.prefix...), escapeKey(key)...), keyTerminator...)
+	end := append(append([]byte{}, start...), heightBytes(s.version+1)...)
+
+	it, err := s.db.ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	value := it.Value()
+	if value[0] == opDelete {
+		return nil
+	}
+
+	return append([]byte{}, value[1:]...)
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements KVStore. It panics as the store is read-only.
+func (s *Store) Set(_, _ []byte) {
+	panic("cannot write to a historical store")
+}
+
+// Delete implements KVStore. It panics as the store is read-only.
+func (s *Store) Delete(_ []byte) {
+	panic("cannot write to a historical store")
+}
+
+// Iterator implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	sourceStart := append(append([]byte{}, s.prefix...), escapeKey(start)...)
+	sourceEnd := types.PrefixEndBytes(s.prefix)
+	if end != nil {
+		sourceEnd = append(append([]byte{}, s.prefix...), escapeKey(end)...)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if ascending {
+		source, err = s.db.Iterator(sourceStart, sourceEnd)
+	} else {
+		source, err = s.db.ReverseIterator(sourceStart, sourceEnd)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	it := &iterator{
+		source:    source,
+		prefixLen: len(s.prefix),
+		version:   s.version,
+		ascending: ascending,
+		start:     start,
+		end:       end,
+	}
+	it.next()
+
+	return it
+}
+
+// iterator iterates over the keys of a store at a height, i.e. over the latest
+// change of each key of the changelog at or before that height.
+type iterator struct {
+	source    dbm.Iterator
+	prefixLen int
+	version   int64
+	ascending bool
+	start     []byte
+	end       []byte
+
+	key   []byte
+	value []byte
+	valid bool
+	err   error
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+// next moves to the next key whose latest change is not a deletion.
+func (it *iterator) next() {
+	it.valid = false
+
+	for it.source.Valid() {
+		key, _, err := splitChangeKey(it.source.Key()[it.prefixLen:])
+		if err != nil {
+			it.err = err
+			return
+		}
+
+		var value []byte
+		for it.source.Valid() {
+			k, height, err := splitChangeKey(it.source.Key()[it.prefixLen:])
+			if err != nil {
+				it.err = err
+				return
+			}
+			if !bytes.Equal(k, key) {
+				break
+			}
+
+			// the changes of a key are sorted by height
+			if height <= it.version && (it.ascending || value == nil) {
+				value = append([]byte{}, it.source.Value()...)
+			}
+			it.source.Next()
+		}
+
+		if value != nil && value[0] == opSet {
+			it.key, it.value, it.valid = key, value[1:], true
+			return
+		}
+	}
+
+	it.err = it.source.Error()
+}
+
+func (it *iterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+func (it *iterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.next()
+}
+
+func (it *iterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+func (it *iterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+func (it *iterator) Error() error {
+	return it.err
+}
+
+func (it *iterator) Close() error {
+	return it.source.Close()
+}
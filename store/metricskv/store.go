@@ -0,0 +1,207 @@
+package metricskv
+
+import (
+	"io"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// MetricLabelNameStore is the label of the metrics holding the store name.
+	MetricLabelNameStore = "store"
+
+	opGet      = "get"
+	opSet      = "set"
+	opHas      = "has"
+	opDelete   = "delete"
+	opIterator = "iterator"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface with metrics enabled. The latency
+// of each operation, the lifetime of the iterators and the sizes of the keys
+// and values read and written are recorded into the telemetry package, labeled
+// with the name of the store:
+//
+//   - store_metricskv_<operation>: latency of the get, set, has, delete and
+//     iterator operations, the latter measuring the creation of an iterator
+//   - store_metricskv_iterator_lifetime: time between the creation and the
+//     closing of an iterator
+//   - store_metricskv_iterator_keys: number of keys iterated over
+//   - store_metricskv_key_size, store_metricskv_value_size: sizes of the keys
+//     and values, labeled with the operation
+type Store struct {
+	parent types.KVStore
+	labels []metrics.Label
+}
+
+// NewStore returns a reference to a new metricsKVStore given a parent
+// KVStore implementation and the name of the store.
+func NewStore(parent types.KVStore, storeName string) *Store {
+	return &Store{
+		parent: parent,
+		labels: []metrics.Label{telemetry.NewLabel(MetricLabelNameStore, storeName)},
+	}
+}
+
+// Get implements the KVStore interface. It measures the latency of the read
+// and the sizes of the key and value.
+func (s *Store) Get(key []byte) []byte {
+	defer s.measureSince(time.Now(), opGet)
+
+	value := s.parent.Get(key)
+	s.recordSizes(opGet, key, value)
+	return value
+}
+
+// Set implements the KVStore interface. It measures the latency of the write
+// and the sizes of the key and value.
+func (s *Store) Set(key []byte, value []byte) {
+	defer s.measureSince(time.Now(), opSet)
+
+	s.parent.Set(key, value)
+	s.recordSizes(opSet, key, value)
+}
+
+// Delete implements the KVStore interface. It measures the latency of the
+// delete and the size of the key.
+func (s *Store) Delete(key []byte) {
+	defer s.measureSince(time.Now(), opDelete)
+
+	s.parent.Delete(key)
+	s.recordSizes(opDelete, key, nil)
+}
+
+// Has implements the KVStore interface. It measures the latency of the check
+// and the size of the key.
+func (s *Store) Has(key []byte) bool {
+	defer s.measureSince(time.Now(), opHas)
+
+	has := s.parent.Has(key)
+	s.recordSizes(opHas, key, nil)
+	return has
+}
+
+// Iterator implements the KVStore interface. It measures the latency of the
+// iterator creation, and returns an iterator recording its lifetime and the
+// keys and values it iterates over.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface. It measures the latency of
+// the iterator creation, and returns an iterator recording its lifetime and
+// the keys and values it iterates over.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	created := time.Now()
+	defer s.measureSince(created, opIterator)
+
+	var parent types.Iterator
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+
+	return newMetricsIterator(s, parent, created)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics as a Store
+// cannot be cache wrapped.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a MetricsKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a MetricsKVStore")
+}
+
+func (s *Store) measureSince(start time.Time, keys ...string) {
+	telemetry.MeasureSinceWithLabels(append([]string{"store", "metricskv"}, keys...), start, s.labels)
+}
+
+// recordSizes records the size of the key, and of the value unless it is nil.
+func (s *Store) recordSizes(op string, key, value []byte) {
+	labels := append([]metrics.Label{telemetry.NewLabel("operation", op)}, s.labels...)
+	telemetry.AddSampleWithLabels([]string{"store", "metricskv", "key_size"}, float32(len(key)), labels)
+	if value != nil {
+		telemetry.AddSampleWithLabels([]string{"store", "metricskv", "value_size"}, float32(len(value)), labels)
+	}
+}
+
+type metricsIterator struct {
+	store   *Store
+	parent  types.Iterator
+	created time.Time
+	keys    int
+}
+
+func newMetricsIterator(store *Store, parent types.Iterator, created time.Time) types.Iterator {
+	it := &metricsIterator{store: store, parent: parent, created: created}
+	it.recordItem()
+	return it
+}
+
+// Domain implements the Iterator interface.
+func (mi *metricsIterator) Domain() (start []byte, end []byte) {
+	return mi.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (mi *metricsIterator) Valid() bool {
+	return mi.parent.Valid()
+}
+
+// Next implements the Iterator interface.
+func (mi *metricsIterator) Next() {
+	mi.parent.Next()
+	mi.recordItem()
+}
+
+// Key implements the Iterator interface.
+func (mi *metricsIterator) Key() []byte {
+	return mi.parent.Key()
+}
+
+// Value implements the Iterator interface.
+func (mi *metricsIterator) Value() []byte {
+	return mi.parent.Value()
+}
+
+// Close implements the Iterator interface. It records the lifetime of the
+// iterator and the number of keys it iterated over.
+func (mi *metricsIterator) Close() error {
+	mi.store.measureSince(mi.created, opIterator, "lifetime")
+	telemetry.AddSampleWithLabels([]string{"store", "metricskv", opIterator, "keys"}, float32(mi.keys), mi.store.labels)
+	return mi.parent.Close()
+}
+
+// Error delegates the Error call to the parent iterator.
+func (mi *metricsIterator) Error() error {
+	return mi.parent.Error()
+}
+
+// recordItem records the sizes of the current key and value, if any.
+func (mi *metricsIterator) recordItem() {
+	if mi.parent.Valid() {
+		mi.keys++
+		mi.store.recordSizes(opIterator, mi.parent.Key(), mi.parent.Value())
+	}
+}
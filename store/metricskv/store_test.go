@@ -0,0 +1,100 @@
+package metricskv_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/metricskv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// newInmemSink registers a global in-memory metrics sink and returns it.
+func newInmemSink(t *testing.T) *metrics.InmemSink {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	return sink
+}
+
+// samples returns the metrics recorded by the sink, keyed by name and labels.
+func samples(sink *metrics.InmemSink) map[string]metrics.SampledValue {
+	data := sink.Data()
+	return data[len(data)-1].Samples
+}
+
+func newMetricsKVStore() *metricskv.Store {
+	store := metricskv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, "store1")
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value22"))
+	return store
+}
+
+func TestMetricsKVStoreOperations(t *testing.T) {
+	sink := newInmemSink(t)
+	store := newMetricsKVStore()
+
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.Nil(t, store.Get([]byte("key3")))
+	require.True(t, store.Has([]byte("key2")))
+	store.Delete([]byte("key2"))
+	require.False(t, store.Has([]byte("key2")))
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+
+	s := samples(sink)
+	require.Equal(t, 2, s["test.store.metricskv.set;store=store1"].Count)
+	require.Equal(t, 2, s["test.store.metricskv.get;store=store1"].Count)
+	require.Equal(t, 2, s["test.store.metricskv.has;store=store1"].Count)
+	require.Equal(t, 1, s["test.store.metricskv.delete;store=store1"].Count)
+
+	// the sizes of the missing values are not recorded
+	keySize := s["test.store.metricskv.key_size;operation=get;store=store1"]
+	require.Equal(t, 2, keySize.Count)
+	require.Equal(t, float64(8), keySize.Sum)
+	valueSize := s["test.store.metricskv.value_size;operation=get;store=store1"]
+	require.Equal(t, 1, valueSize.Count)
+	require.Equal(t, float64(6), valueSize.Sum)
+	valueSize = s["test.store.metricskv.value_size;operation=set;store=store1"]
+	require.Equal(t, 2, valueSize.Count)
+	require.Equal(t, float64(13), valueSize.Sum)
+}
+
+func TestMetricsKVStoreIterator(t *testing.T) {
+	sink := newInmemSink(t)
+	store := newMetricsKVStore()
+
+	for _, it := range []types.Iterator{store.Iterator(nil, nil), store.ReverseIterator(nil, nil)} {
+		var keys int
+		for ; it.Valid(); it.Next() {
+			keys++
+		}
+		require.Equal(t, 2, keys)
+		require.NoError(t, it.Close())
+	}
+
+	s := samples(sink)
+	require.Equal(t, 2, s["test.store.metricskv.iterator;store=store1"].Count)
+	require.Equal(t, 2, s["test.store.metricskv.iterator.lifetime;store=store1"].Count)
+	iteratorKeys := s["test.store.metricskv.iterator.keys;store=store1"]
+	require.Equal(t, 2, iteratorKeys.Count)
+	require.Equal(t, float64(4), iteratorKeys.Sum)
+	require.Equal(t, 4, s["test.store.metricskv.value_size;operation=iterator;store=store1"].Count)
+
+	for key := range s {
+		require.True(t, strings.HasPrefix(key, "test.store.metricskv."), key)
+	}
+}
+
+func TestMetricsKVStoreCacheWrap(t *testing.T) {
+	store := newMetricsKVStore()
+	require.Panics(t, func() { store.CacheWrap() })
+	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
+}
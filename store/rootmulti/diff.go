@@ -0,0 +1,223 @@
+package rootmulti
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// diffCacheSize is the IAVL node cache size of each store walked by DiffVersions.
+const diffCacheSize = 10000
+
+// KVPairDiff is a key whose value differs between two versions of a store of
+// the multistore. A nil value means the key is absent from that version.
+type KVPairDiff struct {
+	StoreName  string
+	Key        []byte
+	Value      []byte
+	OtherValue []byte
+}
+
+// DiffVersions compares the IAVL stores of the multistore at the given version
+// of db with the ones at otherVersion of otherDB, which may be the same
+// database. A zero version stands for the latest version of the database. The
+// stores are walked in parallel without writing to the databases, and fn is
+// called for each key whose value differs, ordered by store name and key.
+// Stores with the same hash in both versions are skipped. If storeNames is not
+// empty, only the given stores are compared.
+func DiffVersions(db dbm.DB, version int64, otherDB dbm.DB, otherVersion int64, storeNames []string, fn func(KVPairDiff) error) error {
+	stores, err := diffStoreIDs(db, version, storeNames)
+	if err != nil {
+		return err
+	}
+	otherStores, err := diffStoreIDs(otherDB, otherVersion, storeNames)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range stores {
+		names = append(names, name)
+	}
+	for name := range otherStores {
+		if _, ok := stores[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// abort the walks before waiting for them if fn fails
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	walks := make([]*storeDiffWalk, 0, len(names))
+	for _, name := range names {
+		id, ok := stores[name]
+		otherID, otherOK := otherStores[name]
+		if ok && otherOK && bytes.Equal(id.Hash, otherID.Hash) {
+			continue
+		}
+
+		walk := &storeDiffWalk{name: name, diffs: make(chan KVPairDiff, 256)}
+		walks = append(walks, walk)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(walk.diffs)
+			walk.err = walk.run(db, id, ok, otherDB, otherID, otherOK, done)
+		}()
+	}
+
+	for _, walk := range walks {
+		for diff := range walk.diffs {
+			if err := fn(diff); err != nil {
+				return err
+			}
+		}
+		if walk.err != nil {
+			return fmt.Errorf("failed to compare store %s: %w", walk.name, walk.err)
+		}
+	}
+
+	return nil
+}
+
+// diffStoreIDs returns the commit IDs of the IAVL stores of the given version,
+// or of the latest version if it is zero.
+func diffStoreIDs(db dbm.DB, version int64, storeNames []string) (map[string]types.CommitID, error) {
+	if version == 0 {
+		version = GetLatestVersion(db)
+	}
+
+	cInfo, err := GetCommitInfo(db, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load version %d: %w", version, err)
+	}
+
+	ids := make(map[string]types.CommitID)
+	for _, storeInfo := range cInfo.StoreInfos {
+		// only IAVL stores have a version
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+		ids[storeInfo.Name] = storeInfo.CommitId
+	}
+
+	if len(storeNames) == 0 {
+		return ids, nil
+	}
+
+	filtered := make(map[string]types.CommitID)
+	for _, name := range storeNames {
+		if id, ok := ids[name]; ok {
+			filtered[name] = id
+		}
+	}
+
+	return filtered, nil
+}
+
+// storeDiffWalk walks a store in two versions and sends the keys which differ.
+type storeDiffWalk struct {
+	name  string
+	diffs chan KVPairDiff
+	err   error
+}
+
+func (w *storeDiffWalk) run(db dbm.DB, id types.CommitID, ok bool, otherDB dbm.DB, otherID types.CommitID, otherOK bool, done <-chan struct{}) error {
+	it, err := w.iterator(db, id, ok)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	otherIt, err := w.iterator(otherDB, otherID, otherOK)
+	if err != nil {
+		return err
+	}
+	defer otherIt.Close()
+
+	send := func(diff KVPairDiff) bool {
+		diff.StoreName = w.name
+		select {
+		case w.diffs <- diff:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	for it.Valid() || otherIt.Valid() {
+		var diff *KVPairDiff
+		switch cmp := compareIterators(it, otherIt); {
+		case cmp < 0:
+			diff = &KVPairDiff{Key: copyBytes(it.Key()), Value: copyBytes(it.Value())}
+			it.Next()
+		case cmp > 0:
+			diff = &KVPairDiff{Key: copyBytes(otherIt.Key()), OtherValue: copyBytes(otherIt.Value())}
+			otherIt.Next()
+		default:
+			if !bytes.Equal(it.Value(), otherIt.Value()) {
+				diff = &KVPairDiff{Key: copyBytes(it.Key()), Value: copyBytes(it.Value()), OtherValue: copyBytes(otherIt.Value())}
+			}
+			it.Next()
+			otherIt.Next()
+		}
+
+		if diff != nil && !send(*diff) {
+			return errors.New("comparison aborted")
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return otherIt.Error()
+}
+
+// iterator returns an iterator over the store with the given commit ID in db,
+// which is empty if the store does not exist.
+func (w *storeDiffWalk) iterator(db dbm.DB, id types.CommitID, ok bool) (types.Iterator, error) {
+	if !ok {
+		return dbm.NewMemDB().Iterator(nil, nil)
+	}
+
+	prefixDB := dbm.NewPrefixDB(db, []byte("s/k:"+w.name+"/"))
+	store, err := iavl.LoadStore(prefixDB, nil, types.NewKVStoreKey(w.name), id, true, diffCacheSize, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.Iterator(nil, nil), nil
+}
+
+// compareIterators compares the current keys of two iterators, an exhausted
+// iterator being greater than any other.
+func compareIterators(it, otherIt types.Iterator) int {
+	switch {
+	case !otherIt.Valid():
+		return -1
+	case !it.Valid():
+		return 1
+	default:
+		return bytes.Compare(it.Key(), otherIt.Key())
+	}
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	return append([]byte{}, bz...)
+}
@@ -0,0 +1,73 @@
+package rootmulti
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestDiffVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store1 := ms.GetStoreByName("store1").(types.KVStore)
+	store2 := ms.GetStoreByName("store2").(types.KVStore)
+	store3 := ms.GetStoreByName("store3").(types.KVStore)
+
+	store1.Set([]byte("a"), []byte("1"))
+	store1.Set([]byte("b"), []byte("2"))
+	store2.Set([]byte("a"), []byte("1"))
+	store3.Set([]byte("a"), []byte("1"))
+	ms.Commit()
+
+	store1.Set([]byte("b"), []byte("3"))
+	store1.Set([]byte("c"), []byte("4"))
+	store2.Delete([]byte("a"))
+	ms.Commit()
+
+	collect := func(otherDB dbm.DB, version, otherVersion int64, storeNames ...string) []KVPairDiff {
+		var diffs []KVPairDiff
+		err := DiffVersions(db, version, otherDB, otherVersion, storeNames, func(diff KVPairDiff) error {
+			diffs = append(diffs, diff)
+			return nil
+		})
+		require.NoError(t, err)
+		return diffs
+	}
+
+	expected := []KVPairDiff{
+		{StoreName: "store1", Key: []byte("b"), Value: []byte("2"), OtherValue: []byte("3")},
+		{StoreName: "store1", Key: []byte("c"), OtherValue: []byte("4")},
+		{StoreName: "store2", Key: []byte("a"), Value: []byte("1")},
+	}
+	require.Equal(t, expected, collect(db, 1, 0))
+	require.Equal(t, expected[2:], collect(db, 1, 2, "store2", "store3"))
+	require.Empty(t, collect(db, 2, 2))
+
+	// compare with another database
+	otherDB := dbm.NewMemDB()
+	otherMS := newMultiStoreWithMounts(otherDB, types.PruneNothing)
+	require.NoError(t, otherMS.LoadLatestVersion())
+	otherMS.GetStoreByName("store1").(types.KVStore).Set([]byte("a"), []byte("1"))
+	otherMS.GetStoreByName("store1").(types.KVStore).Set([]byte("b"), []byte("2"))
+	otherMS.GetStoreByName("store3").(types.KVStore).Set([]byte("a"), []byte("1"))
+	otherMS.Commit()
+	require.Equal(t, []KVPairDiff{
+		{StoreName: "store1", Key: []byte("b"), Value: []byte("3"), OtherValue: []byte("2")},
+		{StoreName: "store1", Key: []byte("c"), Value: []byte("4")},
+	}, collect(otherDB, 0, 0))
+
+	// versions must exist
+	err := DiffVersions(db, 3, db, 0, nil, func(KVPairDiff) error { return nil })
+	require.Error(t, err)
+
+	// errors abort the comparison
+	errAbort := errors.New("abort")
+	err = DiffVersions(db, 1, db, 2, nil, func(KVPairDiff) error { return errAbort })
+	require.ErrorIs(t, err, errAbort)
+}
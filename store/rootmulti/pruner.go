@@ -0,0 +1,301 @@
+package rootmulti
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	iavltree "github.com/cosmos/iavl"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// pruneQueueSize is the number of pruning jobs which can wait for the
+// asynchronous pruner before Commit blocks until it catches up.
+const pruneQueueSize = 2
+
+// pruneJob holds the heights to delete from the IAVL stores of each pruning
+// group.
+type pruneJob []pruneTarget
+
+// pruneTarget holds the heights to delete from the IAVL stores of a pruning
+// group, i.e. the stores sharing a pruning strategy. The group is named after
+// the store with its own pruning strategy, or empty for the stores following
+// the strategy of the root store.
+type pruneTarget struct {
+	group   string
+	stores  []*iavl.Store
+	heights []int64
+}
+
+// pruningState tracks the versions which are read or pruned, so that a version
+// in use is never deleted, and the heights handed to the pruner which are not
+// deleted yet, so that they are persisted until they are.
+type pruningState struct {
+	mtx      sync.Mutex
+	inUse    map[int64]int
+	pruning  map[int64]int
+	queued   map[string]map[int64]struct{}
+	deferred map[string][]int64
+}
+
+func newPruningState() *pruningState {
+	return &pruningState{
+		inUse:    make(map[int64]int),
+		pruning:  make(map[int64]int),
+		queued:   make(map[string]map[int64]struct{}),
+		deferred: make(map[string][]int64),
+	}
+}
+
+// SetAsyncPruning enables or disables asynchronous pruning. When enabled, the
+// versions pruned on Commit are deleted by a dedicated goroutine instead of
+// the committing one, which blocks only when the pruner falls behind by more
+// than a few pruning intervals.
+func (rs *Store) SetAsyncPruning(async bool) {
+	switch {
+	case async && rs.pruneJobs == nil:
+		rs.pruneJobs = make(chan pruneJob, pruneQueueSize)
+		go rs.runPruner(rs.pruneJobs)
+
+	case !async && rs.pruneJobs != nil:
+		close(rs.pruneJobs)
+		rs.pruneJobs = nil
+		rs.pruneWG.Wait()
+	}
+}
+
+// RetainVersion prevents the given version from being pruned until the
+// returned function is called, so that it can be safely read, e.g. through
+// CacheMultiStoreWithVersion. Pruning the version is deferred until then. An
+// error is returned if the version is being pruned.
+func (rs *Store) RetainVersion(version int64) (func(), error) {
+	ps := rs.pruningState
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.pruning[version] > 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "version %d is being pruned", version)
+	}
+	ps.inUse[version]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ps.mtx.Lock()
+			defer ps.mtx.Unlock()
+
+			if ps.inUse[version]--; ps.inUse[version] <= 0 {
+				delete(ps.inUse, version)
+			}
+		})
+	}, nil
+}
+
+// schedulePruning prunes the given job, in the background if asynchronous
+// pruning is enabled.
+func (rs *Store) schedulePruning(job pruneJob) {
+	rs.queuePruneHeights(job)
+
+	if rs.pruneJobs == nil {
+		if err := rs.prune(job, false); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	rs.pruneWG.Add(1)
+	select {
+	case rs.pruneJobs <- job:
+	default:
+		// the pruner fell behind, wait for it to catch up
+		start := time.Now()
+		rs.pruneJobs <- job
+		telemetry.MeasureSince(start, "store", "pruning", "backpressure")
+	}
+	telemetry.SetGauge(float32(len(rs.pruneJobs)), "store", "pruning", "queue_size")
+}
+
+// waitPruning blocks until the queued pruning jobs are done.
+func (rs *Store) waitPruning() {
+	rs.pruneWG.Wait()
+}
+
+func (rs *Store) runPruner(jobs <-chan pruneJob) {
+	for job := range jobs {
+		telemetry.SetGauge(float32(len(jobs)), "store", "pruning", "queue_size")
+		if err := rs.prune(job, true); err != nil {
+			rs.logger.Error("failed to prune stores; the heights will be pruned again later", "err", err)
+		}
+		rs.pruneWG.Done()
+	}
+}
+
+// prune deletes the heights of the job from its stores, except for the heights
+// in use which are deferred to the next job of their pruning group. When async
+// is true, each height is deleted separately so that Commit, which cannot run
+// concurrently with a deletion, is never held up for long.
+func (rs *Store) prune(job pruneJob, async bool) error {
+	defer telemetry.MeasureSince(time.Now(), "store", "pruning", "duration")
+
+	for _, target := range job {
+		heights := rs.startPruning(target)
+		if len(heights) == 0 {
+			continue
+		}
+
+		for _, store := range target.stores {
+			if err := rs.deleteVersions(store, heights, async); err != nil {
+				rs.finishPruning(target.group, heights, false)
+				return err
+			}
+		}
+		rs.finishPruning(target.group, heights, true)
+		telemetry.IncrCounter(float32(len(heights)), "store", "pruning", "pruned_heights")
+	}
+
+	return nil
+}
+
+func (rs *Store) deleteVersions(store *iavl.Store, heights []int64, async bool) error {
+	del := func(heights ...int64) error {
+		rs.commitMtx.Lock()
+		defer rs.commitMtx.Unlock()
+
+		err := store.DeleteVersions(heights...)
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+			return err
+		}
+		return nil
+	}
+
+	if !async {
+		return del(heights...)
+	}
+	for _, height := range heights {
+		if err := del(height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queuePruneHeights records the heights of the job as queued, until they are
+// deleted from all the stores of their pruning group.
+func (rs *Store) queuePruneHeights(job pruneJob) {
+	ps := rs.pruningState
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	for _, target := range job {
+		queued, ok := ps.queued[target.group]
+		if !ok {
+			queued = make(map[int64]struct{})
+			ps.queued[target.group] = queued
+		}
+		for _, height := range target.heights {
+			queued[height] = struct{}{}
+		}
+	}
+}
+
+// startPruning marks the heights of the target which are not in use as being
+// pruned and returns them. The heights in use are deferred.
+func (rs *Store) startPruning(target pruneTarget) []int64 {
+	ps := rs.pruningState
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	heights := make([]int64, 0, len(target.heights))
+	for _, height := range target.heights {
+		if ps.inUse[height] > 0 {
+			ps.deferred[target.group] = append(ps.deferred[target.group], height)
+			continue
+		}
+		ps.pruning[height]++
+		heights = append(heights, height)
+	}
+
+	if deferred := len(target.heights) - len(heights); deferred > 0 {
+		telemetry.IncrCounter(float32(deferred), "store", "pruning", "deferred_heights")
+	}
+
+	return heights
+}
+
+// finishPruning unmarks the heights as being pruned. They are no longer queued
+// if they were deleted, otherwise they are deferred.
+func (rs *Store) finishPruning(group string, heights []int64, deleted bool) {
+	ps := rs.pruningState
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	for _, height := range heights {
+		if ps.pruning[height]--; ps.pruning[height] <= 0 {
+			delete(ps.pruning, height)
+		}
+		if deleted {
+			delete(ps.queued[group], height)
+		} else {
+			ps.deferred[group] = append(ps.deferred[group], height)
+		}
+	}
+}
+
+// takeDeferredHeights returns and clears the heights deferred from the previous
+// pruning of the group.
+func (rs *Store) takeDeferredHeights(group string) []int64 {
+	ps := rs.pruningState
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	deferred := ps.deferred[group]
+	delete(ps.deferred, group)
+
+	return deferred
+}
+
+// unprunedHeights returns the given heights pending to be pruned from a pruning
+// group along with the queued ones which are not deleted yet, which must be
+// persisted so that they are pruned after a restart.
+func (rs *Store) unprunedHeights(group string, pending []int64) []int64 {
+	ps := rs.pruningState
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if len(ps.queued[group]) == 0 {
+		return pending
+	}
+
+	heights := make([]int64, 0, len(pending)+len(ps.queued[group]))
+	heights = append(heights, pending...)
+	for height := range ps.queued[group] {
+		heights = append(heights, height)
+	}
+
+	return uniqueHeights(heights)
+}
+
+// uniqueHeights sorts the heights and removes the duplicates.
+func uniqueHeights(heights []int64) []int64 {
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	unique := heights[:0]
+	for _, height := range heights {
+		if len(unique) == 0 || height != unique[len(unique)-1] {
+			unique = append(unique, height)
+		}
+	}
+
+	return unique
+}
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -307,3 +308,71 @@ func BenchmarkMultistoreSnapshotRestore100K(b *testing.B) {
 func BenchmarkMultistoreSnapshotRestore1M(b *testing.B) {
 	benchmarkMultistoreSnapshotRestore(b, 10, 100000)
 }
+
+// itemsReader reads snapshot items from a slice, failing at the given index.
+type itemsReader struct {
+	items  []snapshottypes.SnapshotItem
+	failAt int
+}
+
+func (r *itemsReader) ReadMsg(msg proto.Message) error {
+	if len(r.items) == 0 {
+		return io.EOF
+	}
+	if r.failAt == 0 {
+		return errors.New("interrupted")
+	}
+	*msg.(*snapshottypes.SnapshotItem) = r.items[0]
+	r.items = r.items[1:]
+	r.failAt--
+	return nil
+}
+
+func TestMultistoreSnapshotRestore_Resume(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		defer streamWriter.Close()
+		require.NoError(t, source.Snapshot(version, streamWriter))
+	}()
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+	var items []snapshottypes.SnapshotItem
+	secondStore := -1
+	for {
+		item := snapshottypes.SnapshotItem{}
+		if err := streamReader.ReadMsg(&item); err == io.EOF {
+			break
+		} else {
+			require.NoError(t, err)
+		}
+		if item.GetStore() != nil && item.GetStore().Name == "iavl2" {
+			secondStore = len(items)
+		}
+		items = append(items, item)
+	}
+	require.Positive(t, secondStore)
+
+	// the restore is interrupted while importing the second store, once the
+	// first one is imported
+	db := dbm.NewMemDB()
+	target := newMultiStoreWithMixedMounts(db)
+	_, err = target.Restore(version, snapshottypes.CurrentFormat, &itemsReader{items: items, failAt: secondStore + 2})
+	require.Error(t, err)
+
+	// restoring the snapshot again after a restart skips the first store
+	target = newMultiStoreWithMixedMounts(db)
+	require.Zero(t, target.LastCommitID().Version)
+	require.EqualValues(t, version, target.GetStoreByName("iavl1").(types.CommitKVStore).LastCommitID().Version)
+	_, err = target.Restore(version, snapshottypes.CurrentFormat, &itemsReader{items: items, failAt: -1})
+	require.NoError(t, err)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore),
+			target.GetStoreByName(name).(types.CommitKVStore), "store %q not equal", name)
+	}
+}
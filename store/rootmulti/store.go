@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/metricskv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -31,9 +32,10 @@ import (
 )
 
 const (
-	latestVersionKey = "s/latest"
-	pruneHeightsKey  = "s/pruneheights"
-	commitInfoKeyFmt = "s/%d" // s/<version>
+	latestVersionKey        = "s/latest"
+	pruneHeightsKey         = "s/pruneheights"
+	storePruneHeightsKeyFmt = "s/pruneheights/%s" // s/pruneheights/<store name>
+	commitInfoKeyFmt        = "s/%d"              // s/<version>
 
 	proofsPath = "proofs"
 )
@@ -55,8 +57,16 @@ type Store struct {
 	keysByName          map[string]types.StoreKey
 	lazyLoading         bool
 	pruneHeights        []int64
+	storePruning        map[string]*storePruning
 	initialVersion      int64
 
+	// commitMtx serializes the writes to the IAVL trees of Commit and of the
+	// asynchronous pruner.
+	commitMtx    sync.Mutex
+	pruningState *pruningState
+	pruneJobs    chan pruneJob
+	pruneWG      sync.WaitGroup
+
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
 	traceContextMutex sync.Mutex
@@ -64,6 +74,17 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	// metricsStores holds the names of the stores with metrics enabled, "*"
+	// enabling them for all the stores.
+	metricsStores map[string]bool
+}
+
+// storePruning holds the pruning strategy of a store which overrides the one
+// of the multistore, and the heights pending to be pruned from it.
+type storePruning struct {
+	opts         types.PruningOptions
+	pruneHeights []int64
 }
 
 var (
@@ -86,7 +107,10 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		stores:              make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:          make(map[string]types.StoreKey),
 		pruneHeights:        make([]int64, 0),
+		storePruning:        make(map[string]*storePruning),
+		pruningState:        newPruningState(),
 		listeners:           make(map[types.StoreKey][]types.WriteListener),
+		metricsStores:       make(map[string]bool),
 	}
 }
 
@@ -102,6 +126,26 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
 }
 
+// SetStorePruning sets the pruning strategy of the IAVL store with the given
+// name, overriding the pruning strategy of the root store. The store must be
+// mounted when the root store is loaded.
+func (rs *Store) SetStorePruning(storeName string, pruningOpts types.PruningOptions) {
+	rs.storePruning[storeName] = &storePruning{
+		opts:         pruningOpts,
+		pruneHeights: make([]int64, 0),
+	}
+}
+
+// GetStorePruning returns the pruning strategy of the store with the given
+// name, which is the one of the root store unless overridden.
+func (rs *Store) GetStorePruning(storeName string) types.PruningOptions {
+	if sp, ok := rs.storePruning[storeName]; ok {
+		return sp.opts
+	}
+
+	return rs.pruningOpts
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -184,6 +228,13 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	// the queued pruning jobs hold the stores being replaced
+	rs.waitPruning()
+
+	if err := rs.validateStorePruning(); err != nil {
+		return err
+	}
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
@@ -191,7 +242,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	// load old data if we are not version 0
 	if ver != 0 {
 		var err error
-		cInfo, err = getCommitInfo(rs.db, ver)
+		cInfo, err = GetCommitInfo(rs.db, ver)
 		if err != nil {
 			return err
 		}
@@ -265,6 +316,31 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	if err == nil && len(ph) > 0 {
 		rs.pruneHeights = ph
 	}
+	for name, sp := range rs.storePruning {
+		ph, err := getStorePruningHeights(rs.db, name)
+		if err == nil && len(ph) > 0 {
+			sp.pruneHeights = ph
+		}
+	}
+
+	return nil
+}
+
+// validateStorePruning checks that the stores with their own pruning strategy
+// are mounted IAVL stores and that their strategies are valid.
+func (rs *Store) validateStorePruning() error {
+	for name, sp := range rs.storePruning {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("pruning strategy set for unknown store %s", name)
+		}
+		if typ := rs.storesParams[key].typ; typ != types.StoreTypeIAVL {
+			return fmt.Errorf("pruning strategy set for store %s of type %s, only IAVL stores can be pruned", name, typ)
+		}
+		if err := sp.opts.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning strategy of store %s: %w", name, err)
+		}
+	}
 
 	return nil
 }
@@ -371,6 +447,23 @@ func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
 	return false
 }
 
+// SetStoreMetrics enables or disables the metrics of the accesses to the store
+// with the given name, or to all the stores for "*". The metrics are recorded
+// by wrapping the store in a metricskv.Store, below the caches of the branches
+// of the multistore, so that they measure the accesses reaching the store.
+func (rs *Store) SetStoreMetrics(storeName string, enabled bool) {
+	if enabled {
+		rs.metricsStores[storeName] = true
+	} else {
+		delete(rs.metricsStores, storeName)
+	}
+}
+
+// MetricsEnabled returns if metrics are enabled for a specific KVStore
+func (rs *Store) MetricsEnabled(key types.StoreKey) bool {
+	return rs.metricsStores[key.Name()] || rs.metricsStores["*"]
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -382,6 +475,19 @@ func (rs *Store) LastCommitID() types.CommitID {
 	return rs.lastCommitInfo.CommitID()
 }
 
+// CommitInfo returns the commit info of the given version, i.e. the name,
+// version and hash of each store, or of the latest version if it is zero.
+func (rs *Store) CommitInfo(version int64) (*types.CommitInfo, error) {
+	if rs.lastCommitInfo != nil && (version == 0 || version == rs.lastCommitInfo.Version) {
+		return rs.lastCommitInfo, nil
+	}
+	if version == 0 {
+		version = GetLatestVersion(rs.db)
+	}
+
+	return GetCommitInfo(rs.db, version)
+}
+
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
 	var previousHeight, version int64
@@ -399,64 +505,148 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.commitMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores)
+	rs.commitMtx.Unlock()
+
+	rs.pruneHeights = appendPruneHeight(rs.pruningOpts, previousHeight, rs.pruneHeights)
+	for _, sp := range rs.storePruning {
+		sp.pruneHeights = appendPruneHeight(sp.opts, previousHeight, sp.pruneHeights)
+	}
+
+	// batch prune the stores whose pruning interval is reached
+	rs.pruneStores(version)
 
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.unprunedHeights("", rs.pruneHeights))
+	rs.flushStorePruningHeights()
+
+	return types.CommitID{
+		Version: version,
+		Hash:    rs.lastCommitInfo.Hash(),
+	}
+}
+
+// appendPruneHeight appends the height to be pruned once the given previous
+// height is committed, if any, to the pruning heights of a pruning strategy.
+func appendPruneHeight(opts types.PruningOptions, previousHeight int64, pruneHeights []int64) []int64 {
 	// Determine if pruneHeight height needs to be added to the list of heights to
 	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
-	if rs.pruningOpts.Interval > 0 && int64(rs.pruningOpts.KeepRecent) < previousHeight {
-		pruneHeight := previousHeight - int64(rs.pruningOpts.KeepRecent)
+	if opts.Interval > 0 && int64(opts.KeepRecent) < previousHeight {
+		pruneHeight := previousHeight - int64(opts.KeepRecent)
 		// We consider this height to be pruned iff:
 		//
 		// - KeepEvery is zero as that means that all heights should be pruned.
 		// - KeepEvery % (height - KeepRecent) != 0 as that means the height is not
 		// a 'snapshot' height.
-		if rs.pruningOpts.KeepEvery == 0 || pruneHeight%int64(rs.pruningOpts.KeepEvery) != 0 {
-			rs.pruneHeights = append(rs.pruneHeights, pruneHeight)
+		if opts.KeepEvery == 0 || pruneHeight%int64(opts.KeepEvery) != 0 {
+			pruneHeights = append(pruneHeights, pruneHeight)
 		}
 	}
 
-	// batch prune if the current height is a pruning interval height
-	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
-		rs.PruneStores(true, nil)
-	}
-
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
-
-	return types.CommitID{
-		Version: version,
-		Hash:    rs.lastCommitInfo.Hash(),
-	}
+	return pruneHeights
 }
 
-// PruneStores will batch delete a list of heights from each mounted sub-store.
-// If clearStorePruningHeihgts is true, store's pruneHeights is appended to the
-// pruningHeights and reset after finishing pruning.
+// PruneStores will batch delete a list of heights from each mounted sub-store,
+// except for the heights retained by the pruning strategy of the store, which
+// is the one of the root store unless overridden with SetStorePruning.
+// If clearStorePruningHeihgts is true, the pruneHeights pending for each store
+// are deleted as well and reset after finishing pruning. The heights are
+// deleted before returning even if asynchronous pruning is enabled.
 func (rs *Store) PruneStores(clearStorePruningHeihgts bool, pruningHeights []int64) {
-	if clearStorePruningHeihgts {
-		pruningHeights = append(pruningHeights, rs.pruneHeights...)
+	rs.waitPruning()
+	if err := rs.prune(rs.pruneJob(0, clearStorePruningHeihgts, pruningHeights), false); err != nil {
+		panic(err)
 	}
+	rs.resetPruneHeights(0, clearStorePruningHeihgts)
+}
 
-	if len(rs.pruneHeights) == 0 {
-		return
+// pruneStores prunes the stores whose pruning interval is reached at the given
+// version, asynchronously if enabled.
+func (rs *Store) pruneStores(version int64) {
+	job := rs.pruneJob(version, true, nil)
+	rs.resetPruneHeights(version, true)
+	if len(job) > 0 {
+		rs.schedulePruning(job)
 	}
+}
+
+// atPruningInterval returns true if the pruning interval of the given strategy
+// is reached at the given version, or if the version is zero.
+func atPruningInterval(opts types.PruningOptions, version int64) bool {
+	return version == 0 || (opts.Interval > 0 && version%int64(opts.Interval) == 0)
+}
 
+// pruneJob collects the heights to delete from the IAVL stores of each pruning
+// group. If version is not zero, only the groups whose pruning interval is
+// reached at that version are pruned.
+func (rs *Store) pruneJob(version int64, clearStorePruningHeihgts bool, pruningHeights []int64) pruneJob {
+	latestHeight := rs.lastCommitInfo.GetVersion()
+
+	groups := make(map[string][]*iavl.Store)
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
 
-			if err := store.(*iavl.Store).DeleteVersions(pruningHeights...); err != nil {
-				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-					panic(err)
-				}
+		var group string
+		if _, ok := rs.storePruning[key.Name()]; ok {
+			group = key.Name()
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		groups[group] = append(groups[group], rs.GetCommitKVStore(key).(*iavl.Store))
+	}
+
+	var job pruneJob
+	for group, stores := range groups {
+		opts, pending := rs.pruningOpts, rs.pruneHeights
+		if sp, ok := rs.storePruning[group]; ok {
+			opts, pending = sp.opts, sp.pruneHeights
+		}
+		if !atPruningInterval(opts, version) {
+			continue
+		}
+
+		var heights []int64
+		for _, height := range pruningHeights {
+			if !opts.Retains(height, latestHeight) {
+				heights = append(heights, height)
 			}
 		}
+		if clearStorePruningHeihgts {
+			heights = append(heights, pending...)
+		}
+		heights = append(heights, rs.takeDeferredHeights(group)...)
+		if len(heights) == 0 {
+			continue
+		}
+
+		job = append(job, pruneTarget{
+			group:   group,
+			stores:  stores,
+			heights: uniqueHeights(heights),
+		})
+	}
+
+	return job
+}
+
+// resetPruneHeights resets the heights pending to be pruned from the groups
+// whose pruning interval is reached at the given version.
+func (rs *Store) resetPruneHeights(version int64, clearStorePruningHeihgts bool) {
+	if !clearStorePruningHeihgts {
+		return
 	}
 
-	if clearStorePruningHeihgts {
+	if atPruningInterval(rs.pruningOpts, version) {
 		rs.pruneHeights = make([]int64, 0)
 	}
+	for _, sp := range rs.storePruning {
+		if atPruningInterval(sp.opts, version) {
+			sp.pruneHeights = make([]int64, 0)
+		}
+	}
 }
 
 // CacheWrap implements CacheWrapper/Store/CommitStore.
@@ -475,6 +665,9 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
 		store := types.KVStore(v)
+		if rs.MetricsEnabled(k) {
+			store = metricskv.NewStore(store, k.Name())
+		}
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(k) {
@@ -488,7 +681,8 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights. Use RetainVersion to prevent the version from
+// being pruned while it is read.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
@@ -510,6 +704,10 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			cacheStore = store
 		}
 
+		if rs.MetricsEnabled(key) {
+			cacheStore = metricskv.NewStore(cacheStore, key.Name())
+		}
+
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(key) {
@@ -550,6 +748,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	}
 	store := types.KVStore(s)
 
+	if rs.MetricsEnabled(key) {
+		store = metricskv.NewStore(store, key.Name())
+	}
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
 	}
@@ -586,6 +787,14 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(err)
 	}
 
+	if req.Height > 0 {
+		release, err := rs.RetainVersion(req.Height)
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+		defer release()
+	}
+
 	if firstPath == proofsPath {
 		return rs.doProofsQuery(req)
 	}
@@ -620,7 +829,7 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	if res.Height == rs.lastCommitInfo.Version {
 		commitInfo = rs.lastCommitInfo
 	} else {
-		commitInfo, err = getCommitInfo(rs.db, res.Height)
+		commitInfo, err = GetCommitInfo(rs.db, res.Height)
 		if err != nil {
 			return sdkerrors.QueryResult(err)
 		}
@@ -684,6 +893,12 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
+	release, err := rs.RetainVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// Collect stores to snapshot (only IAVL stores are supported)
 	type namedStore struct {
 		*iavl.Store
@@ -762,7 +977,11 @@ func (rs *Store) Restore(
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
+	//
+	// The stores already imported at the height by an interrupted restore are skipped, so that the
+	// restore can be resumed by restoring the snapshot again.
 	var importer *iavltree.Importer
+	var skipping bool
 	var snapshotItem snapshottypes.SnapshotItem
 loop:
 	for {
@@ -783,10 +1002,15 @@ loop:
 				}
 				importer.Close()
 			}
+			importer = nil
 			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
 			}
+			skipping = store.LastCommitID().Version == int64(height)
+			if skipping {
+				continue
+			}
 			importer, err = store.Import(int64(height))
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
@@ -794,6 +1018,9 @@ loop:
 			defer importer.Close()
 
 		case *snapshottypes.SnapshotItem_IAVL:
+			if skipping {
+				continue
+			}
 			if importer == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
@@ -914,10 +1141,72 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	}
 }
 
+// StoresMissingVersion returns the sorted names of the mounted IAVL stores
+// which do not have the given version, e.g. because it was pruned.
+func (rs *Store) StoresMissingVersion(version int64) []string {
+	var missing []string
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		store = rs.GetCommitKVStore(key)
+		if !store.(*iavl.Store).VersionExists(version) {
+			missing = append(missing, key.Name())
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
+
+// RollbackCommitInfo returns the commit info the multistore would have after a
+// rollback to the target version, without modifying it. It returns an error if
+// the target is above the latest version or if a mounted store lacks it.
+func (rs *Store) RollbackCommitInfo(target int64) (*types.CommitInfo, error) {
+	if target <= 0 {
+		return nil, fmt.Errorf("invalid rollback height target: %d", target)
+	}
+	if latest := rs.LastCommitID().Version; target > latest {
+		return nil, fmt.Errorf("rollback height target %d is above the latest version %d", target, latest)
+	}
+	if missing := rs.StoresMissingVersion(target); len(missing) != 0 {
+		return nil, fmt.Errorf("version %d does not exist in stores: %s", target, strings.Join(missing, ", "))
+	}
+
+	storeInfos := []types.StoreInfo{}
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+
+		commitID := store.LastCommitID()
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			immutable, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(target)
+			if err != nil {
+				return nil, err
+			}
+			commitID = immutable.LastCommitID()
+		}
+
+		storeInfos = append(storeInfos, types.StoreInfo{
+			Name:     key.Name(),
+			CommitId: commitID,
+		})
+	}
+
+	return &types.CommitInfo{
+		Version:    target,
+		StoreInfos: storeInfos,
+	}, nil
+}
+
 // RollbackToVersion delete the versions after `target` and update the latest version.
+// It fails without modifying any store if a mounted store lacks the target version.
 func (rs *Store) RollbackToVersion(target int64) error {
-	if target <= 0 {
-		return fmt.Errorf("invalid rollback height target: %d", target)
+	rs.waitPruning()
+
+	if _, err := rs.RollbackCommitInfo(target); err != nil {
+		return err
 	}
 
 	for key, store := range rs.stores {
@@ -994,7 +1283,7 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 }
 
 func (rs *Store) doProofsQuery(req abci.RequestQuery) abci.ResponseQuery {
-	commitInfo, err := getCommitInfo(rs.db, req.Height)
+	commitInfo, err := GetCommitInfo(rs.db, req.Height)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
@@ -1011,8 +1300,8 @@ func (rs *Store) doProofsQuery(req abci.RequestQuery) abci.ResponseQuery {
 	return res
 }
 
-// Gets commitInfo from disk.
-func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
+// GetCommitInfo gets the commitInfo of the given version from disk.
+func GetCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
 
 	bz, err := db.Get([]byte(cInfoKey))
@@ -1050,6 +1339,10 @@ func setLatestVersion(batch dbm.Batch, version int64) {
 }
 
 func setPruningHeights(batch dbm.Batch, pruneHeights []int64) {
+	batch.Set([]byte(pruneHeightsKey), encodePruningHeights(pruneHeights))
+}
+
+func encodePruningHeights(pruneHeights []int64) []byte {
 	bz := make([]byte, 0)
 	for _, ph := range pruneHeights {
 		buf := make([]byte, 8)
@@ -1057,7 +1350,7 @@ func setPruningHeights(batch dbm.Batch, pruneHeights []int64) {
 		bz = append(bz, buf...)
 	}
 
-	batch.Set([]byte(pruneHeightsKey), bz)
+	return bz
 }
 
 func getPruningHeights(db dbm.DB) ([]int64, error) {
@@ -1069,6 +1362,10 @@ func getPruningHeights(db dbm.DB) ([]int64, error) {
 		return nil, errors.New("no pruned heights found")
 	}
 
+	return decodePruningHeights(bz), nil
+}
+
+func decodePruningHeights(bz []byte) []int64 {
 	prunedHeights := make([]int64, len(bz)/8)
 	i, offset := 0, 0
 	for offset < len(bz) {
@@ -1077,7 +1374,35 @@ func getPruningHeights(db dbm.DB) ([]int64, error) {
 		offset += 8
 	}
 
-	return prunedHeights, nil
+	return prunedHeights
+}
+
+func getStorePruningHeights(db dbm.DB, storeName string) ([]int64, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(storePruneHeightsKeyFmt, storeName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pruned heights of store %s: %w", storeName, err)
+	}
+
+	return decodePruningHeights(bz), nil
+}
+
+// flushStorePruningHeights persists the heights pending to be pruned from the
+// stores with their own pruning strategy.
+func (rs *Store) flushStorePruningHeights() {
+	if len(rs.storePruning) == 0 {
+		return
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	for name, sp := range rs.storePruning {
+		batch.Set([]byte(fmt.Sprintf(storePruneHeightsKeyFmt, name)), encodePruningHeights(rs.unprunedHeights(name, sp.pruneHeights)))
+	}
+
+	if err := batch.Write(); err != nil {
+		panic(fmt.Errorf("error on batch write %w", err))
+	}
 }
 
 func flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo, pruneHeights []int64) {
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/metricskv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -206,7 +207,7 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	expectedCommitID := getExpectedCommitID(store, 1)
 	checkStore(t, store, expectedCommitID, commitID)
 
-	ci, err := getCommitInfo(db, 1)
+	ci, err := GetCommitInfo(db, 1)
 	require.NoError(t, err)
 	require.Equal(t, int64(1), ci.Version)
 	require.Equal(t, 3, len(ci.StoreInfos))
@@ -296,7 +297,7 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	require.Equal(t, v4, rl4.Get(k4))
 
 	// check commitInfo in storage
-	ci, err = getCommitInfo(db, 2)
+	ci, err = GetCommitInfo(db, 2)
 	require.NoError(t, err)
 	require.Equal(t, int64(2), ci.Version)
 	require.Equal(t, 4, len(ci.StoreInfos), ci.StoreInfos)
@@ -355,7 +356,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 		multi.Commit()
 
-		cinfo, err := getCommitInfo(multi.db, int64(i))
+		cinfo, err := GetCommitInfo(multi.db, int64(i))
 		require.NoError(t, err)
 		require.Equal(t, int64(i), cinfo.Version)
 	}
@@ -370,7 +371,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 	multi.Commit()
 
-	flushedCinfo, err := getCommitInfo(multi.db, 3)
+	flushedCinfo, err := GetCommitInfo(multi.db, 3)
 	require.Nil(t, err)
 	require.NotEqual(t, initCid, flushedCinfo, "CID is different after flush to disk")
 
@@ -380,7 +381,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 	multi.Commit()
 
-	postFlushCinfo, err := getCommitInfo(multi.db, 4)
+	postFlushCinfo, err := GetCommitInfo(multi.db, 4)
 	require.NoError(t, err)
 	require.Equal(t, int64(4), postFlushCinfo.Version, "Commit changed after in-memory commit")
 
@@ -570,6 +571,174 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 1))
+		ms.SetStorePruning("store1", types.PruneNothing)
+		ms.SetStorePruning("store2", types.NewPruningOptions(2, 3, 4))
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+	requireVersions := func(ms *Store, key types.StoreKey, saved, deleted []int64) {
+		store := ms.GetCommitKVStore(key).(*iavl.Store)
+		for _, v := range saved {
+			require.True(t, store.VersionExists(v), "expected height %d of %s to be saved", v, key.Name())
+		}
+		for _, v := range deleted {
+			require.False(t, store.VersionExists(v), "expected height %d of %s to be deleted", v, key.Name())
+		}
+	}
+
+	ms := newStore()
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	require.Equal(t, types.PruneNothing, ms.GetStorePruning("store1"))
+	require.Equal(t, types.NewPruningOptions(2, 0, 1), ms.GetStorePruning("store3"))
+	requireVersions(ms, testStoreKey1, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, nil)
+	requireVersions(ms, testStoreKey2, []int64{3, 6, 7, 8, 9, 10}, []int64{1, 2, 4, 5})
+	requireVersions(ms, testStoreKey3, []int64{8, 9, 10}, []int64{1, 2, 3, 4, 5, 6, 7})
+
+	// the heights pending to be pruned from a store survive a restart
+	ms = newStore()
+	require.Equal(t, []int64{7}, ms.storePruning["store2"].pruneHeights)
+
+	// given heights are only pruned from the stores which do not retain them
+	ms.PruneStores(false, []int64{7, 8})
+	requireVersions(ms, testStoreKey1, []int64{7, 8}, nil)
+	requireVersions(ms, testStoreKey2, []int64{8}, []int64{7})
+
+	// only mounted IAVL stores can have their own pruning options
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetStorePruning("store4", types.PruneNothing)
+	require.EqualError(t, ms.LoadLatestVersion(), "pruning strategy set for unknown store store4")
+
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetStorePruning("store1", types.NewPruningOptions(2, 3, 0))
+	require.Error(t, ms.LoadLatestVersion())
+}
+
+func TestMultiStore_AsyncPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 1))
+	ms.SetAsyncPruning(true)
+	require.NoError(t, ms.LoadLatestVersion())
+	defer ms.SetAsyncPruning(false)
+
+	ms.Commit()
+	release, err := ms.RetainVersion(1)
+	require.NoError(t, err)
+
+	for i := int64(1); i < 10; i++ {
+		ms.Commit()
+	}
+	ms.waitPruning()
+
+	// the height in use is deferred and persisted to be pruned after a restart
+	store := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+	for _, v := range []int64{2, 3, 4, 5, 6, 7} {
+		require.False(t, store.VersionExists(v), "expected height %d to be deleted", v)
+	}
+	for _, v := range []int64{1, 8, 9, 10} {
+		require.True(t, store.VersionExists(v), "expected height %d to be saved", v)
+	}
+	ph, err := getPruningHeights(db)
+	require.NoError(t, err)
+	require.Contains(t, ph, int64(1))
+	require.Equal(t, []int64{1}, ms.unprunedHeights("", ms.pruneHeights))
+
+	release()
+	release() // releasing twice is a no-op
+	ms.Commit()
+	ms.waitPruning()
+	require.False(t, store.VersionExists(1))
+	require.False(t, store.VersionExists(8))
+
+	// a height being pruned cannot be retained
+	heights := ms.startPruning(pruneTarget{heights: []int64{9}})
+	require.Equal(t, []int64{9}, heights)
+	_, err = ms.RetainVersion(9)
+	require.Error(t, err)
+	ms.finishPruning("", heights, true)
+	release, err = ms.RetainVersion(9)
+	require.NoError(t, err)
+	release()
+}
+
+func TestMultiStore_CommitInfo(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	commitIDs := make(map[int64]types.CommitID)
+	for i := int64(1); i <= 3; i++ {
+		ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		commitIDs[i] = ms.Commit()
+	}
+
+	for _, version := range []int64{1, 2, 3} {
+		cInfo, err := ms.CommitInfo(version)
+		require.NoError(t, err)
+		require.Equal(t, commitIDs[version], cInfo.CommitID())
+	}
+
+	// the latest version is returned for 0, also after a reload
+	cInfo, err := ms.CommitInfo(0)
+	require.NoError(t, err)
+	require.Equal(t, commitIDs[3], cInfo.CommitID())
+
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	cInfo, err = ms.CommitInfo(0)
+	require.NoError(t, err)
+	require.Equal(t, commitIDs[3], cInfo.CommitID())
+
+	_, err = ms.CommitInfo(4)
+	require.Error(t, err)
+}
+
+func TestMultiStore_RollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	commitIDs := make(map[int64]types.CommitID)
+	for i := int64(1); i <= 5; i++ {
+		ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		commitIDs[i] = ms.Commit()
+	}
+
+	cInfo, err := ms.RollbackCommitInfo(3)
+	require.NoError(t, err)
+	require.Equal(t, commitIDs[3], cInfo.CommitID())
+
+	_, err = ms.RollbackCommitInfo(0)
+	require.Error(t, err)
+	_, err = ms.RollbackCommitInfo(6)
+	require.Error(t, err)
+
+	// a store lacking the target version prevents the rollback
+	require.NoError(t, ms.GetCommitKVStore(testStoreKey2).(*iavl.Store).DeleteVersions(2))
+	require.Equal(t, []string{"store2"}, ms.StoresMissingVersion(2))
+	require.Empty(t, ms.StoresMissingVersion(3))
+	_, err = ms.RollbackCommitInfo(2)
+	require.EqualError(t, err, "version 2 does not exist in stores: store2")
+	require.Error(t, ms.RollbackToVersion(2))
+	require.Equal(t, commitIDs[5], ms.LastCommitID())
+	require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(5))
+
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.Equal(t, commitIDs[3], ms.LastCommitID())
+	require.Equal(t, []byte("value3"), ms.GetStoreByName("store1").(types.KVStore).Get([]byte("key")))
+	require.Equal(t, []string{"store1", "store2", "store3"}, ms.StoresMissingVersion(4))
+
+	// the rollback is persisted
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, commitIDs[3], ms.LastCommitID())
+}
+
 // TestUnevenStoresHeightCheck tests if loading root store correctly errors when
 // there's any module store with the wrong height
 func TestUnevenStoresHeightCheck(t *testing.T) {
@@ -918,3 +1087,28 @@ func TestStateListeners(t *testing.T) {
 	cacheMulti.Write()
 	require.Equal(t, 1, len(listener.stateCache))
 }
+
+func TestStoreMetrics(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	require.False(t, ms.MetricsEnabled(testStoreKey1))
+	ms.SetStoreMetrics(testStoreKey1.Name(), true)
+	require.True(t, ms.MetricsEnabled(testStoreKey1))
+	require.False(t, ms.MetricsEnabled(testStoreKey2))
+	require.IsType(t, &metricskv.Store{}, ms.GetKVStore(testStoreKey1))
+	require.IsType(t, &iavl.Store{}, ms.GetKVStore(testStoreKey2))
+
+	// the stores of the branches are measured below their caches
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(testStoreKey1).Set(testKey1, testValue1)
+	cms.Write()
+	require.Equal(t, testValue1, ms.GetKVStore(testStoreKey1).Get(testKey1))
+
+	ms.SetStoreMetrics("*", true)
+	require.True(t, ms.MetricsEnabled(testStoreKey2))
+	ms.SetStoreMetrics("*", false)
+	ms.SetStoreMetrics(testStoreKey1.Name(), false)
+	require.False(t, ms.MetricsEnabled(testStoreKey1))
+}
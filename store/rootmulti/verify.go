@@ -0,0 +1,49 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// verifyCacheSize is the IAVL node cache size of each store loaded by
+// VerifyCommitInfo.
+const verifyCacheSize = 10000
+
+// VerifyCommitInfo loads the IAVL stores of the multistore at the given version
+// of db, or at the latest version if it is zero, without writing to db, and
+// checks that their root hashes match the ones of the commit info of that
+// version, which is returned.
+func VerifyCommitInfo(db dbm.DB, version int64) (*types.CommitInfo, error) {
+	if version == 0 {
+		version = GetLatestVersion(db)
+	}
+
+	cInfo, err := GetCommitInfo(db, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load version %d: %w", version, err)
+	}
+
+	for _, storeInfo := range cInfo.StoreInfos {
+		// only IAVL stores have a version
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+
+		prefixDB := dbm.NewPrefixDB(db, []byte("s/k:"+storeInfo.Name+"/"))
+		store, err := iavl.LoadStore(prefixDB, nil, types.NewKVStoreKey(storeInfo.Name), storeInfo.CommitId, true, verifyCacheSize, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load store %s: %w", storeInfo.Name, err)
+		}
+
+		if hash := store.LastCommitID().Hash; !bytes.Equal(hash, storeInfo.CommitId.Hash) {
+			return nil, fmt.Errorf("hash of store %s mismatch its commit info; expected %X got %X", storeInfo.Name, storeInfo.CommitId.Hash, hash)
+		}
+	}
+
+	return cInfo, nil
+}
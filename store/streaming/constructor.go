@@ -10,12 +10,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/historical"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/segment"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 )
 
 // ServiceConstructor is used to construct a streaming service
@@ -27,17 +33,33 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	Index
+	Plugin
 )
 
 // Streaming option keys
 const (
-	OptStreamersFilePrefix          = "streamers.file.prefix"
-	OptStreamersFileWriteDir        = "streamers.file.write_dir"
-	OptStreamersFileOutputMetadata  = "streamers.file.output-metadata"
-	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
-	OptStreamersFileFsync           = "streamers.file.fsync"
+	OptStreamersFilePrefix           = "streamers.file.prefix"
+	OptStreamersFileWriteDir         = "streamers.file.write_dir"
+	OptStreamersFileOutputMetadata   = "streamers.file.output-metadata"
+	OptStreamersFileStopNodeOnError  = "streamers.file.stop-node-on-error"
+	OptStreamersFileFsync            = "streamers.file.fsync"
+	OptStreamersFileCompression      = "streamers.file.compression"
+	OptStreamersFileBlocksPerSegment = "streamers.file.blocks-per-segment"
+	OptStreamersFileKeepSegments     = "streamers.file.keep-segments"
+	OptStreamersIndexDir             = "streamers.index.dir"
+	OptStreamersPluginPath           = "streamers.plugin.path"
+	OptStreamersPluginArgs           = "streamers.plugin.args"
+	OptStreamersPluginDelivery       = "streamers.plugin.delivery"
+	OptStreamersPluginBufferSize     = "streamers.plugin.buffer-size"
+	OptStreamersPluginTimeout        = "streamers.plugin.timeout"
 
 	OptStoreStreamers = "store.streamers"
+
+	// per streamer filter option keys, formatted with the streamer name
+	OptStreamersKeyPrefixesFmt  = "streamers.%s.key-prefixes"
+	OptStreamersMessageTypesFmt = "streamers.%s.message-types"
+	OptStreamersEventTypesFmt   = "streamers.%s.event-types"
 )
 
 // ServiceTypeFromString returns the streaming.ServiceType corresponding to the
@@ -47,6 +69,12 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "index":
+		return Index
+
+	case "plugin":
+		return Plugin
+
 	default:
 		return Unknown
 	}
@@ -58,6 +86,12 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case Index:
+		return "index"
+
+	case Plugin:
+		return "plugin"
+
 	default:
 		return "unknown"
 	}
@@ -66,7 +100,9 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:   NewFileStreamingService,
+	Index:  NewIndexStreamingService,
+	Plugin: NewPluginStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -89,7 +125,7 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 func NewFileStreamingService(
 	opts serverTypes.AppOptions,
 	keys []types.StoreKey,
-	marshaller codec.BinaryCodec,
+	_ codec.BinaryCodec,
 ) (baseapp.StreamingService, error) {
 	homePath := cast.ToString(opts.Get(flags.FlagHome))
 	filePrefix := cast.ToString(opts.Get(OptStreamersFilePrefix))
@@ -97,6 +133,10 @@ func NewFileStreamingService(
 	outputMetadata := cast.ToBool(opts.Get(OptStreamersFileOutputMetadata))
 	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersFileStopNodeOnError))
 	fsync := cast.ToBool(opts.Get(OptStreamersFileFsync))
+	compression, err := segment.CompressionFromString(cast.ToString(opts.Get(OptStreamersFileCompression)))
+	if err != nil {
+		return nil, err
+	}
 
 	// relative path is based on node home directory.
 	if !path.IsAbs(fileDir) {
@@ -110,7 +150,55 @@ func NewFileStreamingService(
 		}
 	}
 
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
+	return file.NewStreamingService(fileDir, keys, outputMetadata, stopNodeOnErr, segment.Options{
+		Prefix:           filePrefix,
+		Compression:      compression,
+		BlocksPerSegment: cast.ToInt(opts.Get(OptStreamersFileBlocksPerSegment)),
+		KeepSegments:     cast.ToInt(opts.Get(OptStreamersFileKeepSegments)),
+		Fsync:            fsync,
+	})
+}
+
+// NewIndexStreamingService is the streaming.ServiceConstructor function for
+// creating a historical.Index.
+func NewIndexStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	homePath := cast.ToString(opts.Get(flags.FlagHome))
+	dir := cast.ToString(opts.Get(OptStreamersIndexDir))
+
+	// relative path is based on node home directory.
+	if !path.IsAbs(dir) {
+		dir = path.Join(homePath, dir)
+	}
+
+	db, err := dbm.NewDB("historical", server.GetAppDBBackend(opts), dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return historical.NewIndex(db, keys)
+}
+
+// NewPluginStreamingService is the streaming.ServiceConstructor function for
+// creating a plugin.StreamingService.
+func NewPluginStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	config := plugin.Config{
+		Path:       cast.ToString(opts.Get(OptStreamersPluginPath)),
+		Args:       cast.ToStringSlice(opts.Get(OptStreamersPluginArgs)),
+		Delivery:   plugin.Delivery(cast.ToString(opts.Get(OptStreamersPluginDelivery))),
+		BufferSize: cast.ToInt(opts.Get(OptStreamersPluginBufferSize)),
+		Timeout:    cast.ToDuration(opts.Get(OptStreamersPluginTimeout)),
+		Logger:     log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "streaming-plugin"),
+	}
+
+	return plugin.NewStreamingService(config, keys)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the
@@ -155,6 +243,18 @@ func LoadStreamingServices(
 			continue
 		}
 
+		filter, err := loadFilter(appOpts, streamerName, exposeStoreKeys)
+		if err == nil && !filter.IsEmpty() && ServiceTypeFromString(streamerName) == Index {
+			err = fmt.Errorf("the %s streaming service cannot be filtered", streamerName)
+		}
+		if err != nil {
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+
+			return nil, nil, err
+		}
+
 		constructor, err := NewServiceConstructor(streamerName)
 		if err != nil {
 			// Close any services we may have already spun up before hitting the error
@@ -179,8 +279,14 @@ func LoadStreamingServices(
 			return nil, nil, err
 		}
 
-		// register the streaming service with the BaseApp
-		bApp.SetStreamingService(streamingService)
+		// register the streaming service with the BaseApp, the historical index
+		// also serves the queries at past heights
+		if idx, ok := streamingService.(*historical.Index); ok {
+			bApp.SetHistoricalIndex(idx)
+		} else {
+			streamingService = NewFilteredStreamingService(streamingService, filter)
+			bApp.SetStreamingService(streamingService)
+		}
 
 		// kick off the background streaming service loop
 		streamingService.Stream(wg)
@@ -193,3 +299,31 @@ func LoadStreamingServices(
 	// the waitGroup is not waiting on anything.
 	return activeStreamers, wg, nil
 }
+
+// loadFilter returns the Filter of a streaming service from the AppOptions.
+// The key prefixes must be those of exposed stores.
+func loadFilter(appOpts serverTypes.AppOptions, streamerName string, exposeStoreKeys []types.StoreKey) (Filter, error) {
+	keyPrefixes, err := ParseKeyPrefixes(cast.ToStringSlice(appOpts.Get(fmt.Sprintf(OptStreamersKeyPrefixesFmt, streamerName))))
+	if err != nil {
+		return Filter{}, err
+	}
+
+	for storeName := range keyPrefixes {
+		exposed := false
+		for _, storeKey := range exposeStoreKeys {
+			if storeKey.Name() == storeName {
+				exposed = true
+				break
+			}
+		}
+		if !exposed {
+			return Filter{}, fmt.Errorf("key prefix of store %s not exposed to the %s streaming service", storeName, streamerName)
+		}
+	}
+
+	return Filter{
+		KeyPrefixes:  keyPrefixes,
+		MessageTypes: cast.ToStringSlice(appOpts.Get(fmt.Sprintf(OptStreamersMessageTypesFmt, streamerName))),
+		EventTypes:   cast.ToStringSlice(appOpts.Get(fmt.Sprintf(OptStreamersEventTypesFmt, streamerName))),
+	}, nil
+}
@@ -54,6 +54,15 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestPluginStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("plugin")
+	require.Nil(t, err)
+
+	// the plugin path is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -63,6 +72,7 @@ func TestLoadStreamingServices(t *testing.T) {
 	testCases := map[string]struct {
 		appOpts            serverTypes.AppOptions
 		activeStreamersLen int
+		expErr             bool
 	}{
 		"empty app options": {
 			appOpts: simapp.EmptyAppOptions{},
@@ -78,11 +88,27 @@ func TestLoadStreamingServices(t *testing.T) {
 		"not exposing anything": {
 			appOpts: streamingAppOptions{keys: []string{"mockKey3"}},
 		},
+		"filtered": {
+			appOpts:            streamingAppOptions{keys: []string{"mockKey1"}, keyPrefixes: []string{"mockKey1/01"}},
+			activeStreamersLen: 1,
+		},
+		"invalid key prefix": {
+			appOpts: streamingAppOptions{keys: []string{"mockKey1"}, keyPrefixes: []string{"mockKey1/zz"}},
+			expErr:  true,
+		},
+		"key prefix of a store not exposed": {
+			appOpts: streamingAppOptions{keys: []string{"mockKey1"}, keyPrefixes: []string{"mockKey2/01"}},
+			expErr:  true,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			activeStreamers, _, err := streaming.LoadStreamingServices(bApp, tc.appOpts, encCdc.Marshaler, keys)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
 			require.Equal(t, tc.activeStreamersLen, len(activeStreamers))
 		})
@@ -90,7 +116,8 @@ func TestLoadStreamingServices(t *testing.T) {
 }
 
 type streamingAppOptions struct {
-	keys []string
+	keys        []string
+	keyPrefixes []string
 }
 
 func (ao streamingAppOptions) Get(o string) interface{} {
@@ -101,6 +128,8 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return ao.keys
 	case "streamers.file.write_dir":
 		return "data/file_streamer"
+	case "streamers.file.key-prefixes":
+		return ao.keyPrefixes
 	default:
 		return nil
 	}
@@ -0,0 +1,317 @@
+// Package segment implements the output files of the file streaming service:
+// segment files holding the blocks of a range of heights, each one with an
+// index file for random access by height.
+//
+// A segment file is named {prefix-}segment-{first height}.data, where the
+// first height is padded to 20 digits, and holds a frame per block, each frame
+// compressed independently. A frame holds the height as an 8 bytes big endian
+// integer, a flags byte, the length-prefixed protobuf encoded BlockMetadata
+// of the block if the metadata flag is set, and the length-prefixed protobuf
+// encoded StoreKVPairs written during the block.
+//
+// The index file of a segment is named {prefix-}segment-{first height}.index.
+// It holds an 8 bytes header with the magic number, the format version and
+// the compression of the segment, followed by an entry per frame with the
+// height, the offset and the size of the frame as 8 bytes big endian integers.
+// The index entry of a block is written after its frame, so the frames without
+// an entry, which were not completely written, are ignored.
+package segment
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Compression is the compression of the frames of a segment.
+type Compression byte
+
+const (
+	CompressionNone Compression = iota
+	CompressionZstd
+)
+
+// CompressionFromString returns the Compression corresponding to the provided
+// name.
+func CompressionFromString(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "none":
+		return CompressionNone, nil
+
+	case "zstd", "":
+		return CompressionZstd, nil
+
+	default:
+		return 0, fmt.Errorf("unknown compression %s", name)
+	}
+}
+
+// String returns the name of a Compression.
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+
+	case CompressionZstd:
+		return "zstd"
+
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	indexMagic      = "CSIX"
+	indexVersion    = 1
+	indexHeaderSize = 8
+	indexEntrySize  = 24
+
+	flagMetadata = 1 << 0
+
+	dataFileExt  = ".data"
+	indexFileExt = ".index"
+)
+
+// Block holds the ABCI messages and the state changes of a block.
+type Block struct {
+	Height int64
+	// Metadata holds the ABCI messages of the block, it is nil if the metadata
+	// is not output.
+	Metadata *types.BlockMetadata
+	// Changes holds the writes to the stores during the block.
+	Changes []*types.StoreKVPair
+}
+
+// indexEntry locates the frame of a block in a segment file.
+type indexEntry struct {
+	height int64
+	offset int64
+	size   int64
+}
+
+// segmentInfo describes a segment and its index.
+type segmentInfo struct {
+	first       int64
+	compression Compression
+	entries     []indexEntry
+}
+
+func fileBaseName(prefix string, first int64) string {
+	name := fmt.Sprintf("segment-%020d", first)
+	if prefix != "" {
+		name = fmt.Sprintf("%s-%s", prefix, name)
+	}
+
+	return name
+}
+
+// listSegments returns the first heights of the segments with the given
+// prefix in dir, in ascending order.
+func listSegments(dir, prefix string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	base := fileBaseName(prefix, 0)
+	base = base[:len(base)-20]
+
+	var firsts []int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, base) || !strings.HasSuffix(name, indexFileExt) {
+			continue
+		}
+
+		digits := strings.TrimSuffix(strings.TrimPrefix(name, base), indexFileExt)
+		if len(digits) != 20 {
+			continue
+		}
+		first, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		firsts = append(firsts, first)
+	}
+
+	sort.Slice(firsts, func(i, j int) bool { return firsts[i] < firsts[j] })
+
+	return firsts, nil
+}
+
+// removeSegment removes the data and index files of a segment.
+func removeSegment(dir, prefix string, first int64) error {
+	base := filepath.Join(dir, fileBaseName(prefix, first))
+	if err := os.Remove(base + indexFileExt); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(base + dataFileExt); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func encodeIndexHeader(compression Compression) []byte {
+	header := make([]byte, indexHeaderSize)
+	copy(header, indexMagic)
+	header[4] = indexVersion
+	header[5] = byte(compression)
+	return header
+}
+
+func encodeIndexEntry(entry indexEntry) []byte {
+	bz := make([]byte, indexEntrySize)
+	binary.BigEndian.PutUint64(bz, uint64(entry.height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(entry.offset))
+	binary.BigEndian.PutUint64(bz[16:], uint64(entry.size))
+	return bz
+}
+
+// readIndex reads the index file of a segment, ignoring a trailing incomplete
+// entry.
+func readIndex(dir, prefix string, first int64) (segmentInfo, error) {
+	path := filepath.Join(dir, fileBaseName(prefix, first)+indexFileExt)
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return segmentInfo{}, err
+	}
+
+	if len(bz) < indexHeaderSize || string(bz[:4]) != indexMagic {
+		return segmentInfo{}, fmt.Errorf("invalid index file %s", path)
+	}
+	if bz[4] != indexVersion {
+		return segmentInfo{}, fmt.Errorf("unsupported version %d of index file %s", bz[4], path)
+	}
+
+	info := segmentInfo{first: first, compression: Compression(bz[5])}
+	for bz = bz[indexHeaderSize:]; len(bz) >= indexEntrySize; bz = bz[indexEntrySize:] {
+		info.entries = append(info.entries, indexEntry{
+			height: int64(binary.BigEndian.Uint64(bz)),
+			offset: int64(binary.BigEndian.Uint64(bz[8:])),
+			size:   int64(binary.BigEndian.Uint64(bz[16:])),
+		})
+	}
+
+	return info, nil
+}
+
+// encodeBlock encodes a block as an uncompressed frame.
+func encodeBlock(block *Block) ([]byte, error) {
+	bz := make([]byte, 9, 1024)
+	binary.BigEndian.PutUint64(bz, uint64(block.Height))
+
+	if block.Metadata != nil {
+		bz[8] |= flagMetadata
+
+		metadata, err := block.Metadata.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		bz = binary.AppendUvarint(bz, uint64(len(metadata)))
+		bz = append(bz, metadata...)
+	}
+
+	for _, pair := range block.Changes {
+		pairBz, err := pair.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		bz = binary.AppendUvarint(bz, uint64(len(pairBz)))
+		bz = append(bz, pairBz...)
+	}
+
+	return bz, nil
+}
+
+// decodeBlock decodes an uncompressed frame.
+func decodeBlock(bz []byte) (*Block, error) {
+	if len(bz) < 9 {
+		return nil, fmt.Errorf("invalid frame of %d bytes", len(bz))
+	}
+
+	block := &Block{Height: int64(binary.BigEndian.Uint64(bz))}
+	flags := bz[8]
+	bz = bz[9:]
+
+	next := func() ([]byte, error) {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || size > uint64(len(bz)-n) {
+			return nil, fmt.Errorf("invalid length prefix in frame of height %d", block.Height)
+		}
+		msg := bz[n : n+int(size)]
+		bz = bz[n+int(size):]
+		return msg, nil
+	}
+
+	if flags&flagMetadata != 0 {
+		msg, err := next()
+		if err != nil {
+			return nil, err
+		}
+		block.Metadata = &types.BlockMetadata{}
+		if err := block.Metadata.Unmarshal(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	for len(bz) > 0 {
+		msg, err := next()
+		if err != nil {
+			return nil, err
+		}
+		pair := &types.StoreKVPair{}
+		if err := pair.Unmarshal(msg); err != nil {
+			return nil, err
+		}
+		block.Changes = append(block.Changes, pair)
+	}
+
+	return block, nil
+}
+
+// Record is an ABCI request or response, or a state change, of a block.
+type Record struct {
+	// Type is the snake case name of the ABCI message, e.g.
+	// request_begin_block, or store_kv_pair for a state change.
+	Type    string
+	Message proto.Message
+}
+
+// Records returns the ABCI requests and responses of the block in execution
+// order, followed by its state changes.
+func (b *Block) Records() []Record {
+	var records []Record
+	add := func(typ string, msg proto.Message, ok bool) {
+		if ok {
+			records = append(records, Record{Type: typ, Message: msg})
+		}
+	}
+
+	if md := b.Metadata; md != nil {
+		add("request_begin_block", md.RequestBeginBlock, md.RequestBeginBlock != nil)
+		add("response_begin_block", md.ResponseBeginBlock, md.ResponseBeginBlock != nil)
+		for _, tx := range md.DeliverTxs {
+			add("request_deliver_tx", tx.Request, tx.Request != nil)
+			add("response_deliver_tx", tx.Response, tx.Response != nil)
+		}
+		add("request_end_block", md.RequestEndBlock, md.RequestEndBlock != nil)
+		add("response_end_block", md.ResponseEndBlock, md.ResponseEndBlock != nil)
+		add("response_commit", md.ResponseCommit, md.ResponseCommit != nil)
+	}
+
+	for _, pair := range b.Changes {
+		add("store_kv_pair", pair, true)
+	}
+
+	return records
+}
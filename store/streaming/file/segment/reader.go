@@ -0,0 +1,180 @@
+package segment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// ErrBlockNotFound is returned when the block of a height is not in the
+// segments.
+var ErrBlockNotFound = errors.New("block not found")
+
+// Reader reads the blocks of the segments of a directory, as they were when
+// the reader was opened.
+type Reader struct {
+	dir      string
+	prefix   string
+	segments []segmentInfo
+	decoder  *zstd.Decoder
+}
+
+// NewReader opens the segments with the given prefix in dir.
+func NewReader(dir, prefix string) (*Reader, error) {
+	firsts, err := listSegments(dir, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reader{dir: dir, prefix: prefix}
+	for i, first := range firsts {
+		info, err := readIndex(dir, prefix, first)
+		if err != nil {
+			return nil, err
+		}
+
+		// the blocks of a segment are superseded by the next segment, which
+		// starts at a lower or equal height after a rollback
+		if i+1 < len(firsts) {
+			next := firsts[i+1]
+			n := sort.Search(len(info.entries), func(j int) bool { return info.entries[j].height >= next })
+			info.entries = info.entries[:n]
+		}
+		if len(info.entries) > 0 {
+			r.segments = append(r.segments, info)
+		}
+	}
+
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	r.decoder = decoder
+
+	return r, nil
+}
+
+// Heights returns the heights of the first and the last blocks of the
+// segments, or zeros if there is no block.
+func (r *Reader) Heights() (first, last int64) {
+	if len(r.segments) == 0 {
+		return 0, 0
+	}
+
+	lastSegment := r.segments[len(r.segments)-1]
+	return r.segments[0].entries[0].height, lastSegment.entries[len(lastSegment.entries)-1].height
+}
+
+// Block returns the block of the given height, or ErrBlockNotFound.
+func (r *Reader) Block(height int64) (*Block, error) {
+	i := sort.Search(len(r.segments), func(i int) bool { return r.segments[i].first > height }) - 1
+	if i < 0 {
+		return nil, ErrBlockNotFound
+	}
+
+	info := r.segments[i]
+	j := sort.Search(len(info.entries), func(j int) bool { return info.entries[j].height >= height })
+	if j == len(info.entries) || info.entries[j].height != height {
+		return nil, ErrBlockNotFound
+	}
+
+	f, err := os.Open(r.dataPath(info))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return r.readBlock(f, info, info.entries[j])
+}
+
+// Iterate calls fn with the blocks from the start height to the end height,
+// both included, in ascending order. An end of 0 iterates to the last block.
+func (r *Reader) Iterate(start, end int64, fn func(*Block) error) error {
+	for _, info := range r.segments {
+		if end > 0 && info.first > end {
+			break
+		}
+		if info.entries[len(info.entries)-1].height < start {
+			continue
+		}
+
+		if err := r.iterateSegment(info, start, end, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reader) iterateSegment(info segmentInfo, start, end int64, fn func(*Block) error) error {
+	f, err := os.Open(r.dataPath(info))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for _, entry := range info.entries {
+		if entry.height < start {
+			continue
+		}
+		if end > 0 && entry.height > end {
+			break
+		}
+
+		block, err := r.readBlock(f, info, entry)
+		if err != nil {
+			return err
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reader) readBlock(f *os.File, info segmentInfo, entry indexEntry) (*Block, error) {
+	frame := make([]byte, entry.size)
+	if _, err := f.ReadAt(frame, entry.offset); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("failed to read block %d: %w", entry.height, err)
+	}
+
+	switch info.compression {
+	case CompressionNone:
+	case CompressionZstd:
+		var err error
+		if frame, err = r.decoder.DecodeAll(frame, nil); err != nil {
+			return nil, fmt.Errorf("failed to decompress block %d: %w", entry.height, err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown compression %d of segment %d", info.compression, info.first)
+	}
+
+	block, err := decodeBlock(frame)
+	if err != nil {
+		return nil, err
+	}
+	if block.Height != entry.height {
+		return nil, fmt.Errorf("invalid frame of height %d at the index entry of height %d", block.Height, entry.height)
+	}
+
+	return block, nil
+}
+
+func (r *Reader) dataPath(info segmentInfo) string {
+	return filepath.Join(r.dir, fileBaseName(r.prefix, info.first)+dataFileExt)
+}
+
+// Close releases the resources of the reader.
+func (r *Reader) Close() error {
+	r.decoder.Close()
+	return nil
+}
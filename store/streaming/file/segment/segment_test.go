@@ -0,0 +1,205 @@
+package segment_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/segment"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newBlock(height int64, value string) *segment.Block {
+	return &segment.Block{
+		Height: height,
+		Metadata: &types.BlockMetadata{
+			RequestBeginBlock: &abci.RequestBeginBlock{Header: tmproto.Header{Height: height}},
+			ResponseCommit:    &abci.ResponseCommit{Data: []byte("hash")},
+		},
+		Changes: []*types.StoreKVPair{
+			{StoreKey: "store1", Key: []byte("key"), Value: bytes.Repeat([]byte(value), 100)},
+			{StoreKey: "store2", Key: []byte("key"), Delete: true},
+		},
+	}
+}
+
+func writeBlocks(t *testing.T, dir string, opts segment.Options, from, to int64, value string) {
+	w, err := segment.NewWriter(dir, opts)
+	require.NoError(t, err)
+	for height := from; height <= to; height++ {
+		require.NoError(t, w.WriteBlock(newBlock(height, value)))
+	}
+	require.NoError(t, w.Close())
+}
+
+func requireSegments(t *testing.T, dir string, prefix string, firsts ...int64) {
+	var expected []string
+	for _, first := range firsts {
+		name := fmt.Sprintf("segment-%020d", first)
+		if prefix != "" {
+			name = prefix + "-" + name
+		}
+		expected = append(expected, name+".data", name+".index")
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.Equal(t, expected, names)
+}
+
+func requireBlocks(t *testing.T, r *segment.Reader, start, end int64, expected map[int64]string) {
+	var heights []int64
+	err := r.Iterate(start, end, func(block *segment.Block) error {
+		heights = append(heights, block.Height)
+		require.Equal(t, newBlock(block.Height, expected[block.Height]), block)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, heights, len(expected))
+}
+
+func TestWriterReader(t *testing.T) {
+	for _, compression := range []segment.Compression{segment.CompressionZstd, segment.CompressionNone} {
+		t.Run(compression.String(), func(t *testing.T) {
+			dir := t.TempDir()
+			writeBlocks(t, dir, segment.Options{Prefix: "test", Compression: compression, BlocksPerSegment: 10}, 1, 25, "a")
+			requireSegments(t, dir, "test", 1, 11, 21)
+
+			r, err := segment.NewReader(dir, "test")
+			require.NoError(t, err)
+			defer r.Close()
+
+			first, last := r.Heights()
+			require.Equal(t, int64(1), first)
+			require.Equal(t, int64(25), last)
+
+			block, err := r.Block(17)
+			require.NoError(t, err)
+			require.Equal(t, newBlock(17, "a"), block)
+			_, err = r.Block(26)
+			require.ErrorIs(t, err, segment.ErrBlockNotFound)
+			_, err = r.Block(0)
+			require.ErrorIs(t, err, segment.ErrBlockNotFound)
+
+			requireBlocks(t, r, 9, 12, map[int64]string{9: "a", 10: "a", 11: "a", 12: "a"})
+
+			// the segments of another prefix are ignored
+			r, err = segment.NewReader(dir, "")
+			require.NoError(t, err)
+			first, last = r.Heights()
+			require.Zero(t, first)
+			require.Zero(t, last)
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	sizes := make(map[segment.Compression]int64)
+	for _, compression := range []segment.Compression{segment.CompressionZstd, segment.CompressionNone} {
+		dir := t.TempDir()
+		writeBlocks(t, dir, segment.Options{Compression: compression}, 1, 10, "a")
+
+		info, err := os.Stat(filepath.Join(dir, fmt.Sprintf("segment-%020d.data", 1)))
+		require.NoError(t, err)
+		sizes[compression] = info.Size()
+	}
+
+	require.Less(t, sizes[segment.CompressionZstd], sizes[segment.CompressionNone])
+}
+
+func TestRetention(t *testing.T) {
+	dir := t.TempDir()
+	writeBlocks(t, dir, segment.Options{BlocksPerSegment: 10, KeepSegments: 2}, 1, 25, "a")
+	requireSegments(t, dir, "", 11, 21)
+
+	r, err := segment.NewReader(dir, "")
+	require.NoError(t, err)
+	first, last := r.Heights()
+	require.Equal(t, int64(11), first)
+	require.Equal(t, int64(25), last)
+}
+
+func TestRestart(t *testing.T) {
+	dir := t.TempDir()
+	opts := segment.Options{BlocksPerSegment: 10}
+	writeBlocks(t, dir, opts, 1, 15, "a")
+
+	// a restart starts a new segment, superseding the blocks of the previous
+	// ones from its first height on
+	writeBlocks(t, dir, opts, 13, 14, "b")
+	requireSegments(t, dir, "", 1, 11, 13)
+
+	r, err := segment.NewReader(dir, "")
+	require.NoError(t, err)
+	requireBlocks(t, r, 0, 0, map[int64]string{
+		1: "a", 2: "a", 3: "a", 4: "a", 5: "a", 6: "a", 7: "a", 8: "a", 9: "a", 10: "a",
+		11: "a", 12: "a", 13: "b", 14: "b",
+	})
+
+	// the segments starting at or after the first height are removed
+	writeBlocks(t, dir, opts, 11, 11, "c")
+	requireSegments(t, dir, "", 1, 11)
+	r, err = segment.NewReader(dir, "")
+	require.NoError(t, err)
+	requireBlocks(t, r, 10, 0, map[int64]string{10: "a", 11: "c"})
+
+	// a rollback within a segment starts a new one
+	w, err := segment.NewWriter(dir, opts)
+	require.NoError(t, err)
+	for _, height := range []int64{12, 13, 12} {
+		require.NoError(t, w.WriteBlock(newBlock(height, "d")))
+	}
+	require.NoError(t, w.Close())
+	requireSegments(t, dir, "", 1, 11, 12)
+	r, err = segment.NewReader(dir, "")
+	require.NoError(t, err)
+	requireBlocks(t, r, 11, 0, map[int64]string{11: "c", 12: "d"})
+}
+
+func TestIncompleteWrite(t *testing.T) {
+	dir := t.TempDir()
+	writeBlocks(t, dir, segment.Options{}, 1, 3, "a")
+
+	// a crash while writing a block leaves a partial frame and index entry,
+	// which are ignored
+	for _, ext := range []string{".data", ".index"} {
+		f, err := os.OpenFile(filepath.Join(dir, fmt.Sprintf("segment-%020d", 1)+ext), os.O_WRONLY|os.O_APPEND, 0o600)
+		require.NoError(t, err)
+		_, err = f.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+
+	r, err := segment.NewReader(dir, "")
+	require.NoError(t, err)
+	requireBlocks(t, r, 0, 0, map[int64]string{1: "a", 2: "a", 3: "a"})
+}
+
+func TestRecords(t *testing.T) {
+	block := newBlock(1, "a")
+	block.Metadata.DeliverTxs = []*types.BlockMetadata_DeliverTx{
+		{Request: &abci.RequestDeliverTx{Tx: []byte("tx")}, Response: &abci.ResponseDeliverTx{}},
+	}
+
+	var recordTypes []string
+	for _, record := range block.Records() {
+		recordTypes = append(recordTypes, record.Type)
+	}
+	require.Equal(t, []string{
+		"request_begin_block", "request_deliver_tx", "response_deliver_tx", "response_commit",
+		"store_kv_pair", "store_kv_pair",
+	}, recordTypes)
+
+	block.Metadata = nil
+	require.Len(t, block.Records(), 2)
+}
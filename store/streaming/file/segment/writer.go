@@ -0,0 +1,190 @@
+package segment
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// DefaultBlocksPerSegment is the default number of blocks of a segment.
+const DefaultBlocksPerSegment = 1000
+
+// Options defines the files written by a Writer.
+type Options struct {
+	// Prefix is prepended to the file names.
+	Prefix string
+	// Compression is the compression of the frames of the new segments.
+	Compression Compression
+	// BlocksPerSegment is the number of blocks after which a new segment is
+	// started, DefaultBlocksPerSegment by default.
+	BlocksPerSegment int
+	// KeepSegments is the number of segments kept, the oldest ones being
+	// removed when a new segment is started. All are kept if it is 0.
+	KeepSegments int
+	// Fsync, if true, syncs the files after each block is written.
+	Fsync bool
+}
+
+// Writer writes blocks to segment files.
+type Writer struct {
+	dir     string
+	opts    Options
+	encoder *zstd.Encoder
+
+	// the current segment, started on the first block written by the writer
+	data   *os.File
+	index  *os.File
+	first  int64
+	last   int64
+	blocks int
+	offset int64
+}
+
+// NewWriter returns a Writer writing the segments in dir. It starts a new
+// segment on the first block, the existing segments being left unchanged,
+// except the ones starting at or after this block, which are removed.
+func NewWriter(dir string, opts Options) (*Writer, error) {
+	if opts.BlocksPerSegment <= 0 {
+		opts.BlocksPerSegment = DefaultBlocksPerSegment
+	}
+	if opts.KeepSegments < 0 {
+		return nil, fmt.Errorf("invalid number of segments to keep %d", opts.KeepSegments)
+	}
+
+	w := &Writer{dir: dir, opts: opts}
+	if opts.Compression == CompressionZstd {
+		encoder, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		w.encoder = encoder
+	}
+
+	return w, nil
+}
+
+// WriteBlock appends a block to the current segment, starting a new segment
+// if the current one is full or if the height does not follow the one of the
+// previous block, e.g. after a rollback.
+func (w *Writer) WriteBlock(block *Block) error {
+	if w.data == nil || w.blocks >= w.opts.BlocksPerSegment || block.Height <= w.last {
+		if err := w.rotate(block.Height); err != nil {
+			return err
+		}
+	}
+
+	frame, err := encodeBlock(block)
+	if err != nil {
+		return err
+	}
+	if w.encoder != nil {
+		frame = w.encoder.EncodeAll(frame, nil)
+	}
+
+	// the frame and the entry are written at their offset, overwriting the
+	// partial writes of a previous failed attempt
+	if _, err := w.data.WriteAt(frame, w.offset); err != nil {
+		return fmt.Errorf("failed to write segment file: %w", err)
+	}
+	if w.opts.Fsync {
+		if err := w.data.Sync(); err != nil {
+			return fmt.Errorf("failed to sync segment file: %w", err)
+		}
+	}
+
+	entry := encodeIndexEntry(indexEntry{height: block.Height, offset: w.offset, size: int64(len(frame))})
+	if _, err := w.index.WriteAt(entry, int64(indexHeaderSize+w.blocks*indexEntrySize)); err != nil {
+		return fmt.Errorf("failed to write index file: %w", err)
+	}
+	if w.opts.Fsync {
+		if err := w.index.Sync(); err != nil {
+			return fmt.Errorf("failed to sync index file: %w", err)
+		}
+	}
+
+	w.offset += int64(len(frame))
+	w.blocks++
+	w.last = block.Height
+
+	return nil
+}
+
+// rotate closes the current segment and starts a new one at the given height,
+// removing the segments superseded by the new one, and the oldest ones beyond
+// the retention.
+func (w *Writer) rotate(first int64) error {
+	if err := w.closeSegment(); err != nil {
+		return err
+	}
+
+	firsts, err := listSegments(w.dir, w.opts.Prefix)
+	if err != nil {
+		return err
+	}
+	kept := firsts[:0]
+	for _, f := range firsts {
+		if f >= first {
+			if err := removeSegment(w.dir, w.opts.Prefix, f); err != nil {
+				return err
+			}
+			continue
+		}
+		kept = append(kept, f)
+	}
+
+	base := filepath.Join(w.dir, fileBaseName(w.opts.Prefix, first))
+	data, err := os.OpenFile(base+dataFileExt, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	index, err := os.OpenFile(base+indexFileExt, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		data.Close()
+		return err
+	}
+	if _, err := index.Write(encodeIndexHeader(w.opts.Compression)); err != nil {
+		data.Close()
+		index.Close()
+		return err
+	}
+
+	w.data, w.index = data, index
+	w.first, w.last, w.blocks, w.offset = first, 0, 0, 0
+
+	if w.opts.KeepSegments > 0 && len(kept) >= w.opts.KeepSegments {
+		for _, f := range kept[:len(kept)-w.opts.KeepSegments+1] {
+			if err := removeSegment(w.dir, w.opts.Prefix, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Writer) closeSegment() error {
+	if w.data == nil {
+		return nil
+	}
+
+	dataErr := w.data.Close()
+	indexErr := w.index.Close()
+	w.data, w.index = nil, nil
+
+	if dataErr != nil {
+		return dataErr
+	}
+
+	return indexErr
+}
+
+// Close closes the current segment.
+func (w *Writer) Close() error {
+	if w.encoder != nil {
+		w.encoder.Close()
+	}
+
+	return w.closeSegment()
+}
@@ -1,10 +1,7 @@
 package file
 
 import (
-	"bytes"
 	"context"
-	"fmt"
-	"io"
 	"os"
 	"path"
 	"sort"
@@ -13,21 +10,19 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
-	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/segment"
 	"github.com/cosmos/cosmos-sdk/store/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ baseapp.StreamingService = &StreamingService{}
 
 // StreamingService is a concrete implementation of StreamingService that writes
-// state changes out to files.
+// state changes out to segment files.
 type StreamingService struct {
 	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
 	filePrefix     string                  // optional prefix for each of the generated files
 	writeDir       string                  // directory to write files into
-	codec          codec.BinaryCodec       // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
+	writer         *segment.Writer         // writer of the segment files
 
 	currentBlockNumber int64
 	blockMetadata      types.BlockMetadata
@@ -39,17 +34,16 @@ type StreamingService struct {
 	// to ensure eventual consistency of the output, otherwise, any errors are
 	// logged and ignored which could yield data loss in streamed output.
 	stopNodeOnErr bool
-
-	// fsync, if true, will execute file Sync to make sure the data is persisted
-	// onto disk, otherwise there is a risk of data loss during any crash.
-	fsync bool
 }
 
+// NewStreamingService returns a StreamingService writing the state changes of
+// the given stores, and the metadata of the blocks if outputMetadata is set,
+// to segment files in writeDir.
 func NewStreamingService(
-	writeDir, filePrefix string,
+	writeDir string,
 	storeKeys []types.StoreKey,
-	cdc codec.BinaryCodec,
-	outputMetadata, stopNodeOnErr, fsync bool,
+	outputMetadata, stopNodeOnErr bool,
+	opts segment.Options,
 ) (*StreamingService, error) {
 	// sort storeKeys for deterministic output
 	sort.SliceStable(storeKeys, func(i, j int) bool {
@@ -69,14 +63,18 @@ func NewStreamingService(
 		return nil, err
 	}
 
+	writer, err := segment.NewWriter(writeDir, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	return &StreamingService{
 		storeListeners: listeners,
-		filePrefix:     filePrefix,
+		filePrefix:     opts.Prefix,
 		writeDir:       writeDir,
-		codec:          cdc,
+		writer:         writer,
 		outputMetadata: outputMetadata,
 		stopNodeOnErr:  stopNodeOnErr,
-		fsync:          fsync,
 	}, nil
 }
 
@@ -139,62 +137,33 @@ func (fss *StreamingService) ListenCommit(ctx context.Context, res abci.Response
 	return nil
 }
 
-func (fss *StreamingService) doListenCommit(ctx context.Context, res abci.ResponseCommit) (err error) {
+func (fss *StreamingService) doListenCommit(ctx context.Context, res abci.ResponseCommit) error {
 	fss.blockMetadata.ResponseCommit = &res
 
-	// Write to target files, the file size is written at the beginning, which can
-	// be used to detect completeness.
-	metaFileName := fmt.Sprintf("block-%d-meta", fss.currentBlockNumber)
-	dataFileName := fmt.Sprintf("block-%d-data", fss.currentBlockNumber)
-
-	if fss.filePrefix != "" {
-		metaFileName = fmt.Sprintf("%s-%s", fss.filePrefix, metaFileName)
-		dataFileName = fmt.Sprintf("%s-%s", fss.filePrefix, dataFileName)
-	}
-
+	block := &segment.Block{Height: fss.currentBlockNumber}
 	if fss.outputMetadata {
-		bz, err := fss.codec.Marshal(&fss.blockMetadata)
-		if err != nil {
-			return err
-		}
-
-		if err := writeLengthPrefixedFile(path.Join(fss.writeDir, metaFileName), bz, fss.fsync); err != nil {
-			return err
-		}
+		metadata := fss.blockMetadata
+		block.Metadata = &metadata
 	}
+	// reset the metadata for the next block
+	fss.blockMetadata = types.BlockMetadata{}
 
-	var buf bytes.Buffer
-	if err := fss.writeBlockData(&buf); err != nil {
-		return err
-	}
-
-	return writeLengthPrefixedFile(path.Join(fss.writeDir, dataFileName), buf.Bytes(), fss.fsync)
-}
-
-func (fss *StreamingService) writeBlockData(writer io.Writer) error {
 	for _, listener := range fss.storeListeners {
 		cache := listener.PopStateCache()
-
 		for i := range cache {
-			bz, err := fss.codec.MarshalLengthPrefixed(&cache[i])
-			if err != nil {
-				return err
-			}
-
-			if _, err := writer.Write(bz); err != nil {
-				return err
-			}
+			block.Changes = append(block.Changes, &cache[i])
 		}
 	}
 
-	return nil
+	return fss.writer.WriteBlock(block)
 }
 
 // Stream satisfies the StreamingService interface. It performs a no-op.
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
 
-// Close satisfies the StreamingService interface. It performs a no-op.
-func (fss *StreamingService) Close() error { return nil }
+// Close satisfies the StreamingService interface. It closes the current
+// segment.
+func (fss *StreamingService) Close() error { return fss.writer.Close() }
 
 // isDirWriteable checks if dir is writable by writing and removing a file
 // to dir. It returns nil if dir is writable. We have to do this as there is no
@@ -207,37 +176,3 @@ func isDirWriteable(dir string) error {
 
 	return os.Remove(f)
 }
-
-func writeLengthPrefixedFile(path string, data []byte, fsync bool) (err error) {
-	var f *os.File
-	f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "open file failed: %s", path)
-	}
-
-	defer func() {
-		// avoid overriding the real error with file close error
-		if err1 := f.Close(); err1 != nil && err == nil {
-			err = sdkerrors.Wrapf(err, "close file failed: %s", path)
-		}
-	}()
-
-	_, err = f.Write(sdk.Uint64ToBigEndian(uint64(len(data))))
-	if err != nil {
-		return sdkerrors.Wrapf(err, "write length prefix failed: %s", path)
-	}
-
-	_, err = f.Write(data)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "write block data failed: %s", path)
-	}
-
-	if fsync {
-		err = f.Sync()
-		if err != nil {
-			return sdkerrors.Wrapf(err, "fsync failed: %s", path)
-		}
-	}
-
-	return err
-}
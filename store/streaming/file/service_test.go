@@ -1,8 +1,6 @@
 package file
 
 import (
-	"encoding/binary"
-	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/segment"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -120,12 +119,11 @@ func TestFileStreamingService(t *testing.T) {
 
 	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
 	var err error
-	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller, true, false, false)
+	testStreamingService, err = NewStreamingService(testDir, testKeys, true, false, segment.Options{Prefix: testPrefix})
 	require.Nil(t, err)
 	require.IsType(t, &StreamingService{}, testStreamingService)
 	require.Equal(t, testPrefix, testStreamingService.filePrefix)
 	require.Equal(t, testDir, testStreamingService.writeDir)
-	require.Equal(t, testMarshaller, testStreamingService.codec)
 
 	testListener1 = testStreamingService.storeListeners[0]
 	testListener2 = testStreamingService.storeListeners[1]
@@ -295,11 +293,14 @@ func testListenBlock(t *testing.T) {
 	err = testStreamingService.ListenCommit(emptyContextWrap, testCommitRes)
 	require.Nil(t, err)
 
-	// load the file, checking that it was created with the expected name
-	metaFileName := fmt.Sprintf("%s-block-%d-meta", testPrefix, testBeginBlockReq.GetHeader().Height)
-	dataFileName := fmt.Sprintf("%s-block-%d-data", testPrefix, testBeginBlockReq.GetHeader().Height)
-	metaFileBytes, err := readInFile(metaFileName)
-	dataFileBytes, err := readInFile(dataFileName)
+	// load the block, checking that the segment was created with the expected name
+	require.FileExists(t, filepath.Join(testDir, fmt.Sprintf("%s-segment-%020d.data", testPrefix, testBeginBlockReq.GetHeader().Height)))
+	reader, err := segment.NewReader(testDir, testPrefix)
+	require.Nil(t, err)
+	defer reader.Close()
+	block, err := reader.Block(testBeginBlockReq.GetHeader().Height)
+	require.Nil(t, err)
+	metaFileBytes, err := testMarshaller.Marshal(block.Metadata)
 	require.Nil(t, err)
 
 	metadata := types.BlockMetadata{
@@ -317,60 +318,13 @@ func testListenBlock(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, expectedMetadataBytes, metaFileBytes)
 
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(dataFileBytes)
-	require.Nil(t, err)
+	// check the correctness of each state change
+	segments := make([][]byte, len(block.Changes))
+	for i, pair := range block.Changes {
+		segments[i], err = testMarshaller.Marshal(pair)
+		require.Nil(t, err)
+	}
 	require.Equal(t, len(expectKVPairsStore1)+len(expectKVPairsStore2), len(segments))
 	require.Equal(t, expectKVPairsStore1, segments[:len(expectKVPairsStore1)])
 	require.Equal(t, expectKVPairsStore2, segments[len(expectKVPairsStore1):])
 }
-
-func readInFile(name string) ([]byte, error) {
-	path := filepath.Join(testDir, name)
-	bz, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
-
-	size := sdk.BigEndianToUint64(bz[:8])
-	if len(bz) != int(size)+8 {
-		return nil, errors.New("incomplete file ")
-	}
-
-	return bz[8:], nil
-}
-
-// segmentBytes returns all of the protobuf messages contained in the byte array
-// as an array of byte arrays. The messages have their length prefix removed.
-func segmentBytes(bz []byte) ([][]byte, error) {
-	var err error
-
-	segments := make([][]byte, 0)
-	for len(bz) > 0 {
-		var segment []byte
-
-		segment, bz, err = getHeadSegment(bz)
-		if err != nil {
-			return nil, err
-		}
-
-		segments = append(segments, segment)
-	}
-
-	return segments, nil
-}
-
-// getHeadSegment returns the bytes for the leading protobuf object in the byte
-// array (removing the length prefix) and returns the remainder of the byte array.
-func getHeadSegment(bz []byte) ([]byte, []byte, error) {
-	size, prefixSize := binary.Uvarint(bz)
-	if prefixSize < 0 {
-		return nil, nil, fmt.Errorf("invalid number of bytes read from length-prefixed encoding: %d", prefixSize)
-	}
-
-	if size > uint64(len(bz)-prefixSize) {
-		return nil, nil, fmt.Errorf("not enough bytes to read; want: %v, got: %v", size, len(bz)-prefixSize)
-	}
-
-	return bz[prefixSize:(uint64(prefixSize) + size)], bz[uint64(prefixSize)+size:], nil
-}
@@ -0,0 +1,152 @@
+package streaming
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Filter selects the data forwarded by a StreamingService.
+type Filter struct {
+	// KeyPrefixes maps the names of the stores whose writes are filtered to
+	// the prefixes of the keys whose writes are forwarded. The writes to the
+	// other stores are all forwarded.
+	KeyPrefixes map[string][][]byte
+
+	// MessageTypes and EventTypes, if any, select the DeliverTx messages
+	// forwarded: those of the transactions with a message of one of the given
+	// type URLs, or with an event of one of the given types.
+	MessageTypes []string
+	EventTypes   []string
+}
+
+// ParseKeyPrefixes parses key prefixes given as store/hex-prefix into the
+// KeyPrefixes of a Filter.
+func ParseKeyPrefixes(keyPrefixes []string) (map[string][][]byte, error) {
+	prefixes := make(map[string][][]byte)
+	for _, keyPrefix := range keyPrefixes {
+		storeName, hexPrefix, ok := strings.Cut(keyPrefix, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid key prefix %s, expected store/hex-prefix", keyPrefix)
+		}
+
+		prefix, err := hex.DecodeString(hexPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key prefix %s: %w", keyPrefix, err)
+		}
+
+		prefixes[storeName] = append(prefixes[storeName], prefix)
+	}
+
+	return prefixes, nil
+}
+
+// IsEmpty returns true if the filter forwards all the data.
+func (f Filter) IsEmpty() bool {
+	return len(f.KeyPrefixes) == 0 && len(f.MessageTypes) == 0 && len(f.EventTypes) == 0
+}
+
+// MatchDeliverTx returns true if the DeliverTx messages of a transaction are
+// forwarded.
+func (f Filter) MatchDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) bool {
+	if len(f.MessageTypes) == 0 && len(f.EventTypes) == 0 {
+		return true
+	}
+
+	for _, event := range res.Events {
+		if sdk.SliceContains(f.EventTypes, event.Type) {
+			return true
+		}
+	}
+
+	if len(f.MessageTypes) > 0 {
+		for _, typeURL := range messageTypeURLs(req.Tx) {
+			if sdk.SliceContains(f.MessageTypes, typeURL) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// messageTypeURLs returns the type URLs of the messages of a protobuf encoded
+// transaction, without resolving them, or nil if it cannot be decoded.
+func messageTypeURLs(txBytes []byte) []string {
+	var raw tx.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil
+	}
+
+	var body tx.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil
+	}
+
+	typeURLs := make([]string, len(body.Messages))
+	for i, msg := range body.Messages {
+		typeURLs[i] = msg.TypeUrl
+	}
+
+	return typeURLs
+}
+
+// filteredStreamingService is a StreamingService forwarding the data selected
+// by a Filter to its parent.
+type filteredStreamingService struct {
+	baseapp.StreamingService
+	filter Filter
+}
+
+var _ baseapp.StreamingService = filteredStreamingService{}
+
+// NewFilteredStreamingService returns a StreamingService forwarding the data
+// selected by the filter to the given service: the writes to the stores are
+// filtered by the WriteListeners it returns, and the DeliverTx messages by its
+// ABCIListener.
+func NewFilteredStreamingService(service baseapp.StreamingService, filter Filter) baseapp.StreamingService {
+	if filter.IsEmpty() {
+		return service
+	}
+
+	return filteredStreamingService{StreamingService: service, filter: filter}
+}
+
+// Listeners satisfies the StreamingService interface. It returns the listeners
+// of the parent service, wrapped to forward only the writes to the keys with
+// the prefixes of the filter.
+func (fs filteredStreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := fs.StreamingService.Listeners()
+	for key, storeListeners := range listeners {
+		prefixes, ok := fs.filter.KeyPrefixes[key.Name()]
+		if !ok {
+			continue
+		}
+
+		filtered := make([]types.WriteListener, len(storeListeners))
+		for i, listener := range storeListeners {
+			filtered[i] = types.NewPrefixFilterWriteListener(listener, prefixes)
+		}
+		listeners[key] = filtered
+	}
+
+	return listeners
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It forwards the
+// DeliverTx messages selected by the filter to the parent service.
+func (fs filteredStreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !fs.filter.MatchDeliverTx(req, res) {
+		return nil
+	}
+
+	return fs.StreamingService.ListenDeliverTx(ctx, req, res)
+}
@@ -0,0 +1,154 @@
+package streaming_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// recordingService is a StreamingService recording the data it receives.
+type recordingService struct {
+	key        types.StoreKey
+	writes     *bytes.Buffer
+	deliverTxs int
+}
+
+func (s *recordingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+func (s *recordingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return map[types.StoreKey][]types.WriteListener{
+		s.key: {types.NewStoreKVPairWriteListener(s.writes, testMarshaller)},
+	}
+}
+
+func (s *recordingService) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (s *recordingService) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+func (s *recordingService) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	s.deliverTxs++
+	return nil
+}
+
+func (s *recordingService) ListenCommit(context.Context, abci.ResponseCommit) error { return nil }
+
+func (s *recordingService) Close() error { return nil }
+
+func txBytes(t *testing.T, typeURLs ...string) []byte {
+	body := tx.TxBody{}
+	for _, typeURL := range typeURLs {
+		body.Messages = append(body.Messages, &codecTypes.Any{TypeUrl: typeURL})
+	}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+
+	raw := tx.TxRaw{BodyBytes: bodyBytes}
+	bz, err := raw.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestParseKeyPrefixes(t *testing.T) {
+	prefixes, err := streaming.ParseKeyPrefixes([]string{"bank/02", "bank/0301", "acc/01"})
+	require.NoError(t, err)
+	require.Equal(t, map[string][][]byte{
+		"bank": {{0x02}, {0x03, 0x01}},
+		"acc":  {{0x01}},
+	}, prefixes)
+
+	_, err = streaming.ParseKeyPrefixes([]string{"bank"})
+	require.Error(t, err)
+	_, err = streaming.ParseKeyPrefixes([]string{"bank/0x02"})
+	require.Error(t, err)
+}
+
+func TestFilterMatchDeliverTx(t *testing.T) {
+	send := abci.RequestDeliverTx{Tx: txBytes(t, "/cosmos.bank.v1beta1.MsgSend")}
+	vote := abci.RequestDeliverTx{Tx: txBytes(t, "/cosmos.gov.v1beta1.MsgVote")}
+	transfer := abci.ResponseDeliverTx{Events: []abci.Event{{Type: "transfer"}}}
+
+	testCases := map[string]struct {
+		filter   streaming.Filter
+		req      abci.RequestDeliverTx
+		res      abci.ResponseDeliverTx
+		expMatch bool
+	}{
+		"no filter": {
+			req:      send,
+			expMatch: true,
+		},
+		"message type": {
+			filter:   streaming.Filter{MessageTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			req:      send,
+			expMatch: true,
+		},
+		"other message type": {
+			filter: streaming.Filter{MessageTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			req:    vote,
+		},
+		"undecodable tx": {
+			filter: streaming.Filter{MessageTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			req:    abci.RequestDeliverTx{Tx: []byte("tx")},
+		},
+		"event type": {
+			filter:   streaming.Filter{EventTypes: []string{"transfer"}},
+			req:      vote,
+			res:      transfer,
+			expMatch: true,
+		},
+		"message or event type": {
+			filter:   streaming.Filter{MessageTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}, EventTypes: []string{"transfer"}},
+			req:      vote,
+			res:      transfer,
+			expMatch: true,
+		},
+		"other event type": {
+			filter: streaming.Filter{EventTypes: []string{"message"}},
+			req:    send,
+			res:    transfer,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.filter.MatchDeliverTx(tc.req, tc.res))
+		})
+	}
+}
+
+func TestFilteredStreamingService(t *testing.T) {
+	service := &recordingService{key: mockKeys[0], writes: new(bytes.Buffer)}
+
+	// an empty filter leaves the service unchanged
+	require.Equal(t, service, streaming.NewFilteredStreamingService(service, streaming.Filter{}))
+
+	filtered := streaming.NewFilteredStreamingService(service, streaming.Filter{
+		KeyPrefixes:  map[string][][]byte{mockKeys[0].Name(): {{0x01}}},
+		MessageTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	})
+
+	listener := filtered.Listeners()[mockKeys[0]][0]
+	require.NoError(t, listener.OnWrite(mockKeys[0], []byte{0x02, 0x01}, []byte("value"), false))
+	require.Zero(t, service.writes.Len())
+	require.NoError(t, listener.OnWrite(mockKeys[0], []byte{0x01, 0x02}, []byte("value"), false))
+	require.NotZero(t, service.writes.Len())
+
+	ctx := context.Background()
+	require.NoError(t, filtered.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: txBytes(t, "/cosmos.gov.v1beta1.MsgVote")}, abci.ResponseDeliverTx{}))
+	require.Zero(t, service.deliverTxs)
+	require.NoError(t, filtered.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: txBytes(t, "/cosmos.bank.v1beta1.MsgSend")}, abci.ResponseDeliverTx{}))
+	require.Equal(t, 1, service.deliverTxs)
+}
@@ -0,0 +1,114 @@
+// Package main implements a reference streaming plugin, which appends the ABCI
+// messages and the state changes of each block it receives to a file, as JSON
+// lines.
+//
+// The plugin is started by the node with the plugin streamer configured in
+// app.toml:
+//
+//	[store]
+//	streamers = ["plugin"]
+//
+//	[streamers.plugin]
+//	keys = ["*"]
+//	path = "/path/to/file-plugin"
+//	args = ["--output", "/path/to/output.jsonl"]
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
+)
+
+// filePlugin appends the messages it receives to a file.
+type filePlugin struct {
+	plugin.UnimplementedABCIListenerServiceServer
+
+	mtx    sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+}
+
+var _ plugin.ABCIListenerServiceServer = &filePlugin{}
+
+// record is a line of the output file.
+type record struct {
+	Type    string      `json:"type"`
+	Message interface{} `json:"message"`
+}
+
+func (p *filePlugin) write(typ string, msg interface{}) (*plugin.ListenResponse, error) {
+	bz, err := json.Marshal(record{Type: typ, Message: msg})
+	if err != nil {
+		return nil, err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, err := p.writer.Write(append(bz, '\n')); err != nil {
+		return nil, err
+	}
+
+	return &plugin.ListenResponse{}, nil
+}
+
+func (p *filePlugin) ListenBeginBlock(_ context.Context, req *plugin.ListenBeginBlockRequest) (*plugin.ListenResponse, error) {
+	return p.write("begin_block", req)
+}
+
+func (p *filePlugin) ListenDeliverTx(_ context.Context, req *plugin.ListenDeliverTxRequest) (*plugin.ListenResponse, error) {
+	return p.write("deliver_tx", req)
+}
+
+func (p *filePlugin) ListenEndBlock(_ context.Context, req *plugin.ListenEndBlockRequest) (*plugin.ListenResponse, error) {
+	return p.write("end_block", req)
+}
+
+// ListenCommit writes the Commit response and the state changes of the block,
+// and flushes the messages of the block to the file.
+func (p *filePlugin) ListenCommit(_ context.Context, req *plugin.ListenCommitRequest) (*plugin.ListenResponse, error) {
+	res, err := p.write("commit", req)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if err := p.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	return res, p.file.Sync()
+}
+
+func main() {
+	output := flag.String("output", "streaming.jsonl", "file to append the messages to")
+	flag.Parse()
+
+	f, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	p := &filePlugin{file: f, writer: bufio.NewWriter(f)}
+	err = plugin.Serve(p)
+
+	if flushErr := p.writer.Flush(); err == nil {
+		err = flushErr
+	}
+	f.Close()
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
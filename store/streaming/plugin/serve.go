@@ -0,0 +1,36 @@
+package plugin
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+)
+
+// Serve serves the given ABCIListenerService implementation to the node that
+// started the plugin process, until the node stops the plugin or exits. It is
+// meant to be called from the main function of a plugin.
+func Serve(srv ABCIListenerServiceServer) error {
+	if os.Getenv(EnvMagicCookie) != MagicCookie {
+		return errors.New("streaming plugins are started by the node, they cannot be executed directly")
+	}
+
+	listener, err := net.Listen("unix", os.Getenv(EnvAddress))
+	if err != nil {
+		return err
+	}
+
+	grpcSrv := grpc.NewServer()
+	RegisterABCIListenerServiceServer(grpcSrv, srv)
+
+	// the node closes the standard input of the plugin to stop it, which is
+	// also closed when the node exits
+	go func() {
+		_, _ = io.Copy(io.Discard, os.Stdin)
+		grpcSrv.GracefulStop()
+	}()
+
+	return grpcSrv.Serve(listener)
+}
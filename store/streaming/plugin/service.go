@@ -0,0 +1,373 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Delivery is the delivery guarantee of the messages forwarded to a plugin.
+type Delivery string
+
+const (
+	// DeliveryBlock forwards each message synchronously: the node waits for
+	// the plugin to receive it, and halts if the plugin fails to.
+	DeliveryBlock Delivery = "block"
+
+	// DeliveryDrop forwards the messages asynchronously through a buffer: the
+	// node never waits for the plugin, and the messages are dropped when the
+	// buffer is full or the plugin fails to receive them.
+	DeliveryDrop Delivery = "drop"
+)
+
+// The environment variables through which the node passes the address to
+// listen on to the plugin process.
+const (
+	// EnvAddress holds the path of the unix socket the plugin serves on.
+	EnvAddress = "COSMOS_STREAMING_PLUGIN_ADDRESS"
+
+	// EnvMagicCookie holds MagicCookie, so that a plugin can tell it was
+	// started by a node.
+	EnvMagicCookie = "COSMOS_STREAMING_PLUGIN_MAGIC_COOKIE"
+
+	// MagicCookie is the value of EnvMagicCookie.
+	MagicCookie = "cosmos-sdk-streaming-plugin"
+)
+
+const (
+	defaultBufferSize   = 1000
+	defaultTimeout      = 10 * time.Second
+	defaultStartTimeout = 10 * time.Second
+	stopTimeout         = 5 * time.Second
+)
+
+// Config defines the plugin process to start and how the messages are
+// forwarded to it.
+type Config struct {
+	// Path is the path of the plugin executable.
+	Path string
+	// Args are the arguments the plugin is started with.
+	Args []string
+	// Delivery is the delivery guarantee of the messages, DeliveryBlock by
+	// default.
+	Delivery Delivery
+	// BufferSize is the number of messages buffered with DeliveryDrop.
+	BufferSize int
+	// Timeout bounds the time to forward a message.
+	Timeout time.Duration
+	// StartTimeout bounds the time for the plugin to start serving.
+	StartTimeout time.Duration
+	// Logger logs the messages dropped with DeliveryDrop.
+	Logger log.Logger
+}
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is an implementation of StreamingService that forwards the
+// ABCI messages and the state changes of each block to a plugin, i.e. a local
+// process serving the ABCIListenerService over gRPC.
+type StreamingService struct {
+	config         Config
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+
+	cmd    *exec.Cmd
+	stdin  io.Closer     // closing the standard input of the plugin stops it
+	exited chan struct{} // closed when the plugin process exits
+	dir    string        // directory of the socket of the plugin
+	conn   *grpc.ClientConn
+	client ABCIListenerServiceClient
+
+	currentBlockNumber int64
+
+	// queue holds the messages to forward with DeliveryDrop.
+	mtx    sync.Mutex
+	queue  chan func(context.Context) error
+	closed bool
+	worker sync.WaitGroup
+}
+
+// NewStreamingService starts the plugin and returns a StreamingService
+// forwarding the writes to the given stores to it.
+func NewStreamingService(config Config, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("streaming plugin path is not set")
+	}
+	switch config.Delivery {
+	case "":
+		config.Delivery = DeliveryBlock
+	case DeliveryBlock, DeliveryDrop:
+	default:
+		return nil, fmt.Errorf("unknown streaming plugin delivery %q", config.Delivery)
+	}
+	if config.BufferSize <= 0 {
+		config.BufferSize = defaultBufferSize
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.StartTimeout <= 0 {
+		config.StartTimeout = defaultStartTimeout
+	}
+	if config.Logger == nil {
+		config.Logger = log.NewNopLogger()
+	}
+
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	s := &StreamingService{
+		config:         config,
+		storeListeners: listeners,
+	}
+	if config.Delivery == DeliveryDrop {
+		s.queue = make(chan func(context.Context) error, config.BufferSize)
+	}
+
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// start starts the plugin process and connects to it.
+func (s *StreamingService) start() error {
+	dir, err := os.MkdirTemp("", "streaming-plugin")
+	if err != nil {
+		return err
+	}
+	address := filepath.Join(dir, "plugin.sock")
+
+	cmd := exec.Command(s.config.Path, s.config.Args...)
+	cmd.Env = append(os.Environ(), EnvAddress+"="+address, EnvMagicCookie+"="+MagicCookie)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return fmt.Errorf("failed to start streaming plugin: %w", err)
+	}
+
+	s.cmd, s.stdin, s.dir = cmd, stdin, dir
+	s.exited = make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(s.exited)
+	}()
+
+	// give up waiting for the plugin to serve as soon as it exits
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.StartTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.exited:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// retry connecting frequently, as the plugin serves shortly after starting
+	backoffConfig := backoff.DefaultConfig
+	backoffConfig.BaseDelay = 50 * time.Millisecond
+	conn, err := grpc.DialContext(
+		ctx, "unix://"+address,
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoffConfig}),
+	)
+	if err != nil {
+		s.stop()
+		return fmt.Errorf("failed to connect to streaming plugin: %w", err)
+	}
+
+	s.conn = conn
+	s.client = NewABCIListenerServiceClient(conn)
+
+	return nil
+}
+
+// stop stops the plugin process, killing it if it does not exit in time.
+func (s *StreamingService) stop() {
+	s.stdin.Close()
+
+	select {
+	case <-s.exited:
+	case <-time.After(stopTimeout):
+		_ = s.cmd.Process.Kill()
+		<-s.exited
+	}
+
+	os.RemoveAll(s.dir)
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(s.storeListeners))
+	for _, listener := range s.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It forwards the
+// BeginBlock request and response to the plugin.
+func (s *StreamingService) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.currentBlockNumber = req.Header.Height
+
+	return s.deliver(func(ctx context.Context) error {
+		_, err := s.client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It forwards the
+// DeliverTx request and response to the plugin.
+func (s *StreamingService) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	blockHeight := s.currentBlockNumber
+
+	return s.deliver(func(ctx context.Context) error {
+		_, err := s.client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{BlockHeight: blockHeight, Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It forwards the
+// EndBlock request and response to the plugin.
+func (s *StreamingService) ListenEndBlock(_ context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return s.deliver(func(ctx context.Context) error {
+		_, err := s.client.ListenEndBlock(ctx, &ListenEndBlockRequest{Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenCommit satisfies the ABCIListener interface. It forwards the Commit
+// response and the writes to the stores during the block to the plugin.
+func (s *StreamingService) ListenCommit(_ context.Context, res abci.ResponseCommit) error {
+	var changeSet []*types.StoreKVPair
+	for _, listener := range s.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			changeSet = append(changeSet, &cache[i])
+		}
+	}
+
+	req := &ListenCommitRequest{BlockHeight: s.currentBlockNumber, Res: &res, ChangeSet: changeSet}
+	return s.deliver(func(ctx context.Context) error {
+		_, err := s.client.ListenCommit(ctx, req)
+		return err
+	})
+}
+
+// deliver forwards a message to the plugin with the configured guarantee. It
+// only returns a non-nil error with DeliveryBlock.
+func (s *StreamingService) deliver(send func(context.Context) error) error {
+	if s.config.Delivery == DeliveryBlock {
+		return s.send(send)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		s.drop(fmt.Errorf("streaming service is closed"))
+		return nil
+	}
+
+	select {
+	case s.queue <- send:
+	default:
+		s.drop(fmt.Errorf("buffer is full"))
+	}
+
+	return nil
+}
+
+func (s *StreamingService) send(send func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Timeout)
+	defer cancel()
+
+	if err := send(ctx); err != nil {
+		return fmt.Errorf("failed to forward message to streaming plugin: %w", err)
+	}
+
+	return nil
+}
+
+func (s *StreamingService) drop(err error) {
+	telemetry.IncrCounter(1, "streaming", "plugin", "dropped")
+	s.config.Logger.Error("dropped message to streaming plugin", "err", err)
+}
+
+// Stream satisfies the StreamingService interface. With DeliveryDrop, it
+// starts forwarding the buffered messages to the plugin, otherwise it performs
+// a no-op.
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if s.queue == nil {
+		return nil
+	}
+
+	wg.Add(1)
+	s.worker.Add(1)
+	go func() {
+		defer wg.Done()
+		defer s.worker.Done()
+
+		for send := range s.queue {
+			if err := s.send(send); err != nil {
+				s.drop(err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Close satisfies the StreamingService interface. It forwards the buffered
+// messages and stops the plugin.
+func (s *StreamingService) Close() error {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return nil
+	}
+	s.closed = true
+	if s.queue != nil {
+		close(s.queue)
+	}
+	s.mtx.Unlock()
+
+	s.worker.Wait()
+	err := s.conn.Close()
+	s.stop()
+
+	return err
+}
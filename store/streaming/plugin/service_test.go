@@ -0,0 +1,255 @@
+package plugin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// The environment variables configuring the stand-in plugin. They are set by
+// the tests and inherited by the plugin processes.
+const (
+	envOutput     = "STREAMING_PLUGIN_TEST_OUTPUT"
+	envFailHeight = "STREAMING_PLUGIN_TEST_FAIL_HEIGHT"
+	envDelay      = "STREAMING_PLUGIN_TEST_DELAY"
+	envExit       = "STREAMING_PLUGIN_TEST_EXIT"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+// TestMain runs the test binary as the stand-in plugin when it is started by
+// a StreamingService.
+func TestMain(m *testing.M) {
+	if os.Getenv(plugin.EnvMagicCookie) != "" {
+		os.Exit(runStandInPlugin())
+	}
+
+	os.Exit(m.Run())
+}
+
+// standInPlugin appends a line describing each message it receives to the
+// output file.
+type standInPlugin struct {
+	plugin.UnimplementedABCIListenerServiceServer
+
+	mtx        sync.Mutex
+	file       *os.File
+	failHeight string
+	delay      time.Duration
+}
+
+func runStandInPlugin() int {
+	if os.Getenv(envExit) != "" {
+		return 1
+	}
+
+	f, err := os.OpenFile(os.Getenv(envOutput), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer f.Close()
+
+	delay, _ := time.ParseDuration(os.Getenv(envDelay))
+	p := &standInPlugin{file: f, failHeight: os.Getenv(envFailHeight), delay: delay}
+	if err := plugin.Serve(p); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+func (p *standInPlugin) write(height int64, line string) (*plugin.ListenResponse, error) {
+	time.Sleep(p.delay)
+
+	if fmt.Sprint(height) == p.failHeight {
+		return nil, fmt.Errorf("failed at height %d", height)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	_, err := fmt.Fprintln(p.file, line)
+	return &plugin.ListenResponse{}, err
+}
+
+func (p *standInPlugin) ListenBeginBlock(_ context.Context, req *plugin.ListenBeginBlockRequest) (*plugin.ListenResponse, error) {
+	return p.write(req.Req.Header.Height, fmt.Sprintf("begin_block %d", req.Req.Header.Height))
+}
+
+func (p *standInPlugin) ListenDeliverTx(_ context.Context, req *plugin.ListenDeliverTxRequest) (*plugin.ListenResponse, error) {
+	return p.write(req.BlockHeight, fmt.Sprintf("deliver_tx %d %s %d", req.BlockHeight, req.Req.Tx, req.Res.GasUsed))
+}
+
+func (p *standInPlugin) ListenEndBlock(_ context.Context, req *plugin.ListenEndBlockRequest) (*plugin.ListenResponse, error) {
+	return p.write(req.Req.Height, fmt.Sprintf("end_block %d", req.Req.Height))
+}
+
+func (p *standInPlugin) ListenCommit(_ context.Context, req *plugin.ListenCommitRequest) (*plugin.ListenResponse, error) {
+	changes := make([]string, len(req.ChangeSet))
+	for i, pair := range req.ChangeSet {
+		if pair.Delete {
+			changes[i] = fmt.Sprintf("%s:-%s", pair.StoreKey, pair.Key)
+		} else {
+			changes[i] = fmt.Sprintf("%s:%s=%s", pair.StoreKey, pair.Key, pair.Value)
+		}
+	}
+
+	return p.write(req.BlockHeight, fmt.Sprintf("commit %d %s %s", req.BlockHeight, req.Res.Data, strings.Join(changes, ",")))
+}
+
+// startPlugin returns a StreamingService running the stand-in plugin, and the
+// path of the file the plugin writes the messages it receives to.
+func startPlugin(t *testing.T, config plugin.Config) (*plugin.StreamingService, string) {
+	output := filepath.Join(t.TempDir(), "output")
+	t.Setenv(envOutput, output)
+
+	config.Path = os.Args[0]
+	s, err := plugin.NewStreamingService(config, []types.StoreKey{storeKey2, storeKey1})
+	require.NoError(t, err)
+	t.Cleanup(func() { s.Close() })
+
+	return s, output
+}
+
+func readOutput(t *testing.T, output string) []string {
+	bz, err := os.ReadFile(output)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(bz), "\n"), "\n")
+}
+
+// streamBlock forwards the messages of a block with a transaction writing to
+// both stores.
+func streamBlock(s *plugin.StreamingService, height int64) error {
+	ctx := context.Background()
+	if err := s.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}); err != nil {
+		return err
+	}
+
+	listeners := s.Listeners()
+	if err := listeners[storeKey2][0].OnWrite(storeKey2, []byte("b"), []byte(fmt.Sprint(height)), false); err != nil {
+		return err
+	}
+	if err := listeners[storeKey1][0].OnWrite(storeKey1, []byte("a"), nil, true); err != nil {
+		return err
+	}
+	if err := s.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{GasUsed: 10}); err != nil {
+		return err
+	}
+
+	if err := s.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}); err != nil {
+		return err
+	}
+
+	return s.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")})
+}
+
+func TestStreamingServiceBlock(t *testing.T) {
+	s, output := startPlugin(t, plugin.Config{})
+	require.Len(t, s.Listeners(), 2)
+
+	require.NoError(t, streamBlock(s, 1))
+	require.NoError(t, streamBlock(s, 2))
+	require.NoError(t, s.Close())
+
+	// the change sets are ordered by store name
+	require.Equal(t, []string{
+		"begin_block 1",
+		"deliver_tx 1 tx 10",
+		"end_block 1",
+		"commit 1 hash store1:-a,store2:b=1",
+		"begin_block 2",
+		"deliver_tx 2 tx 10",
+		"end_block 2",
+		"commit 2 hash store1:-a,store2:b=2",
+	}, readOutput(t, output))
+
+	// the messages cannot be forwarded once the plugin is stopped
+	require.Error(t, streamBlock(s, 3))
+}
+
+func TestStreamingServiceBlockError(t *testing.T) {
+	t.Setenv(envFailHeight, "2")
+	s, _ := startPlugin(t, plugin.Config{Delivery: plugin.DeliveryBlock})
+
+	require.NoError(t, streamBlock(s, 1))
+	require.Error(t, streamBlock(s, 2))
+}
+
+func TestStreamingServiceBlockTimeout(t *testing.T) {
+	t.Setenv(envDelay, "1s")
+	s, _ := startPlugin(t, plugin.Config{Timeout: 100 * time.Millisecond})
+
+	require.Error(t, streamBlock(s, 1))
+}
+
+func TestStreamingServiceDrop(t *testing.T) {
+	t.Setenv(envFailHeight, "2")
+	t.Setenv(envDelay, "20ms")
+	s, output := startPlugin(t, plugin.Config{Delivery: plugin.DeliveryDrop, BufferSize: 4})
+
+	var wg sync.WaitGroup
+	require.NoError(t, s.Stream(&wg))
+
+	// the node does not wait for the plugin, nor halts when it fails
+	start := time.Now()
+	for height := int64(1); height <= 4; height++ {
+		require.NoError(t, streamBlock(s, height))
+	}
+	require.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+	// the buffered messages are forwarded before the plugin is stopped
+	require.NoError(t, s.Close())
+	wg.Wait()
+
+	lines := readOutput(t, output)
+	require.Equal(t, []string{
+		"begin_block 1",
+		"deliver_tx 1 tx 10",
+		"end_block 1",
+		"commit 1 hash store1:-a,store2:b=1",
+	}, lines[:4])
+	require.Less(t, len(lines), 16)
+
+	// the messages are dropped once the service is closed
+	require.NoError(t, streamBlock(s, 5))
+}
+
+func TestStreamingServiceStartError(t *testing.T) {
+	_, err := plugin.NewStreamingService(plugin.Config{}, nil)
+	require.Error(t, err)
+
+	_, err = plugin.NewStreamingService(plugin.Config{Path: os.Args[0], Delivery: "unknown"}, nil)
+	require.Error(t, err)
+
+	_, err = plugin.NewStreamingService(plugin.Config{Path: filepath.Join(t.TempDir(), "missing")}, nil)
+	require.Error(t, err)
+
+	// the service does not wait for the start timeout when the plugin exits
+	t.Setenv(envExit, "1")
+	start := time.Now()
+	_, err = plugin.NewStreamingService(plugin.Config{Path: os.Args[0], StartTimeout: time.Minute}, nil)
+	require.Error(t, err)
+	require.Less(t, int64(time.Since(start)), int64(10*time.Second))
+}
+
+func TestServe(t *testing.T) {
+	// plugins cannot be executed directly
+	require.Error(t, plugin.Serve(&standInPlugin{}))
+}
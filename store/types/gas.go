@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"math"
+	"sort"
 )
 
 // Gas consumption descriptors.
@@ -220,3 +221,48 @@ func TransientGasConfig() GasConfig {
 		IterNextCostFlat: 1,
 	}
 }
+
+// StoreGasStats records the accesses to a store and the gas they consumed.
+type StoreGasStats struct {
+	Reads      uint64
+	ReadBytes  uint64
+	Writes     uint64
+	WriteBytes uint64
+	Gas        Gas
+}
+
+// GasStats records the StoreGasStats of each store accessed through a gas
+// meter, by store name. It is not safe for concurrent use, like the gas meters.
+type GasStats struct {
+	stores map[string]*StoreGasStats
+}
+
+// NewGasStats returns a new empty GasStats.
+func NewGasStats() *GasStats {
+	return &GasStats{
+		stores: make(map[string]*StoreGasStats),
+	}
+}
+
+// Store returns the stats of the store with the given name.
+func (gs *GasStats) Store(name string) *StoreGasStats {
+	stats, ok := gs.stores[name]
+	if !ok {
+		stats = &StoreGasStats{}
+		gs.stores[name] = stats
+	}
+
+	return stats
+}
+
+// StoreNames returns the names of the stores with recorded stats, in
+// ascending order.
+func (gs *GasStats) StoreNames() []string {
+	names := make([]string, 0, len(gs.stores))
+	for name := range gs.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"io"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -88,3 +89,31 @@ func (fl *MemoryListener) PopStateCache() []StoreKVPair {
 func (fl *MemoryListener) StoreKey() StoreKey {
 	return fl.key
 }
+
+// PrefixFilterWriteListener forwards to its parent listener the writes to the
+// keys starting with one of its prefixes.
+type PrefixFilterWriteListener struct {
+	parent   WriteListener
+	prefixes [][]byte
+}
+
+// NewPrefixFilterWriteListener creates a listener forwarding to the parent
+// listener the writes to the keys starting with one of the given prefixes.
+func NewPrefixFilterWriteListener(parent WriteListener, prefixes [][]byte) *PrefixFilterWriteListener {
+	return &PrefixFilterWriteListener{
+		parent:   parent,
+		prefixes: prefixes,
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by forwarding the writes to
+// the keys starting with one of the prefixes of the listener.
+func (fl *PrefixFilterWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	for _, prefix := range fl.prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return fl.parent.OnWrite(storeKey, key, value, delete)
+		}
+	}
+
+	return nil
+}
@@ -64,3 +64,19 @@ func TestOnWrite(t *testing.T) {
 	testMarshaller.UnmarshalLengthPrefixed(outputBytes, outputKVPair)
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestPrefixFilterWriteListener(t *testing.T) {
+	ml := NewMemoryListener(NewKVStoreKey("test_key"))
+	fl := NewPrefixFilterWriteListener(ml, [][]byte{{0x01}, {0x02, 0x03}})
+
+	testStoreKey := NewKVStoreKey("test_key")
+	require.NoError(t, fl.OnWrite(testStoreKey, []byte{0x01, 0xff}, []byte("value1"), false))
+	require.NoError(t, fl.OnWrite(testStoreKey, []byte{0x02}, []byte("value2"), false))
+	require.NoError(t, fl.OnWrite(testStoreKey, []byte{0x02, 0x03}, nil, true))
+	require.NoError(t, fl.OnWrite(testStoreKey, []byte{0x03, 0x01}, []byte("value3"), false))
+
+	require.Equal(t, []StoreKVPair{
+		{StoreKey: "test_key", Key: []byte{0x01, 0xff}, Value: []byte("value1")},
+		{StoreKey: "test_key", Key: []byte{0x02, 0x03}, Delete: true},
+	}, ml.PopStateCache())
+}
@@ -62,6 +62,16 @@ func (po PruningOptions) Validate() error {
 	return nil
 }
 
+// Retains returns true if the given height is kept on disk by the pruning
+// strategy once the latest height is committed.
+func (po PruningOptions) Retains(height, latestHeight int64) bool {
+	if height >= latestHeight-int64(po.KeepRecent) {
+		return true
+	}
+
+	return po.KeepEvery > 0 && height%int64(po.KeepEvery) == 0
+}
+
 func NewPruningOptionsFromString(strategy string) PruningOptions {
 	switch strategy {
 	case PruningOptionEverything:
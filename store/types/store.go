@@ -187,6 +187,18 @@ type CommitMultiStore interface {
 	// SetIAVLDisableFastNode enables/disables fastnode feature on iavl.
 	SetIAVLDisableFastNode(disable bool)
 
+	// SetStorePruning sets the pruning strategy of the store with the given
+	// name, overriding the pruning strategy of the multistore.
+	SetStorePruning(storeName string, opts PruningOptions)
+
+	// SetAsyncPruning enables or disables the deletion of pruned versions in
+	// the background instead of on commit.
+	SetAsyncPruning(async bool)
+
+	// SetStoreMetrics enables or disables the metrics of the accesses to the
+	// store with the given name, or to all the stores for "*".
+	SetStoreMetrics(storeName string, enabled bool)
+
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
 
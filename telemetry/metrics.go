@@ -52,6 +52,15 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// StoreMetrics defines the names of the stores whose accesses are measured,
+	// i.e. the latency of their operations, the lifetime of their iterators and
+	// the sizes of the keys and values read and written. "*" measures all the
+	// stores.
+	//
+	// Example:
+	// ["bank", "staking"]
+	StoreMetrics []string `mapstructure:"store-metrics"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -160,6 +161,7 @@ type (
 		RPCClient  tmclient.Client
 
 		tmNode  *node.Node
+		tmDBs   []io.Closer
 		api     *api.Server
 		grpc    *grpc.Server
 		grpcWeb *http.Server
@@ -474,6 +476,13 @@ func (n *Network) Cleanup() {
 	for _, v := range n.Validators {
 		if v.tmNode != nil && v.tmNode.IsRunning() {
 			_ = v.tmNode.Stop()
+			v.tmNode.Wait()
+		}
+
+		// the node does not close its databases, which are closed for the node
+		// directory to be reopened
+		for _, db := range v.tmDBs {
+			_ = db.Close()
 		}
 
 		if v.api != nil {
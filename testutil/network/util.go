@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	tmdb "github.com/cometbft/cometbft-db"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
@@ -42,6 +43,15 @@ func startInProcess(cfg Config, val *Validator) error {
 
 	app := cfg.AppConstructor(*val)
 
+	dbProvider := func(ctx *node.DBContext) (tmdb.DB, error) {
+		db, err := node.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		val.tmDBs = append(val.tmDBs, db)
+		return db, nil
+	}
+
 	genDocProvider := node.DefaultGenesisDocProviderFunc(tmCfg)
 	tmNode, err := node.NewNode(
 		tmCfg,
@@ -49,7 +59,7 @@ func startInProcess(cfg Config, val *Validator) error {
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		genDocProvider,
-		node.DefaultDBProvider,
+		dbProvider,
 		node.DefaultMetricsProvider(tmCfg.Instrumentation),
 		logger.With("module", val.Moniker),
 	)
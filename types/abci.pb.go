@@ -272,6 +272,10 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// StoreGas is the breakdown of the accesses to each store and the gas they
+	// consumed, sorted by store name. It is only recorded when the transaction
+	// is simulated.
+	StoreGas []StoreGasInfo `protobuf:"bytes,3,rep,name=store_gas,json=storeGas,proto3" json:"store_gas" yaml:"store_gas"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -320,6 +324,105 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetStoreGas() []StoreGasInfo {
+	if m != nil {
+		return m.StoreGas
+	}
+	return nil
+}
+
+// StoreGasInfo defines the accesses of a transaction to a store, and the gas
+// they consumed.
+type StoreGasInfo struct {
+	// Store is the name of the store.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// Reads is the number of keys read from the store, including the keys
+	// checked for existence and the iterated keys.
+	Reads uint64 `protobuf:"varint,2,opt,name=reads,proto3" json:"reads,omitempty"`
+	// ReadBytes is the size of the keys and values read from the store.
+	ReadBytes uint64 `protobuf:"varint,3,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty" yaml:"read_bytes"`
+	// Writes is the number of keys set or deleted in the store.
+	Writes uint64 `protobuf:"varint,4,opt,name=writes,proto3" json:"writes,omitempty"`
+	// WriteBytes is the size of the keys and values written to the store.
+	WriteBytes uint64 `protobuf:"varint,5,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty" yaml:"write_bytes"`
+	// GasUsed is the gas consumed by the accesses to the store.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *StoreGasInfo) Reset()      { *m = StoreGasInfo{} }
+func (*StoreGasInfo) ProtoMessage() {}
+func (*StoreGasInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *StoreGasInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreGasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreGasInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreGasInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreGasInfo.Merge(m, src)
+}
+func (m *StoreGasInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreGasInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreGasInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreGasInfo proto.InternalMessageInfo
+
+func (m *StoreGasInfo) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *StoreGasInfo) GetReads() uint64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *StoreGasInfo) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *StoreGasInfo) GetWrites() uint64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *StoreGasInfo) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *StoreGasInfo) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -335,7 +438,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +477,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +523,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +575,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +630,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,6 +707,7 @@ func init() {
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*StoreGasInfo)(nil), "cosmos.base.abci.v1beta1.StoreGasInfo")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
@@ -616,66 +720,72 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 929 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0xee, 0x3a, 0x7e, 0x4e, 0x08, 0x0c, 0xa1, 0xdd, 0xb4, 0xe0, 0x35, 0x9b, 0x56,
-	0xf2, 0x85, 0xb5, 0x9a, 0x06, 0x84, 0x7a, 0x40, 0xd4, 0x85, 0xd2, 0x48, 0x2d, 0x87, 0x89, 0x2b,
-	0x24, 0x2e, 0xd6, 0xd8, 0x9e, 0x8e, 0x97, 0x7a, 0x77, 0xac, 0x9d, 0xd9, 0x64, 0x7d, 0xe3, 0xc8,
-	0x91, 0x13, 0x07, 0x4e, 0x9c, 0xf9, 0x4b, 0x7a, 0x40, 0x22, 0xc7, 0x1e, 0x90, 0x81, 0xe4, 0xd6,
-	0x63, 0xfe, 0x02, 0x34, 0x3f, 0xe2, 0xdd, 0x80, 0x5c, 0x89, 0x93, 0xdf, 0xfb, 0xde, 0x9b, 0x37,
-	0xef, 0x7d, 0xef, 0xdb, 0x31, 0xec, 0x8d, 0xb9, 0x88, 0xb9, 0xe8, 0x8d, 0x88, 0xa0, 0x3d, 0x32,
-	0x1a, 0x47, 0xbd, 0xe3, 0xbb, 0x23, 0x2a, 0xc9, 0x5d, 0xed, 0x84, 0xf3, 0x94, 0x4b, 0x8e, 0x3c,
-	0x93, 0x14, 0xaa, 0xa4, 0x50, 0xe3, 0x36, 0xe9, 0xe6, 0x0e, 0xe3, 0x8c, 0xeb, 0xa4, 0x9e, 0xb2,
-	0x4c, 0xfe, 0xcd, 0x5b, 0x92, 0x26, 0x13, 0x9a, 0xc6, 0x51, 0x22, 0x4d, 0x4d, 0xb9, 0x98, 0x53,
-	0x61, 0x83, 0xbb, 0x8c, 0x73, 0x36, 0xa3, 0x3d, 0xed, 0x8d, 0xb2, 0xe7, 0x3d, 0x92, 0x2c, 0x4c,
-	0x28, 0xf8, 0xad, 0x06, 0x30, 0xc8, 0x31, 0x15, 0x73, 0x9e, 0x08, 0x8a, 0xae, 0x83, 0x3b, 0xa5,
-	0x11, 0x9b, 0x4a, 0xcf, 0xe9, 0x38, 0xdd, 0x1a, 0xb6, 0x1e, 0x0a, 0xc0, 0x95, 0xf9, 0x94, 0x88,
-	0xa9, 0x57, 0xed, 0x38, 0xdd, 0x66, 0x1f, 0xce, 0x96, 0xbe, 0x3b, 0xc8, 0x1f, 0x13, 0x31, 0xc5,
-	0x36, 0x82, 0xde, 0x87, 0xe6, 0x98, 0x4f, 0xa8, 0x98, 0x93, 0x31, 0xf5, 0x6a, 0x2a, 0x0d, 0x17,
-	0x00, 0x42, 0x50, 0x57, 0x8e, 0x57, 0xef, 0x38, 0xdd, 0x2d, 0xac, 0x6d, 0x85, 0x4d, 0x88, 0x24,
-	0xde, 0x35, 0x9d, 0xac, 0x6d, 0x74, 0x03, 0x1a, 0x29, 0x39, 0x19, 0xce, 0x38, 0xf3, 0x5c, 0x0d,
-	0xbb, 0x29, 0x39, 0x79, 0xc2, 0x19, 0x7a, 0x06, 0xf5, 0x19, 0x67, 0xc2, 0x6b, 0x74, 0x6a, 0xdd,
-	0xd6, 0x7e, 0x37, 0x5c, 0x47, 0x50, 0xf8, 0xa0, 0xff, 0xf0, 0xf0, 0x29, 0x15, 0x82, 0x30, 0xfa,
-	0x84, 0xb3, 0xfe, 0x8d, 0x97, 0x4b, 0xbf, 0xf2, 0xeb, 0x9f, 0xfe, 0xf6, 0x55, 0x5c, 0x60, 0x5d,
-	0x4e, 0xf5, 0x10, 0x25, 0xcf, 0xb9, 0xb7, 0x61, 0x7a, 0x50, 0x36, 0xfa, 0x00, 0x80, 0x11, 0x31,
-	0x3c, 0x21, 0x89, 0xa4, 0x13, 0xaf, 0xa9, 0x99, 0x68, 0x32, 0x22, 0xbe, 0xd1, 0x00, 0xda, 0x85,
-	0x0d, 0x15, 0xce, 0x04, 0x9d, 0x78, 0xa0, 0x83, 0x0d, 0x46, 0xc4, 0x33, 0x41, 0x27, 0xe8, 0x36,
-	0x54, 0x65, 0xee, 0xb5, 0x3a, 0x4e, 0xb7, 0xb5, 0xbf, 0x13, 0x1a, 0xda, 0xc3, 0x4b, 0xda, 0xc3,
-	0x07, 0xc9, 0x02, 0x57, 0x65, 0xae, 0x98, 0x92, 0x51, 0x4c, 0x85, 0x24, 0xf1, 0xdc, 0xdb, 0x34,
-	0x4c, 0xad, 0x00, 0x74, 0x00, 0x2e, 0x3d, 0xa6, 0x89, 0x14, 0xde, 0x96, 0x1e, 0xf5, 0x7a, 0x58,
-	0xec, 0xd6, 0x4c, 0xfa, 0xa5, 0x0a, 0xf7, 0xeb, 0x6a, 0x30, 0x6c, 0x73, 0xef, 0xd7, 0x7f, 0xf8,
-	0xc5, 0xaf, 0x04, 0x3f, 0x3b, 0xf0, 0xd6, 0xd5, 0x39, 0xd1, 0x2d, 0x68, 0xc6, 0x82, 0x0d, 0xa3,
-	0x64, 0x42, 0x73, 0xbd, 0xd5, 0x2d, 0xbc, 0x11, 0x0b, 0x76, 0xa8, 0x7c, 0xf4, 0x36, 0xd4, 0x14,
-	0xd3, 0x7a, 0xa9, 0x58, 0x99, 0xe8, 0x68, 0x75, 0x7b, 0x4d, 0xdf, 0x7e, 0x67, 0x3d, 0xd1, 0x47,
-	0x32, 0x8d, 0x12, 0x66, 0x9a, 0xd9, 0xb1, 0x2c, 0x6f, 0x96, 0x40, 0x51, 0x34, 0xf7, 0xfd, 0x1f,
-	0x1d, 0x27, 0x48, 0xa1, 0x55, 0x8a, 0x2a, 0xe6, 0x95, 0x48, 0x75, 0x4f, 0x4d, 0xac, 0x6d, 0x74,
-	0x08, 0x40, 0xa4, 0x4c, 0xa3, 0x51, 0x26, 0xa9, 0xf0, 0xaa, 0xba, 0x83, 0xbd, 0x37, 0xac, 0xfa,
-	0x32, 0xd7, 0x92, 0x51, 0x3a, 0x6c, 0xef, 0xbc, 0x07, 0xcd, 0x55, 0x92, 0x9a, 0xf6, 0x05, 0x5d,
-	0xd8, 0x0b, 0x95, 0x89, 0x76, 0xe0, 0xda, 0x31, 0x99, 0x65, 0xd4, 0x32, 0x60, 0x9c, 0x80, 0x43,
-	0xe3, 0x2b, 0x22, 0x0e, 0x95, 0x14, 0x0e, 0xae, 0x48, 0x41, 0x9d, 0xac, 0xf7, 0xdf, 0xbb, 0x58,
-	0xfa, 0xef, 0x2c, 0x48, 0x3c, 0xbb, 0x1f, 0x14, 0xb1, 0xa0, 0xac, 0x90, 0xb0, 0xa4, 0x90, 0xaa,
-	0x3e, 0xf3, 0xee, 0xc5, 0xd2, 0xdf, 0x2e, 0xce, 0xa8, 0x48, 0xb0, 0x92, 0x4d, 0xf0, 0x1d, 0xb8,
-	0x98, 0x8a, 0x6c, 0x26, 0x57, 0x9f, 0x84, 0xba, 0x69, 0xd3, 0x7e, 0x12, 0xff, 0x5d, 0xd2, 0xc1,
-	0xbf, 0x96, 0xf4, 0x7f, 0x24, 0xf2, 0x93, 0x03, 0xe8, 0x28, 0x8a, 0xb3, 0x19, 0x91, 0x11, 0x4f,
-	0x56, 0x5f, 0xfe, 0x23, 0xd3, 0xb2, 0xfe, 0x16, 0x1c, 0xad, 0xdf, 0x0f, 0xd7, 0xf3, 0x6e, 0xd9,
-	0xe9, 0x6f, 0xa8, 0xfa, 0xa7, 0x4b, 0xdf, 0xd1, 0xa3, 0x68, 0xc2, 0x3e, 0x05, 0x37, 0xd5, 0xa3,
-	0xe8, 0x7e, 0x5b, 0xfb, 0x9d, 0xf5, 0x55, 0xcc, 0xc8, 0xd8, 0xe6, 0x07, 0x9f, 0x41, 0xe3, 0xa9,
-	0x60, 0x5f, 0xa8, 0x89, 0x77, 0x41, 0x49, 0x74, 0x58, 0x92, 0x47, 0x23, 0x16, 0x6c, 0xa0, 0x14,
-	0x72, 0x49, 0x50, 0xb5, 0x20, 0xc8, 0xae, 0xfa, 0x31, 0x34, 0x07, 0xf9, 0x65, 0x85, 0x8f, 0x57,
-	0x3c, 0xd6, 0xde, 0x3c, 0x8a, 0x3d, 0x70, 0xa5, 0xd2, 0xef, 0x55, 0xd8, 0x3e, 0xa2, 0x24, 0x1d,
-	0x4f, 0x07, 0xb9, 0xb0, 0x8b, 0x79, 0x04, 0x2d, 0xc9, 0x25, 0x99, 0x0d, 0xc7, 0x3c, 0x4b, 0xa4,
-	0x55, 0xc2, 0x9d, 0xd7, 0x4b, 0xbf, 0x0c, 0x5f, 0x2c, 0x7d, 0x64, 0x96, 0x5c, 0x02, 0x03, 0x0c,
-	0xda, 0x7b, 0xa8, 0x1c, 0xa5, 0x38, 0x53, 0x41, 0xeb, 0x02, 0x1b, 0x47, 0x55, 0x9f, 0x13, 0x46,
-	0x87, 0x49, 0x16, 0x8f, 0x68, 0xaa, 0x5f, 0x4f, 0x5b, 0xbd, 0x04, 0x17, 0xd5, 0x4b, 0x60, 0x80,
-	0x41, 0x79, 0x5f, 0x6b, 0x07, 0xf5, 0x41, 0x7b, 0x43, 0x7d, 0xa1, 0x7e, 0x6b, 0xeb, 0xfd, 0xbd,
-	0xd7, 0x4b, 0xbf, 0x84, 0x16, 0xe2, 0x2d, 0xb0, 0x00, 0x37, 0x95, 0x33, 0x50, 0xb6, 0xea, 0x70,
-	0x16, 0xc5, 0x91, 0xd4, 0xcf, 0x72, 0x1d, 0x1b, 0x07, 0x7d, 0x02, 0x35, 0x99, 0x0b, 0xcf, 0xd5,
-	0x7c, 0xde, 0x5e, 0xcf, 0x67, 0xf1, 0x67, 0x82, 0xd5, 0x01, 0xc3, 0x68, 0xff, 0xf3, 0x57, 0x7f,
-	0xb7, 0x2b, 0x2f, 0xcf, 0xda, 0xce, 0xe9, 0x59, 0xdb, 0xf9, 0xeb, 0xac, 0xed, 0xfc, 0x78, 0xde,
-	0xae, 0x9c, 0x9e, 0xb7, 0x2b, 0xaf, 0xce, 0xdb, 0x95, 0x6f, 0x03, 0x16, 0xc9, 0x69, 0x36, 0x0a,
-	0xc7, 0x3c, 0xee, 0xd9, 0x3f, 0x47, 0xf3, 0xf3, 0x91, 0x98, 0xbc, 0x30, 0xff, 0x64, 0x23, 0x57,
-	0xbf, 0xa2, 0xf7, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x03, 0x85, 0x77, 0x9d, 0x3e, 0x07, 0x00,
-	0x00,
+	// 1037 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0xee, 0x3a, 0x3b, 0x4e, 0x69, 0x19, 0x42, 0xba, 0x69, 0xc1, 0x6b, 0x36, 0x2d,
+	0xf2, 0x85, 0xb5, 0x9a, 0x86, 0x1f, 0xea, 0x01, 0x51, 0x17, 0x4a, 0x23, 0xb5, 0x1c, 0x26, 0xae,
+	0x90, 0x90, 0x90, 0x35, 0xb6, 0xa7, 0xe3, 0xa5, 0xde, 0x1d, 0x6b, 0x67, 0x9c, 0xd8, 0x37, 0x8e,
+	0x1c, 0x39, 0x71, 0xe0, 0xc4, 0x99, 0xbf, 0xa4, 0x07, 0x04, 0x39, 0xf6, 0x80, 0x0c, 0x24, 0xb7,
+	0xde, 0xc8, 0x5f, 0x80, 0xde, 0xcc, 0xd8, 0xbb, 0x29, 0x4a, 0x10, 0x27, 0xbf, 0xef, 0xbd, 0x37,
+	0xdf, 0xbc, 0xf7, 0xcd, 0x9b, 0xf1, 0xa2, 0xed, 0x81, 0x90, 0x89, 0x90, 0xed, 0x3e, 0x95, 0xac,
+	0x4d, 0xfb, 0x83, 0xb8, 0x7d, 0x70, 0xbb, 0xcf, 0x14, 0xbd, 0xad, 0x41, 0x34, 0xc9, 0x84, 0x12,
+	0xd8, 0x37, 0x49, 0x11, 0x24, 0x45, 0xda, 0x6f, 0x93, 0xae, 0x6f, 0x70, 0xc1, 0x85, 0x4e, 0x6a,
+	0x83, 0x65, 0xf2, 0xaf, 0xdf, 0x50, 0x2c, 0x1d, 0xb2, 0x2c, 0x89, 0x53, 0x65, 0x38, 0xd5, 0x7c,
+	0xc2, 0xa4, 0x0d, 0x6e, 0x71, 0x21, 0xf8, 0x98, 0xb5, 0x35, 0xea, 0x4f, 0x9f, 0xb6, 0x69, 0x3a,
+	0x37, 0xa1, 0xf0, 0x97, 0x0a, 0x42, 0xdd, 0x19, 0x61, 0x72, 0x22, 0x52, 0xc9, 0xf0, 0x26, 0x72,
+	0x47, 0x2c, 0xe6, 0x23, 0xe5, 0x3b, 0x4d, 0xa7, 0x55, 0x21, 0x16, 0xe1, 0x10, 0xb9, 0x6a, 0x36,
+	0xa2, 0x72, 0xe4, 0x97, 0x9b, 0x4e, 0xcb, 0xeb, 0xa0, 0xe3, 0x45, 0xe0, 0x76, 0x67, 0x0f, 0xa9,
+	0x1c, 0x11, 0x1b, 0xc1, 0x6f, 0x21, 0x6f, 0x20, 0x86, 0x4c, 0x4e, 0xe8, 0x80, 0xf9, 0x15, 0x48,
+	0x23, 0xb9, 0x03, 0x63, 0x54, 0x05, 0xe0, 0x57, 0x9b, 0x4e, 0xeb, 0x32, 0xd1, 0x36, 0xf8, 0x86,
+	0x54, 0x51, 0xff, 0x92, 0x4e, 0xd6, 0x36, 0xbe, 0x86, 0x6a, 0x19, 0x3d, 0xec, 0x8d, 0x05, 0xf7,
+	0x5d, 0xed, 0x76, 0x33, 0x7a, 0xf8, 0x48, 0x70, 0xfc, 0x04, 0x55, 0xc7, 0x82, 0x4b, 0xbf, 0xd6,
+	0xac, 0xb4, 0xea, 0x3b, 0xad, 0xe8, 0x3c, 0x81, 0xa2, 0x7b, 0x9d, 0xfb, 0x7b, 0x8f, 0x99, 0x94,
+	0x94, 0xb3, 0x47, 0x82, 0x77, 0xae, 0x3d, 0x5f, 0x04, 0xa5, 0x9f, 0xff, 0x08, 0xae, 0x9c, 0xf5,
+	0x4b, 0xa2, 0xe9, 0xa0, 0x86, 0x38, 0x7d, 0x2a, 0xfc, 0x35, 0x53, 0x03, 0xd8, 0xf8, 0x6d, 0x84,
+	0x38, 0x95, 0xbd, 0x43, 0x9a, 0x2a, 0x36, 0xf4, 0x3d, 0xad, 0x84, 0xc7, 0xa9, 0xfc, 0x52, 0x3b,
+	0xf0, 0x16, 0x5a, 0x83, 0xf0, 0x54, 0xb2, 0xa1, 0x8f, 0x74, 0xb0, 0xc6, 0xa9, 0x7c, 0x22, 0xd9,
+	0x10, 0xdf, 0x44, 0x65, 0x35, 0xf3, 0xeb, 0x4d, 0xa7, 0x55, 0xdf, 0xd9, 0x88, 0x8c, 0xec, 0xd1,
+	0x52, 0xf6, 0xe8, 0x5e, 0x3a, 0x27, 0x65, 0x35, 0x03, 0xa5, 0x54, 0x9c, 0x30, 0xa9, 0x68, 0x32,
+	0xf1, 0xd7, 0x8d, 0x52, 0x2b, 0x07, 0xde, 0x45, 0x2e, 0x3b, 0x60, 0xa9, 0x92, 0xfe, 0x65, 0xdd,
+	0xea, 0x66, 0x94, 0x9f, 0xad, 0xe9, 0xf4, 0x33, 0x08, 0x77, 0xaa, 0xd0, 0x18, 0xb1, 0xb9, 0x77,
+	0xab, 0xdf, 0xfd, 0x14, 0x94, 0xc2, 0x1f, 0x1d, 0xf4, 0xda, 0xd9, 0x3e, 0xf1, 0x0d, 0xe4, 0x25,
+	0x92, 0xf7, 0xe2, 0x74, 0xc8, 0x66, 0xfa, 0x54, 0x2f, 0x93, 0xb5, 0x44, 0xf2, 0x3d, 0xc0, 0xf8,
+	0x2a, 0xaa, 0x80, 0xd2, 0xfa, 0x50, 0x09, 0x98, 0x78, 0x7f, 0xb5, 0x7b, 0x45, 0xef, 0x7e, 0xeb,
+	0x7c, 0xa1, 0xf7, 0x55, 0x16, 0xa7, 0xdc, 0x14, 0xb3, 0x61, 0x55, 0x5e, 0x2f, 0x38, 0x65, 0x5e,
+	0xdc, 0xb7, 0xbf, 0x37, 0x9d, 0x30, 0x43, 0xf5, 0x42, 0x14, 0x94, 0x87, 0x21, 0xd5, 0x35, 0x79,
+	0x44, 0xdb, 0x78, 0x0f, 0x21, 0xaa, 0x54, 0x16, 0xf7, 0xa7, 0x8a, 0x49, 0xbf, 0xac, 0x2b, 0xd8,
+	0xbe, 0xe0, 0xa8, 0x97, 0xb9, 0x56, 0x8c, 0xc2, 0x62, 0xbb, 0xe7, 0x1d, 0xe4, 0xad, 0x92, 0xa0,
+	0xdb, 0x67, 0x6c, 0x6e, 0x37, 0x04, 0x13, 0x6f, 0xa0, 0x4b, 0x07, 0x74, 0x3c, 0x65, 0x56, 0x01,
+	0x03, 0xc2, 0x5f, 0x1d, 0x54, 0xfb, 0x9c, 0xca, 0x3d, 0x98, 0x85, 0xdd, 0x33, 0xb3, 0x00, 0x4b,
+	0xab, 0x9d, 0x37, 0x4f, 0x17, 0xc1, 0xeb, 0x73, 0x9a, 0x8c, 0xef, 0x86, 0x79, 0x2c, 0x2c, 0x8e,
+	0x48, 0x54, 0x18, 0x91, 0xb2, 0x5e, 0xf3, 0xc6, 0xe9, 0x22, 0xb8, 0x92, 0xaf, 0x81, 0x48, 0x98,
+	0xcf, 0xcd, 0xd7, 0xc8, 0x93, 0x4a, 0x64, 0xac, 0xc7, 0xe9, 0x52, 0xf8, 0x77, 0x2f, 0x12, 0x5e,
+	0x64, 0xcc, 0x16, 0xd8, 0xf1, 0xa1, 0xf3, 0xd3, 0x45, 0x70, 0xd5, 0x90, 0xaf, 0x68, 0x42, 0xb2,
+	0x26, 0x6d, 0x5e, 0xf8, 0xb7, 0x83, 0xd6, 0x8b, 0x8b, 0xa0, 0x6f, 0x1d, 0xb4, 0x5a, 0x18, 0x00,
+	0xde, 0x8c, 0xd1, 0xa1, 0x34, 0x25, 0x13, 0x03, 0x40, 0x01, 0x30, 0x7a, 0xfd, 0x39, 0x9c, 0x49,
+	0xe5, 0x55, 0x05, 0xf2, 0x58, 0x48, 0x3c, 0x00, 0x1d, 0xb0, 0xe1, 0x25, 0x39, 0xcc, 0x62, 0x58,
+	0x51, 0xd5, 0x64, 0x16, 0xe1, 0x0f, 0x51, 0x5d, 0x5b, 0x96, 0xee, 0x92, 0xa6, 0xdb, 0x3c, 0x5d,
+	0x04, 0xd8, 0xd0, 0x15, 0x82, 0x21, 0x41, 0x1a, 0x19, 0xc2, 0xa2, 0xa4, 0xee, 0x7f, 0x4b, 0x1a,
+	0x7e, 0x83, 0x5c, 0xc2, 0xe4, 0x74, 0xac, 0x56, 0xcf, 0x0c, 0xf4, 0xba, 0x6e, 0x9f, 0x99, 0x7f,
+	0x0f, 0xfe, 0xee, 0x2b, 0x83, 0xff, 0x7f, 0xae, 0xdd, 0x0f, 0x0e, 0xc2, 0xfb, 0x71, 0x32, 0x1d,
+	0x53, 0x15, 0x8b, 0x74, 0xf5, 0x9a, 0x3e, 0x30, 0x25, 0xeb, 0xf7, 0xc5, 0xd1, 0x6f, 0xc2, 0x3b,
+	0xe7, 0x1f, 0xea, 0xf2, 0x3c, 0xd7, 0x80, 0xff, 0x68, 0x11, 0x38, 0xba, 0x15, 0x7d, 0x5a, 0x1f,
+	0x21, 0x37, 0xd3, 0xad, 0xe8, 0x7a, 0xeb, 0x3b, 0xcd, 0xf3, 0x59, 0x4c, 0xcb, 0xc4, 0xe6, 0x87,
+	0x1f, 0xa3, 0xda, 0x63, 0xc9, 0x3f, 0x85, 0x8e, 0xb7, 0x10, 0x5c, 0xfb, 0x5e, 0xe1, 0xca, 0xd5,
+	0x12, 0xc9, 0xbb, 0x70, 0xeb, 0x96, 0x02, 0x95, 0x73, 0x81, 0xec, 0xf5, 0x79, 0x88, 0xbc, 0xee,
+	0x6c, 0xc9, 0xf0, 0xfe, 0x4a, 0xc7, 0xca, 0xc5, 0xad, 0xd8, 0x05, 0x67, 0x98, 0x7e, 0x2b, 0xa3,
+	0x2b, 0xfb, 0x8c, 0x66, 0x83, 0x51, 0x77, 0x26, 0xed, 0xc1, 0x3c, 0x40, 0x75, 0x25, 0x14, 0x1d,
+	0xf7, 0x06, 0x62, 0x9a, 0x2a, 0x7b, 0xb9, 0x6e, 0xbd, 0x5c, 0x04, 0x45, 0x77, 0x3e, 0x1a, 0x05,
+	0x67, 0x48, 0x90, 0x46, 0xf7, 0x01, 0xc0, 0xdc, 0x1a, 0x06, 0x3b, 0xb7, 0x1a, 0x00, 0xfb, 0x84,
+	0x72, 0xd6, 0x4b, 0xa7, 0x49, 0x9f, 0x65, 0x7e, 0x25, 0x67, 0x2f, 0xb8, 0x73, 0xf6, 0x82, 0x33,
+	0x24, 0x08, 0xd0, 0x17, 0x1a, 0xe0, 0x0e, 0xd2, 0xa8, 0xa7, 0x37, 0x34, 0xd3, 0xdc, 0xd9, 0x7e,
+	0xb9, 0x08, 0x0a, 0xde, 0xfc, 0x36, 0xe4, 0xbe, 0x90, 0x78, 0x00, 0xba, 0x60, 0x43, 0x85, 0xe3,
+	0x38, 0x89, 0x95, 0x99, 0x77, 0x62, 0x00, 0xfe, 0x00, 0x55, 0xd4, 0x4c, 0xfa, 0xae, 0xd6, 0xf3,
+	0xe6, 0xf9, 0x7a, 0xe6, 0x7f, 0xd0, 0x04, 0x16, 0x18, 0x45, 0x3b, 0x9f, 0xbc, 0xf8, 0xab, 0x51,
+	0x7a, 0x7e, 0xdc, 0x70, 0x8e, 0x8e, 0x1b, 0xce, 0x9f, 0xc7, 0x0d, 0xe7, 0xfb, 0x93, 0x46, 0xe9,
+	0xe8, 0xa4, 0x51, 0x7a, 0x71, 0xd2, 0x28, 0x7d, 0x15, 0xf2, 0x58, 0x8d, 0xa6, 0xfd, 0x68, 0x20,
+	0x92, 0xb6, 0xfd, 0xe0, 0x30, 0x3f, 0xef, 0xc9, 0xe1, 0x33, 0xf3, 0x75, 0xd0, 0x77, 0xf5, 0x3f,
+	0xd3, 0x9d, 0x7f, 0x06, 0x00, 0x7b, 0x52, 0xa8, 0xc8, 0x92, 0x08, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -953,6 +1063,20 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StoreGas) > 0 {
+		for iNdEx := len(m.StoreGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -966,6 +1090,61 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StoreGasInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreGasInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreGasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteBytes != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.WriteBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Writes != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadBytes != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reads != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1340,6 +1519,40 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if len(m.StoreGas) > 0 {
+		for _, e := range m.StoreGas {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreGasInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.Reads != 0 {
+		n += 1 + sovAbci(uint64(m.Reads))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovAbci(uint64(m.ReadBytes))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovAbci(uint64(m.Writes))
+	}
+	if m.WriteBytes != 0 {
+		n += 1 + sovAbci(uint64(m.WriteBytes))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovAbci(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -2390,6 +2603,217 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreGas = append(m.StoreGas, StoreGasInfo{})
+			if err := m.StoreGas[len(m.StoreGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreGasInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreGasInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreGasInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+			}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			m.Writes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Writes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytes", wireType)
+			}
+			m.WriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ type Context struct {
 	voteInfo      []abci.VoteInfo
 	gasMeter      GasMeter
 	blockGasMeter GasMeter
+	gasStats      *stypes.GasStats
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
 	minGasPrice   DecCoins
@@ -54,6 +55,7 @@ func (c Context) Logger() log.Logger          { return c.logger }
 func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
 func (c Context) GasMeter() GasMeter          { return c.gasMeter }
 func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
+func (c Context) GasStats() *stypes.GasStats  { return c.gasStats }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
@@ -180,6 +182,13 @@ func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	return c
 }
 
+// WithGasStats returns a Context recording the accesses to its stores and the
+// gas they consume to the given stats, or not recording them if nil.
+func (c Context) WithGasStats(stats *stypes.GasStats) Context {
+	c.gasStats = stats
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -250,12 +259,21 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStoreWithStats(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig(), c.storeGasStats(key))
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStoreWithStats(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig(), c.storeGasStats(key))
+}
+
+// storeGasStats returns the gas stats of the store, which are nil unless the
+// context records them.
+func (c Context) storeGasStats(key StoreKey) *stypes.StoreGasStats {
+	if c.gasStats == nil {
+		return nil
+	}
+	return c.gasStats.Store(key.Name())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
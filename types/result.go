@@ -21,6 +21,11 @@ func (gi GasInfo) String() string {
 	return string(bz)
 }
 
+func (si StoreGasInfo) String() string {
+	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &si)
+	return string(bz)
+}
+
 func (r Result) String() string {
 	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &r)
 	return string(bz)
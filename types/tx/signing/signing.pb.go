@@ -52,6 +52,11 @@ const (
 	//
 	// Since: cosmos-sdk 0.45.2
 	SignMode_SIGN_MODE_EIP_191 SignMode = 191
+	// SIGN_MODE_EIP_712 specifies the sign mode for EIP 712 signing on the Cosmos
+	// SDK. The legacy amino JSON sign doc is signed as EIP-712 typed structured
+	// data, so that it can be signed by Ethereum wallets.
+	// Ref: https://eips.ethereum.org/EIPS/eip-712
+	SignMode_SIGN_MODE_EIP_712 SignMode = 712
 )
 
 var SignMode_name = map[int32]string{
@@ -60,6 +65,7 @@ var SignMode_name = map[int32]string{
 	2:   "SIGN_MODE_TEXTUAL",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 	191: "SIGN_MODE_EIP_191",
+	712: "SIGN_MODE_EIP_712",
 }
 
 var SignMode_value = map[string]int32{
@@ -68,6 +74,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_TEXTUAL":           2,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
+	"SIGN_MODE_EIP_712":           712,
 }
 
 func (x SignMode) String() string {
@@ -404,43 +411,43 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0xed, 0xe6, 0x47, 0xe9, 0xf4, 0xd3, 0x27, 0x33, 0xa4, 0x28, 0x31, 0xc8, 0x44, 0x65,
-	0x41, 0x84, 0x94, 0xb1, 0x92, 0x2c, 0x50, 0xd9, 0xe5, 0xc7, 0xa4, 0xa6, 0x4d, 0x52, 0xec, 0x54,
-	0x02, 0x36, 0x96, 0xed, 0x4c, 0x8d, 0xd5, 0xd8, 0x63, 0x3c, 0x63, 0x54, 0xaf, 0xb8, 0x00, 0x36,
-	0xdc, 0x06, 0x57, 0xc1, 0x82, 0x0d, 0xcb, 0x2e, 0x59, 0xa2, 0xe4, 0x1a, 0xd8, 0xa3, 0xd8, 0x71,
-	0x12, 0xa4, 0x22, 0x44, 0x56, 0xd6, 0x9c, 0xf3, 0x9e, 0xe7, 0xbc, 0xa3, 0x73, 0xc6, 0xe0, 0xb1,
-	0x4d, 0xa8, 0x47, 0xa8, 0xcc, 0xae, 0x65, 0xea, 0x3a, 0xbe, 0xeb, 0x3b, 0xf2, 0xfb, 0xa6, 0x85,
-	0x99, 0xd9, 0xcc, 0xce, 0x28, 0x08, 0x09, 0x23, 0xb0, 0x9a, 0x0a, 0x11, 0xbb, 0x46, 0x59, 0x62,
-	0x25, 0x14, 0x1b, 0x2b, 0x86, 0x1d, 0xc6, 0x01, 0x23, 0xb2, 0x17, 0xcd, 0x98, 0x4b, 0xdd, 0x0d,
-	0x28, 0x0b, 0xa4, 0x24, 0xb1, 0xea, 0x10, 0xe2, 0xcc, 0xb0, 0x9c, 0x9c, 0xac, 0xe8, 0x52, 0x36,
-	0xfd, 0x38, 0x4d, 0x1d, 0x5d, 0x82, 0xb2, 0xee, 0x3a, 0xbe, 0xc9, 0xa2, 0x10, 0xf7, 0x31, 0xb5,
-	0x43, 0x37, 0x60, 0x24, 0xa4, 0x70, 0x04, 0x00, 0xcd, 0xe2, 0xb4, 0xc2, 0xd7, 0x72, 0xf5, 0x83,
-	0x16, 0x42, 0x7f, 0x74, 0x84, 0x6e, 0x81, 0x68, 0x5b, 0x84, 0xa3, 0x9f, 0x79, 0x70, 0xf7, 0x16,
-	0x0d, 0x6c, 0x03, 0x10, 0x44, 0xd6, 0xcc, 0xb5, 0x8d, 0x2b, 0x1c, 0x57, 0xf8, 0x1a, 0x5f, 0x3f,
-	0x68, 0x95, 0x51, 0xea, 0x17, 0x65, 0x7e, 0x51, 0xc7, 0x8f, 0xb5, 0xfd, 0x54, 0x77, 0x8a, 0x63,
-	0x38, 0x00, 0xf9, 0xa9, 0xc9, 0xcc, 0xca, 0x5e, 0x22, 0x6f, 0xff, 0x9b, 0x2d, 0xd4, 0x37, 0x99,
-	0xa9, 0x25, 0x00, 0x28, 0x82, 0x12, 0xc5, 0xef, 0x22, 0xec, 0xdb, 0xb8, 0x92, 0xab, 0xf1, 0xf5,
-	0xbc, 0xb6, 0x3e, 0x8b, 0x5f, 0x73, 0x20, 0xbf, 0x94, 0xc2, 0x09, 0x28, 0x52, 0xd7, 0x77, 0x66,
-	0x78, 0x65, 0xef, 0xd9, 0x0e, 0xfd, 0x90, 0x9e, 0x10, 0x4e, 0x38, 0x6d, 0xc5, 0x82, 0x2f, 0x41,
-	0x21, 0x99, 0xd2, 0xea, 0x12, 0xc7, 0xbb, 0x40, 0x87, 0x4b, 0xc0, 0x09, 0xa7, 0xa5, 0x24, 0xd1,
-	0x00, 0xc5, 0xb4, 0x0d, 0x7c, 0x0a, 0xf2, 0x1e, 0x99, 0xa6, 0x86, 0xff, 0x6f, 0x3d, 0xfa, 0x0b,
-	0x7b, 0x48, 0xa6, 0x58, 0x4b, 0x0a, 0xe0, 0x03, 0xb0, 0xbf, 0x1e, 0x5a, 0xe2, 0xec, 0x3f, 0x6d,
-	0x13, 0x10, 0x3f, 0xf3, 0xa0, 0x90, 0xf4, 0x84, 0xa7, 0xa0, 0x64, 0xb9, 0xcc, 0x0c, 0x43, 0x33,
-	0x1b, 0x9a, 0x9c, 0x35, 0x49, 0x77, 0x12, 0xad, 0x57, 0x30, 0xeb, 0xd4, 0x23, 0x5e, 0x60, 0xda,
-	0xac, 0xeb, 0xb2, 0xce, 0xb2, 0x4c, 0x5b, 0x03, 0xa0, 0xfe, 0xdb, 0xae, 0xed, 0x25, 0xbb, 0xb6,
-	0xd3, 0x50, 0xb7, 0x30, 0xdd, 0x02, 0xc8, 0xd1, 0xc8, 0x7b, 0xf2, 0x91, 0x07, 0xa5, 0xec, 0x8e,
-	0xb0, 0x0a, 0x0e, 0x75, 0x75, 0x30, 0x32, 0x86, 0xe3, 0xbe, 0x62, 0x5c, 0x8c, 0xf4, 0x73, 0xa5,
-	0xa7, 0x3e, 0x57, 0x95, 0xbe, 0xc0, 0xc1, 0x32, 0x10, 0x36, 0xa9, 0xbe, 0xaa, 0x29, 0xbd, 0x89,
-	0xc0, 0xc3, 0x43, 0x70, 0x67, 0x13, 0x9d, 0x28, 0xaf, 0x26, 0x17, 0x9d, 0x33, 0x61, 0x0f, 0x3e,
-	0x04, 0xf7, 0x37, 0xe1, 0x33, 0x65, 0xd0, 0xe9, 0xbd, 0x36, 0x3a, 0x43, 0x75, 0x34, 0x36, 0x5e,
-	0xe8, 0xe3, 0x91, 0xf0, 0x01, 0xde, 0xdb, 0xae, 0x53, 0xd4, 0x73, 0xa3, 0x79, 0xdc, 0x14, 0xbe,
-	0xf0, 0xdd, 0xc1, 0xb7, 0xb9, 0xc4, 0xdf, 0xcc, 0x25, 0xfe, 0xc7, 0x5c, 0xe2, 0x3f, 0x2d, 0x24,
-	0xee, 0x66, 0x21, 0x71, 0xdf, 0x17, 0x12, 0xf7, 0xa6, 0xe1, 0xb8, 0xec, 0x6d, 0x64, 0x21, 0x9b,
-	0x78, 0x72, 0xf6, 0xb8, 0x93, 0x4f, 0x83, 0x4e, 0xaf, 0x64, 0x16, 0x07, 0x78, 0xfb, 0x8f, 0x61,
-	0x15, 0x93, 0xa7, 0xd1, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x72, 0xd3, 0x58, 0x4d, 0x04,
-	0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0x26, 0xa9, 0xda, 0x2b, 0x42, 0xe6, 0x48, 0x51, 0x62, 0x90, 0x89, 0xca, 0x40,
+	0x84, 0x94, 0xb3, 0x92, 0x0c, 0x55, 0xd9, 0xf2, 0xc7, 0xa4, 0xa6, 0x4d, 0x52, 0xec, 0x54, 0x02,
+	0x16, 0xcb, 0x76, 0xae, 0xc6, 0x6a, 0xec, 0x33, 0xbe, 0x33, 0xaa, 0x27, 0xbe, 0x02, 0xdf, 0x81,
+	0x89, 0x4f, 0xc1, 0xc0, 0xd2, 0xb1, 0x23, 0x23, 0x4a, 0x3e, 0x03, 0x3b, 0x8a, 0x1d, 0x27, 0x41,
+	0x14, 0x21, 0x32, 0x59, 0xf7, 0xdc, 0x73, 0xbf, 0xf7, 0x39, 0xbd, 0xef, 0x19, 0x3c, 0xb5, 0x09,
+	0xf5, 0x08, 0x95, 0xd9, 0x95, 0x4c, 0x5d, 0xc7, 0x77, 0x7d, 0x47, 0xfe, 0x50, 0xb7, 0x30, 0x33,
+	0xeb, 0xd9, 0x1a, 0x05, 0x21, 0x61, 0x04, 0x96, 0x53, 0x23, 0x62, 0x57, 0x28, 0xdb, 0x58, 0x18,
+	0xc5, 0xda, 0x82, 0x61, 0x87, 0x71, 0xc0, 0x88, 0xec, 0x45, 0x13, 0xe6, 0x52, 0x77, 0x05, 0xca,
+	0x84, 0x94, 0x24, 0x96, 0x1d, 0x42, 0x9c, 0x09, 0x96, 0x93, 0x95, 0x15, 0x5d, 0xc8, 0xa6, 0x1f,
+	0xa7, 0x5b, 0x07, 0x17, 0xa0, 0xa8, 0xbb, 0x8e, 0x6f, 0xb2, 0x28, 0xc4, 0x5d, 0x4c, 0xed, 0xd0,
+	0x0d, 0x18, 0x09, 0x29, 0x1c, 0x00, 0x40, 0x33, 0x9d, 0x96, 0xf8, 0x4a, 0xae, 0xba, 0xd7, 0x40,
+	0xe8, 0xaf, 0x89, 0xd0, 0x2d, 0x10, 0x6d, 0x8d, 0x70, 0xf0, 0x33, 0x0f, 0xee, 0xdf, 0xe2, 0x81,
+	0x4d, 0x00, 0x82, 0xc8, 0x9a, 0xb8, 0xb6, 0x71, 0x89, 0xe3, 0x12, 0x5f, 0xe1, 0xab, 0x7b, 0x8d,
+	0x22, 0x4a, 0xf3, 0xa2, 0x2c, 0x2f, 0x6a, 0xf9, 0xb1, 0xb6, 0x9b, 0xfa, 0x4e, 0x70, 0x0c, 0x7b,
+	0x20, 0x3f, 0x36, 0x99, 0x59, 0xda, 0x4a, 0xec, 0xcd, 0xff, 0x8b, 0x85, 0xba, 0x26, 0x33, 0xb5,
+	0x04, 0x00, 0x45, 0xb0, 0x43, 0xf1, 0xfb, 0x08, 0xfb, 0x36, 0x2e, 0xe5, 0x2a, 0x7c, 0x35, 0xaf,
+	0x2d, 0xd7, 0xe2, 0xb7, 0x1c, 0xc8, 0xcf, 0xad, 0x70, 0x04, 0xb6, 0xa9, 0xeb, 0x3b, 0x13, 0xbc,
+	0x88, 0xf7, 0x7c, 0x83, 0x7a, 0x48, 0x4f, 0x08, 0xc7, 0x9c, 0xb6, 0x60, 0xc1, 0x57, 0xa0, 0x90,
+	0x74, 0x69, 0x71, 0x89, 0xa3, 0x4d, 0xa0, 0xfd, 0x39, 0xe0, 0x98, 0xd3, 0x52, 0x92, 0x68, 0x80,
+	0xed, 0xb4, 0x0c, 0x3c, 0x04, 0x79, 0x8f, 0x8c, 0xd3, 0xc0, 0x77, 0x1b, 0x4f, 0xfe, 0xc1, 0xee,
+	0x93, 0x31, 0xd6, 0x92, 0x03, 0xf0, 0x11, 0xd8, 0x5d, 0x36, 0x2d, 0x49, 0x76, 0x47, 0x5b, 0x09,
+	0xe2, 0x17, 0x1e, 0x14, 0x92, 0x9a, 0xf0, 0x04, 0xec, 0x58, 0x2e, 0x33, 0xc3, 0xd0, 0xcc, 0x9a,
+	0x26, 0x67, 0x45, 0xd2, 0x99, 0x44, 0xcb, 0x11, 0xcc, 0x2a, 0x75, 0x88, 0x17, 0x98, 0x36, 0x6b,
+	0xbb, 0xac, 0x35, 0x3f, 0xa6, 0x2d, 0x01, 0x50, 0xff, 0x6d, 0xd6, 0xb6, 0x2a, 0xb9, 0x4d, 0x9b,
+	0xba, 0x86, 0x69, 0x17, 0x40, 0x8e, 0x46, 0xde, 0xb3, 0xcf, 0x3c, 0xd8, 0xc9, 0xee, 0x08, 0xcb,
+	0x60, 0x5f, 0x57, 0x7b, 0x03, 0xa3, 0x3f, 0xec, 0x2a, 0xc6, 0xf9, 0x40, 0x3f, 0x53, 0x3a, 0xea,
+	0x0b, 0x55, 0xe9, 0x0a, 0x1c, 0x2c, 0x02, 0x61, 0xb5, 0xd5, 0x55, 0x35, 0xa5, 0x33, 0x12, 0x78,
+	0xb8, 0x0f, 0xee, 0xad, 0xd4, 0x91, 0xf2, 0x7a, 0x74, 0xde, 0x3a, 0x15, 0xb6, 0xe0, 0x63, 0xf0,
+	0x70, 0x25, 0x9f, 0x2a, 0xbd, 0x56, 0xe7, 0x8d, 0xd1, 0xea, 0xab, 0x83, 0xa1, 0xf1, 0x52, 0x1f,
+	0x0e, 0x84, 0x8f, 0xf0, 0xc1, 0xfa, 0x39, 0x45, 0x3d, 0x33, 0xea, 0x47, 0x75, 0xe1, 0x2b, 0xff,
+	0xa7, 0x7e, 0x58, 0x6f, 0x08, 0xd7, 0x85, 0x76, 0xef, 0x7a, 0x2a, 0xf1, 0x37, 0x53, 0x89, 0xff,
+	0x31, 0x95, 0xf8, 0x4f, 0x33, 0x89, 0xbb, 0x99, 0x49, 0xdc, 0xf7, 0x99, 0xc4, 0xbd, 0xad, 0x39,
+	0x2e, 0x7b, 0x17, 0x59, 0xc8, 0x26, 0x9e, 0x9c, 0x3d, 0xfa, 0xe4, 0x53, 0xa3, 0xe3, 0x4b, 0x99,
+	0xc5, 0x01, 0x5e, 0xff, 0x93, 0x58, 0xdb, 0xc9, 0x93, 0x69, 0xfe, 0x1a, 0x00, 0x37, 0x1f, 0x91,
+	0xad, 0x65, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
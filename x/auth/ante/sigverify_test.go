@@ -3,9 +3,14 @@ package ante_test
 import (
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcececdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"golang.org/x/crypto/sha3"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -17,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -97,6 +103,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
+		{"PubKeyEthSecp256k1", args{sdk.NewInfiniteGasMeter(), nil, ethsecp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
@@ -297,6 +304,82 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSigVerification_EIP712() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	// signEthereum signs the EIP-712 digest of the sign bytes the way an
+	// Ethereum wallet does, as R || S || V
+	signEthereum := func(signBytes []byte) []byte {
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(signBytes)
+		btcecPriv, _ := btcec.PrivKeyFromBytes(priv.Bytes())
+		sig, err := btcececdsa.SignCompact(btcecPriv, hasher.Sum(nil), false)
+		suite.Require().NoError(err)
+		return append(sig[1:], sig[0])
+	}
+
+	testCases := []struct {
+		name      string
+		sign      func(signBytes []byte) []byte
+		shouldErr bool
+	}{
+		{"valid ethereum signature", signEthereum, false},
+		{"signature of the sha256 hash", func(signBytes []byte) []byte {
+			sig, err := priv.Sign(signBytes)
+			suite.Require().NoError(err)
+			return sig
+		}, true},
+		{"signature of other bytes", func(signBytes []byte) []byte {
+			return signEthereum([]byte("unrelated message"))
+		}, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			signerData := authsign.SignerData{
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+			}
+			signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+				signing.SignMode_SIGN_MODE_EIP_712, signerData, suite.txBuilder.GetTx())
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey: priv.PubKey(),
+				Data: &signing.SingleSignatureData{
+					SignMode:  signing.SignMode_SIGN_MODE_EIP_712,
+					Signature: tc.sign(signBytes),
+				},
+				Sequence: acc.GetSequence(),
+			}))
+
+			_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestSigIntegration() {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetSimulateCommand returns the tx simulate command.
+func GetSimulateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [file_path]",
+		Short: "Simulate a transaction and print the gas it consumes",
+		Long: strings.TrimSpace(`Simulate the execution of a transaction read from [file_path], signed or
+created with the --generate-only flag, and print the simulation result. If you supply a
+dash (-) argument in place of an input filename, the command reads from standard input.
+
+The gas info of the result breaks the gas consumption down by store: the number and size
+of the reads and writes of each store and the gas they consumed. The gas consumed outside
+of the stores, e.g. to verify the signatures, is only part of the total gas used.
+
+A transaction without signatures is simulated with empty signatures of its signers at the
+sequences of their accounts.
+
+$ <appd> tx simulate ./mytxn.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+				return errors.New("cannot simulate tx during offline mode")
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			if len(sigs) == 0 {
+				if err := setSimulationSignatures(clientCtx, txBuilder); err != nil {
+					return err
+				}
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := tx.NewServiceClient(clientCtx).Simulate(context.Background(), &tx.SimulateRequest{
+				TxBytes: txBytes,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(flags.FlagOffline, false, "Offline mode (does not allow any online functionality)")
+
+	return cmd
+}
+
+// setSimulationSignatures sets an empty signature of each signer of the
+// transaction, as the ante handler does not verify the signatures when
+// simulating it.
+func setSimulationSignatures(clientCtx client.Context, txBuilder client.TxBuilder) error {
+	signMode := clientCtx.TxConfig.SignModeHandler().DefaultMode()
+
+	var sigs []signing.SignatureV2
+	for _, signer := range txBuilder.GetTx().GetSigners() {
+		acc, err := clientCtx.AccountRetriever.GetAccount(clientCtx, signer)
+		if err != nil {
+			return err
+		}
+
+		// the account has no public key until its first transaction
+		var pk cryptotypes.PubKey = &secp256k1.PubKey{}
+		if acc.GetPubKey() != nil {
+			pk = acc.GetPubKey()
+		}
+
+		sigs = append(sigs, signing.SignatureV2{
+			PubKey:   pk,
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: acc.GetSequence(),
+		})
+	}
+
+	return txBuilder.SetSignatures(sigs...)
+}
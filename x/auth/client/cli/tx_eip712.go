@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// GetEIP712TypedDataCommand returns the command printing the EIP-712 typed data
+// of a transaction, to be signed by an Ethereum wallet with eth_signTypedData_v4.
+func GetEIP712TypedDataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712-typed-data [file]",
+		Short: "Print the EIP-712 typed data of a transaction generated offline",
+		Long: `Print the EIP-712 typed data signed with SIGN_MODE_EIP_712 by the first signer
+of a transaction generated offline, in the format of the eth_signTypedData_v4 JSON-RPC
+method of Ethereum wallets.
+
+The account number and sequence of the signer are queried unless the --offline flag is set,
+in which case they must be provided with the --account-number and --sequence flags.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeEIP712TypedDataCmd(),
+		Args:   cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeEIP712TypedDataCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		clientCtx, txFactory, stdTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		sigTx, ok := stdTx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return fmt.Errorf("invalid transaction type %T", stdTx)
+		}
+		signers := sigTx.GetSigners()
+		if len(signers) == 0 {
+			return fmt.Errorf("transaction has no signers")
+		}
+
+		accNum, seq := txFactory.AccountNumber(), txFactory.Sequence()
+		if !clientCtx.Offline {
+			accNum, seq, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, signers[0])
+			if err != nil {
+				return err
+			}
+		}
+
+		typedData, err := authtx.EIP712TypedData(authsigning.SignerData{
+			ChainID:       txFactory.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      seq,
+		}, stdTx)
+		if err != nil {
+			return err
+		}
+
+		bz, err := json.MarshalIndent(typedData, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+	}
+}
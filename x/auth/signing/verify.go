@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
@@ -21,18 +22,32 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 			return err
 		}
 
-		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_191 {
+		// eth_secp256k1 keys verify signatures of the Keccak256 hash of the sign
+		// bytes, which is the digest signed by Ethereum wallets in the EIP sign
+		// modes, so they do not need special casing
+		_, isEthSecp256k1 := pubKey.(*ethsecp256k1.PubKey)
+
+		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_191 && !isEthSecp256k1 {
 			// do this to not have to register a new type of pubkey like here:
 			// https://github.com/scrtlabs/cosmos-sdk/blob/07817ad365/crypto/keys/secp256k1/keys.pb.go#L120
 
 			secp256k1PubKey, ok := pubKey.(*secp256k1.PubKey)
 			if !ok {
-				return fmt.Errorf("eip191 sign mode requires pubkey to be of type cosmos.crypto.secp256k1.PubKey")
+				return fmt.Errorf("eip191 sign mode requires pubkey to be of type cosmos.crypto.secp256k1.PubKey or cosmos.crypto.ethsecp256k1.PubKey")
 			}
 
 			if !secp256k1PubKey.VerifySignatureEip191(signBytes, data.Signature) {
 				return fmt.Errorf("unable to verify single signer eip191 signature %s for signBytes %s", hex.EncodeToString(data.Signature), hex.EncodeToString(signBytes))
 			}
+		} else if data.SignMode == signing.SignMode_SIGN_MODE_EIP_712 && !isEthSecp256k1 {
+			secp256k1PubKey, ok := pubKey.(*secp256k1.PubKey)
+			if !ok {
+				return fmt.Errorf("eip712 sign mode requires pubkey to be of type cosmos.crypto.secp256k1.PubKey or cosmos.crypto.ethsecp256k1.PubKey")
+			}
+
+			if !secp256k1PubKey.VerifySignatureEip712(signBytes, data.Signature) {
+				return fmt.Errorf("unable to verify single signer eip712 signature %s for signBytes %s", hex.EncodeToString(data.Signature), hex.EncodeToString(signBytes))
+			}
 		} else if !pubKey.VerifySignature(signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature %s for signBytes %s", hex.EncodeToString(data.Signature), hex.EncodeToString(signBytes))
 		}
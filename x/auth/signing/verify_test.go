@@ -7,12 +7,14 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -95,6 +97,46 @@ func TestVerifySignature(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestVerifySignatureEthSecp256k1(t *testing.T) {
+	priv := ethsecp256k1.GenPrivKey()
+	pubKey := priv.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx := txBuilder.GetTx()
+
+	signerData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      0,
+	}
+	handler := txConfig.SignModeHandler()
+
+	for _, mode := range []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_EIP_191,
+		signingtypes.SignMode_SIGN_MODE_EIP_712,
+	} {
+		signBytes, err := handler.GetSignBytes(mode, signerData, tx)
+		require.NoError(t, err)
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+
+		sigData := &signingtypes.SingleSignatureData{SignMode: mode, Signature: sig}
+		require.NoError(t, signing.VerifySignature(pubKey, signerData, sigData, handler, tx), mode)
+
+		// the signature does not verify for other signer data
+		otherSignerData := signerData
+		otherSignerData.AccountNumber = 2
+		require.Error(t, signing.VerifySignature(pubKey, otherSignerData, sigData, handler, tx), mode)
+	}
+}
+
 // returns context and app with params set on account keeper
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app := simapp.Setup(isCheckTx)
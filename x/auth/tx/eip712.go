@@ -0,0 +1,422 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	// EIP712DomainName is the name of the EIP-712 domain of Cosmos SDK transactions.
+	EIP712DomainName = "Cosmos Web3"
+	// EIP712DomainVersion is the version of the EIP-712 domain of Cosmos SDK transactions.
+	EIP712DomainVersion = "1.0.0"
+	// EIP712TxType is the name of the EIP-712 primary type of Cosmos SDK transactions.
+	EIP712TxType = "Tx"
+)
+
+const eip712NonCriticalFieldsError = "protobuf transaction contains unknown non-critical fields. This is a transaction malleability issue and SIGN_MODE_EIP_712 cannot be used."
+
+var _ signing.SignModeHandler = signModeEIP712Handler{}
+
+// signModeEIP712Handler defines the SIGN_MODE_EIP_712 SignModeHandler. The
+// sign bytes are the EIP-712 encoding of the typed data built from the legacy
+// amino JSON sign doc, so that their Keccak256 hash is the digest signed by
+// Ethereum wallets.
+type signModeEIP712Handler struct{}
+
+func (s signModeEIP712Handler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_EIP_712
+}
+
+func (s signModeEIP712Handler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712}
+}
+
+func (s signModeEIP712Handler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP_712 {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_712, mode)
+	}
+
+	typedData, err := EIP712TypedData(data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// EIP712TypedData returns the EIP-712 typed data of a protobuf transaction, as
+// signed with SIGN_MODE_EIP_712. Its message is the legacy amino JSON sign doc
+// where each message of the msgs array is moved to its own msg<n> field, so
+// that transactions with different types of messages can be typed.
+func EIP712TypedData(data signing.SignerData, tx sdk.Tx) (TypedData, error) {
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return TypedData{}, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	if protoTx.txBodyHasUnknownNonCriticals {
+		return TypedData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, eip712NonCriticalFieldsError)
+	}
+
+	body := protoTx.tx.Body
+
+	if len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
+		return TypedData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SignMode_SIGN_MODE_EIP_712 does not support protobuf extension options.")
+	}
+
+	aminoJSONBz := legacytx.StdSignBytes(
+		data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTimeoutHeight(),
+		legacytx.StdFee{
+			Amount:  protoTx.GetFee(),
+			Gas:     protoTx.GetGas(),
+			Payer:   protoTx.tx.AuthInfo.Fee.Payer,
+			Granter: protoTx.tx.AuthInfo.Fee.Granter,
+		},
+		tx.GetMsgs(), protoTx.GetMemo(),
+	)
+
+	typedData, err := NewEIP712TypedData(aminoJSONBz)
+	if err != nil {
+		return TypedData{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "SignMode_SIGN_MODE_EIP_712 cannot type the amino json sign doc: %v", err)
+	}
+	return typedData, nil
+}
+
+// NewEIP712TypedData builds the EIP-712 typed data of a legacy amino JSON sign
+// doc. The struct types are inferred from the JSON values and named after their
+// path in the sign doc, e.g. the type of the fee amounts is named FeeAmount, or
+// after their amino type for amino JSON values, e.g. the type of a MsgSend is
+// named CosmosSdkMsgSend.
+func NewEIP712TypedData(signDoc []byte) (TypedData, error) {
+	decoder := json.NewDecoder(bytes.NewReader(signDoc))
+	decoder.UseNumber()
+
+	var message map[string]interface{}
+	if err := decoder.Decode(&message); err != nil {
+		return TypedData{}, err
+	}
+
+	msgs, ok := message["msgs"].([]interface{})
+	if !ok {
+		return TypedData{}, fmt.Errorf("sign doc has no msgs")
+	}
+	delete(message, "msgs")
+	for i, msg := range msgs {
+		message[fmt.Sprintf("msg%d", i+1)] = msg
+	}
+	message = numberAminoArrays(message).(map[string]interface{})
+
+	shape, err := inferShape(EIP712TxType, message)
+	if err != nil {
+		return TypedData{}, err
+	}
+
+	typedData := TypedData{
+		Types: map[string][]TypedDataField{
+			EIP712DomainType: {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+		},
+		PrimaryType: EIP712TxType,
+		Domain: map[string]interface{}{
+			"name":    EIP712DomainName,
+			"version": EIP712DomainVersion,
+		},
+		Message: shape.typedValue(message).(map[string]interface{}),
+	}
+	typedData.addType(shape)
+
+	return typedData, nil
+}
+
+// numberAminoArrays replaces the arrays of amino JSON values held by the
+// objects of a JSON value, such as the msgs of a MsgExec, with objects holding
+// each value in its own numbered field, as for the msgs of the sign doc, so
+// that they can hold values of different types.
+func numberAminoArrays(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			field = numberAminoArrays(field)
+			if items, ok := field.([]interface{}); ok && isAminoArray(items) {
+				numbered := make(map[string]interface{}, len(items))
+				for i, item := range items {
+					numbered[fmt.Sprintf("%s%d", strings.TrimSuffix(key, "s"), i+1)] = item
+				}
+				field = numbered
+			}
+			v[key] = field
+		}
+		return v
+
+	case []interface{}:
+		for i, item := range v {
+			v[i] = numberAminoArrays(item)
+		}
+		return v
+
+	default:
+		return value
+	}
+}
+
+func isAminoArray(items []interface{}) bool {
+	for _, item := range items {
+		if _, ok := aminoType(item); !ok {
+			return false
+		}
+	}
+	return len(items) > 0
+}
+
+// aminoType returns the amino type of an amino JSON value, i.e. an object
+// holding only a type name and a value.
+func aminoType(value interface{}) (string, bool) {
+	obj, ok := value.(map[string]interface{})
+	if !ok || len(obj) != 2 || obj["value"] == nil {
+		return "", false
+	}
+	typ, ok := obj["type"].(string)
+	return typ, ok
+}
+
+// typeShape is the shape of a JSON value, from which its EIP-712 type is
+// derived. The shapes of the items of an array are merged, so that they share
+// the type holding every field of the items.
+type typeShape struct {
+	// scalar is the EIP-712 type of strings, bools and numbers.
+	scalar string
+	// name and fields describe objects, whose null fields are left out.
+	name   string
+	fields map[string]*typeShape
+	// isArray and item describe arrays, item is nil for empty arrays.
+	isArray bool
+	item    *typeShape
+}
+
+// inferShape returns the shape of a JSON value, naming the objects it contains
+// after the given name.
+func inferShape(name string, value interface{}) (*typeShape, error) {
+	switch v := value.(type) {
+	case string:
+		return &typeShape{scalar: "string"}, nil
+
+	case bool:
+		return &typeShape{scalar: "bool"}, nil
+
+	case json.Number:
+		if _, err := v.Int64(); err != nil {
+			return nil, fmt.Errorf("unsupported number %s", v)
+		}
+		return &typeShape{scalar: "int64"}, nil
+
+	case map[string]interface{}:
+		if typ, ok := aminoType(v); ok {
+			name = typeNameSuffix(typ)
+		}
+		shape := &typeShape{name: name, fields: make(map[string]*typeShape, len(v))}
+		for key, field := range v {
+			if field == nil {
+				continue
+			}
+			fieldShape, err := inferShape(name+typeNameSuffix(key), field)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			shape.fields[key] = fieldShape
+		}
+		return shape, nil
+
+	case []interface{}:
+		shape := &typeShape{isArray: true}
+		for _, item := range v {
+			if item == nil {
+				return nil, fmt.Errorf("arrays with null items are not supported")
+			}
+			itemShape, err := inferShape(name, item)
+			if err != nil {
+				return nil, err
+			}
+			if shape.item, err = mergeShapes(shape.item, itemShape); err != nil {
+				return nil, fmt.Errorf("arrays with items of different types are not supported: %w", err)
+			}
+		}
+		return shape, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+}
+
+// mergeShapes returns the shape of the values of both shapes, whose objects
+// hold the fields of both.
+func mergeShapes(a, b *typeShape) (*typeShape, error) {
+	switch {
+	case a == nil:
+		return b, nil
+
+	case b == nil:
+		return a, nil
+
+	case a.isArray && b.isArray:
+		item, err := mergeShapes(a.item, b.item)
+		if err != nil {
+			return nil, err
+		}
+		return &typeShape{isArray: true, item: item}, nil
+
+	case a.fields != nil && b.fields != nil && a.name == b.name:
+		fields := make(map[string]*typeShape, len(a.fields))
+		for key, field := range a.fields {
+			fields[key] = field
+		}
+		for key, field := range b.fields {
+			merged, err := mergeShapes(fields[key], field)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			fields[key] = merged
+		}
+		return &typeShape{name: a.name, fields: fields}, nil
+
+	case a.scalar != "" && a.scalar == b.scalar:
+		return a, nil
+
+	default:
+		return nil, fmt.Errorf("%s and %s", a.typeName(), b.typeName())
+	}
+}
+
+// typeName returns the name of the shape as written in errors.
+func (s *typeShape) typeName() string {
+	switch {
+	case s.isArray && s.item == nil:
+		return "[]"
+	case s.isArray:
+		return s.item.typeName() + "[]"
+	case s.fields != nil:
+		return s.name
+	default:
+		return s.scalar
+	}
+}
+
+// addType registers the struct types of a shape and returns its EIP-712 type.
+// A struct type keeps the name of its shape unless another struct type was
+// already registered under that name, in which case it is numbered, e.g.
+// CosmosSdkMsgSend2.
+func (td *TypedData) addType(s *typeShape) string {
+	switch {
+	case s.isArray && s.item == nil:
+		// the item type of an empty array cannot be inferred, its encoding is
+		// the same whatever its type
+		return "string[]"
+
+	case s.isArray:
+		return td.addType(s.item) + "[]"
+
+	case s.fields != nil:
+		keys := make([]string, 0, len(s.fields))
+		for key := range s.fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		fields := make([]TypedDataField, len(keys))
+		for i, key := range keys {
+			fields[i] = TypedDataField{Name: key, Type: td.addType(s.fields[key])}
+		}
+
+		name := s.name
+		for i := 2; ; i++ {
+			existing, ok := td.Types[name]
+			if !ok || equalFields(existing, fields) {
+				break
+			}
+			name = fmt.Sprintf("%s%d", s.name, i)
+		}
+		td.Types[name] = fields
+		return name
+
+	default:
+		return s.scalar
+	}
+}
+
+// typedValue returns a JSON value of the shape with every field of its type,
+// the fields missing from the value being set to their zero value.
+func (s *typeShape) typedValue(value interface{}) interface{} {
+	switch {
+	case s.isArray:
+		items, _ := value.([]interface{})
+		typed := make([]interface{}, len(items))
+		for i, item := range items {
+			typed[i] = s.item.typedValue(item)
+		}
+		return typed
+
+	case s.fields != nil:
+		obj, _ := value.(map[string]interface{})
+		typed := make(map[string]interface{}, len(s.fields))
+		for key, field := range s.fields {
+			typed[key] = field.typedValue(obj[key])
+		}
+		return typed
+
+	case value != nil:
+		return value
+
+	case s.scalar == "bool":
+		return false
+
+	case s.scalar == "int64":
+		return json.Number("0")
+
+	default:
+		return ""
+	}
+}
+
+// typeNameSuffix turns a JSON key into a type name suffix, e.g. "from_address"
+// into "FromAddress".
+func typeNameSuffix(key string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range key {
+		switch {
+		case r == '_' || r == '-' || r == '.' || r == '/':
+			upper = true
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func equalFields(a, b []TypedDataField) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,347 @@
+package tx
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/require"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// mailTypedData is the example of the EIP-712 specification.
+// Ref: https://github.com/ethereum/EIPs/blob/master/assets/eip-712/Example.js
+const mailTypedData = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+func TestTypedDataMailVectors(t *testing.T) {
+	var td TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &td))
+
+	encodedType, err := td.EncodeType("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodedType)
+
+	typeHash, err := td.TypeHash("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", hex.EncodeToString(typeHash))
+
+	domainSeparator, err := td.HashStruct(EIP712DomainType, td.Domain)
+	require.NoError(t, err)
+	require.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	messageHash, err := td.HashStruct("Mail", td.Message)
+	require.NoError(t, err)
+	require.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	signBytes, err := td.SignBytes()
+	require.NoError(t, err)
+	digest := keccak256(signBytes)
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(digest))
+
+	// the signature of the specification is reproduced by signing the digest
+	// with the private key keccak256("cow")
+	privKey, _ := btcec.PrivKeyFromBytes(keccak256([]byte("cow")))
+	sig, err := ecdsa.SignCompact(privKey, digest, false)
+	require.NoError(t, err)
+	require.Equal(t, byte(28), sig[0])
+	require.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d", hex.EncodeToString(sig[1:33]))
+	require.Equal(t, "07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562", hex.EncodeToString(sig[33:]))
+
+	// the Ethereum address of the signer is the wallet of the sender
+	pubKey := privKey.PubKey().SerializeUncompressed()
+	require.Equal(t, "cd2a3d9f938e13cd947ec05abc7fe734df8dd826", hex.EncodeToString(keccak256(pubKey[1:])[12:]))
+}
+
+func TestTypedDataErrors(t *testing.T) {
+	var td TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &td))
+
+	_, err := td.EncodeType("Unknown")
+	require.Error(t, err)
+
+	// extra fields are not hashed
+	_, err = td.HashStruct("Person", map[string]interface{}{
+		"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", "age": "3",
+	})
+	require.Error(t, err)
+
+	// invalid address
+	_, err = td.HashStruct("Person", map[string]interface{}{"name": "Cow", "wallet": "0xCD2a"})
+	require.Error(t, err)
+
+	// missing field
+	_, err = td.HashStruct("Mail", map[string]interface{}{"contents": "Hello, Bob!"})
+	require.Error(t, err)
+}
+
+func TestTypedDataIntegers(t *testing.T) {
+	td := TypedData{Types: map[string][]TypedDataField{}}
+
+	encoded, err := td.encodeValue("int64", json.Number("-1"))
+	require.NoError(t, err)
+	require.Equal(t, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", hex.EncodeToString(encoded))
+
+	encoded, err = td.encodeValue("uint256", "0x10")
+	require.NoError(t, err)
+	require.Equal(t, byte(16), encoded[31])
+
+	_, err = td.encodeValue("uint64", json.Number("-1"))
+	require.Error(t, err)
+	_, err = td.encodeValue("int64", json.Number("1.5"))
+	require.Error(t, err)
+}
+
+func TestEIP712Handler_GetSignBytes(t *testing.T) {
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1234567)))
+	refund := banktypes.NewMsgSend(addr2, addr1, sdk.NewCoins())
+
+	bldr := newBuilder()
+	bldr.SetFeeAmount(coins)
+	bldr.SetGasLimit(gas)
+	bldr.SetMemo(memo)
+	bldr.SetTimeoutHeight(timeout)
+	require.NoError(t, bldr.SetMsgs(send, refund))
+	tx := bldr.GetTx()
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+	}
+
+	typedData, err := EIP712TypedData(signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, EIP712TxType, typedData.PrimaryType)
+	require.Equal(t, map[string]interface{}{"name": EIP712DomainName, "version": EIP712DomainVersion}, typedData.Domain)
+	require.Equal(t, []TypedDataField{
+		{Name: "account_number", Type: "string"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: "TxFee"},
+		{Name: "memo", Type: "string"},
+		{Name: "msg1", Type: "CosmosSdkMsgSend"},
+		{Name: "msg2", Type: "CosmosSdkMsgSend2"},
+		{Name: "sequence", Type: "string"},
+		{Name: "timeout_height", Type: "string"},
+	}, typedData.Types[EIP712TxType])
+	require.Equal(t, []TypedDataField{
+		{Name: "amount", Type: "TxFeeAmount[]"},
+		{Name: "gas", Type: "string"},
+	}, typedData.Types["TxFee"])
+	require.Equal(t, []TypedDataField{
+		{Name: "type", Type: "string"},
+		{Name: "value", Type: "CosmosSdkMsgSendValue"},
+	}, typedData.Types["CosmosSdkMsgSend"])
+	require.Equal(t, []TypedDataField{
+		{Name: "amount", Type: "CosmosSdkMsgSendValueAmount[]"},
+		{Name: "from_address", Type: "string"},
+		{Name: "to_address", Type: "string"},
+	}, typedData.Types["CosmosSdkMsgSendValue"])
+	// the item type of empty arrays cannot be inferred, so that the types of
+	// both msgs differ
+	require.Equal(t, []TypedDataField{
+		{Name: "type", Type: "string"},
+		{Name: "value", Type: "CosmosSdkMsgSendValue2"},
+	}, typedData.Types["CosmosSdkMsgSend2"])
+	require.Equal(t, []TypedDataField{
+		{Name: "amount", Type: "string[]"},
+		{Name: "from_address", Type: "string"},
+		{Name: "to_address", Type: "string"},
+	}, typedData.Types["CosmosSdkMsgSendValue2"])
+
+	// the typed data round-trips through its eth_signTypedData_v4 JSON encoding
+	bz, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	var decoded TypedData
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	expectedSignBz, err := decoded.SignBytes()
+	require.NoError(t, err)
+
+	handler := signModeEIP712Handler{}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBz, signBz)
+	require.Equal(t, []byte("\x19\x01"), signBz[:2])
+	require.Len(t, signBz, 66)
+
+	// the sign bytes depend on the signer data
+	signingData.Sequence = 4
+	otherSignBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherSignBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with extension options
+	any, err := cdctypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	bldr.tx.Body.ExtensionOptions = []*cdctypes.Any{any}
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, bldr.GetTx())
+	require.Error(t, err)
+}
+
+func TestEIP712Handler_GetSignBytesMsgExec(t *testing.T) {
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	delegate := stakingtypes.NewMsgDelegate(addr1, sdk.ValAddress(addr2), sdk.NewInt64Coin("stake", 20))
+	exec := authz.NewMsgExec(addr2, []sdk.Msg{send, delegate})
+
+	bldr := newBuilder()
+	bldr.SetFeeAmount(coins)
+	bldr.SetGasLimit(gas)
+	require.NoError(t, bldr.SetMsgs(&exec))
+	tx := bldr.GetTx()
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+	}
+
+	// the msgs of different types are each typed in their own field
+	typedData, err := EIP712TypedData(signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, []TypedDataField{
+		{Name: "grantee", Type: "string"},
+		{Name: "msgs", Type: "CosmosSdkMsgExecValueMsgs"},
+	}, typedData.Types["CosmosSdkMsgExecValue"])
+	require.Equal(t, []TypedDataField{
+		{Name: "msg1", Type: "CosmosSdkMsgSend"},
+		{Name: "msg2", Type: "CosmosSdkMsgDelegate"},
+	}, typedData.Types["CosmosSdkMsgExecValueMsgs"])
+	require.Equal(t, []TypedDataField{
+		{Name: "amount", Type: "CosmosSdkMsgDelegateValueAmount"},
+		{Name: "delegator_address", Type: "string"},
+		{Name: "validator_address", Type: "string"},
+	}, typedData.Types["CosmosSdkMsgDelegateValue"])
+
+	handler := signModeEIP712Handler{}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, tx)
+	require.NoError(t, err)
+	require.Len(t, signBz, 66)
+
+	// the sign bytes depend on the wrapped msgs
+	exec = authz.NewMsgExec(addr2, []sdk.Msg{delegate, send})
+	require.NoError(t, bldr.SetMsgs(&exec))
+	otherSignBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, bldr.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherSignBz)
+}
+
+func TestNewEIP712TypedDataArrays(t *testing.T) {
+	// the items of an array share the type holding all their fields, the
+	// fields missing from an item being set to their zero value
+	signDoc := `{"chain_id":"test-chain","msgs":[{"type":"test/MsgOutputs","value":{"outputs":[` +
+		`{"address":"addr1","coins":[{"amount":"1","denom":"stake"}]},` +
+		`{"address":"addr2","coins":[],"memo":"hello","locked":true}]}}]}`
+	typedData, err := NewEIP712TypedData([]byte(signDoc))
+	require.NoError(t, err)
+	require.Equal(t, []TypedDataField{
+		{Name: "address", Type: "string"},
+		{Name: "coins", Type: "TestMsgOutputsValueOutputsCoins[]"},
+		{Name: "locked", Type: "bool"},
+		{Name: "memo", Type: "string"},
+	}, typedData.Types["TestMsgOutputsValueOutputs"])
+	outputs := typedData.Message["msg1"].(map[string]interface{})["value"].(map[string]interface{})["outputs"].([]interface{})
+	require.Equal(t, map[string]interface{}{
+		"address": "addr1",
+		"coins":   []interface{}{map[string]interface{}{"amount": "1", "denom": "stake"}},
+		"locked":  false,
+		"memo":    "",
+	}, outputs[0])
+	_, err = typedData.SignBytes()
+	require.NoError(t, err)
+
+	// items of different types are rejected
+	_, err = NewEIP712TypedData([]byte(`{"chain_id":"test-chain","msgs":[],"values":["a",true]}`))
+	require.Error(t, err)
+}
+
+func TestEIP712SignatureVerification(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	send := banktypes.NewMsgSend(sdk.AccAddress(pubKey.Address()), addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	bldr := newBuilder()
+	bldr.SetFeeAmount(coins)
+	bldr.SetGasLimit(gas)
+	require.NoError(t, bldr.SetMsgs(send))
+	tx := bldr.GetTx()
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      0,
+	}
+	handler := signModeEIP712Handler{}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, tx)
+	require.NoError(t, err)
+
+	// sign the digest the way an Ethereum wallet does, as R || S || V
+	btcecPrivKey, _ := btcec.PrivKeyFromBytes(privKey.Key)
+	compactSig, err := ecdsa.SignCompact(btcecPrivKey, keccak256(signBz), false)
+	require.NoError(t, err)
+	ethSig := append(compactSig[1:], compactSig[0])
+
+	verify := func(sig []byte) error {
+		sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_EIP_712, Signature: sig}
+		return signing.VerifySignature(pubKey, signingData, sigData, handler, tx)
+	}
+
+	require.NoError(t, verify(ethSig))
+	// the recovery id may be 0 or 1
+	require.NoError(t, verify(append(append([]byte{}, compactSig[1:]...), compactSig[0]-27)))
+	// or omitted
+	require.NoError(t, verify(compactSig[1:]))
+
+	// invalid recovery id
+	require.Error(t, verify(append(append([]byte{}, compactSig[1:]...), 2)))
+	// SIGN_MODE_DIRECT signature
+	directSig, err := privKey.Sign(signBz)
+	require.NoError(t, err)
+	require.Error(t, verify(directSig))
+	// signature of other sign bytes
+	signingData.Sequence = 1
+	require.Error(t, verify(ethSig))
+}
@@ -12,10 +12,13 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 	signingtypes.SignMode_SIGN_MODE_EIP_191,
+	signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+	signingtypes.SignMode_SIGN_MODE_EIP_712,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON, and SIGN_MODE_SIGN_MODE_EIP_191.
+// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON, SIGN_MODE_SIGN_MODE_EIP_191,
+// SIGN_MODE_TEXTUAL and SIGN_MODE_EIP_712.
 func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
@@ -31,6 +34,10 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeLegacyAminoJSONHandler{}
 		case signingtypes.SignMode_SIGN_MODE_EIP_191:
 			handlers[i] = signModeEIP191Handler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			handlers[i] = signModeTextualHandler{}
+		case signingtypes.SignMode_SIGN_MODE_EIP_712:
+			handlers[i] = signModeEIP712Handler{}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -0,0 +1,295 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ signing.SignModeHandler = signModeTextualHandler{}
+
+// Screen is a single line of the human-readable representation of a
+// transaction signed with SIGN_MODE_TEXTUAL. Expert screens are only shown by
+// signing devices in expert mode.
+type Screen struct {
+	Text   string `json:"text"`
+	Indent int    `json:"indent,omitempty"`
+	Expert bool   `json:"expert,omitempty"`
+}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. The
+// sign bytes are the JSON encoding of the screens rendering the transaction.
+type signModeTextualHandler struct{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	screens, err := TextualScreens(data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(screens)
+}
+
+// TextualScreens renders a protobuf transaction into the screens signed with
+// SIGN_MODE_TEXTUAL. The last screen holds the hash of the SIGN_MODE_DIRECT
+// sign bytes, so that the signature also covers the parts of the transaction
+// which are not rendered, such as extension options.
+func TextualScreens(data signing.SignerData, tx sdk.Tx) ([]Screen, error) {
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens := []Screen{
+		{Text: fmt.Sprintf("Chain id: %s", data.ChainID)},
+		{Text: fmt.Sprintf("Account number: %d", data.AccountNumber)},
+		{Text: fmt.Sprintf("Sequence: %d", data.Sequence)},
+	}
+
+	msgs := protoTx.GetMsgs()
+	if len(msgs) == 1 {
+		screens = append(screens, Screen{Text: "This transaction has 1 Message"})
+	} else {
+		screens = append(screens, Screen{Text: fmt.Sprintf("This transaction has %d Messages", len(msgs))})
+	}
+	for i, msg := range msgs {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Message (%d/%d): %s", i+1, len(msgs), sdk.MsgTypeURL(msg))})
+		msgScreens, err := renderMessage(reflect.ValueOf(msg), 1)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, msgScreens...)
+	}
+	screens = append(screens, Screen{Text: "End of Messages"})
+
+	if memo := protoTx.GetMemo(); memo != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Memo: %s", memo)})
+	}
+
+	fees, err := formatCoins(protoTx.GetFee())
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, Screen{Text: fmt.Sprintf("Fees: %s", fees)})
+
+	if fee := protoTx.tx.AuthInfo.Fee; fee != nil {
+		if fee.Payer != "" {
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fee payer: %s", fee.Payer)})
+		}
+		if fee.Granter != "" {
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fee granter: %s", fee.Granter)})
+		}
+	}
+
+	gas, err := FormatInteger(strconv.FormatUint(protoTx.GetGas(), 10))
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, Screen{Text: fmt.Sprintf("Gas limit: %s", gas), Expert: true})
+
+	if timeoutHeight := protoTx.GetTimeoutHeight(); timeoutHeight != 0 {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Timeout height: %d", timeoutHeight), Expert: true})
+	}
+
+	signDoc, err := DirectSignBytes(protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), data.ChainID, data.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(signDoc)
+	screens = append(screens, Screen{Text: fmt.Sprintf("Hash of raw bytes: %s", hex.EncodeToString(hash[:])), Expert: true})
+
+	return screens, nil
+}
+
+// renderMessage renders the non-empty protobuf fields of a message, one screen
+// per scalar value, nesting the fields of embedded messages.
+func renderMessage(v reflect.Value, indent int) ([]Screen, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render %s as a message", v.Type())
+	}
+
+	var screens []Screen
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		value := v.Field(i)
+
+		// oneof fields hold a wrapper struct with a single protobuf field
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			if value.IsNil() {
+				continue
+			}
+			wrapper := value.Elem().Elem()
+			field = wrapper.Type().Field(0)
+			value = wrapper.Field(0)
+		}
+
+		tag, ok := field.Tag.Lookup("protobuf")
+		if !ok || value.IsZero() {
+			continue
+		}
+
+		fieldScreens, err := renderField(protobufFieldTitle(tag, field.Name), value, indent)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+	return screens, nil
+}
+
+// renderField renders a single message field, using the registered value
+// renderer of its type when there is one.
+func renderField(title string, v reflect.Value, indent int) ([]Screen, error) {
+	if renderer, ok := getValueRenderer(v.Type()); ok {
+		text, err := renderer(v)
+		if err != nil {
+			return nil, err
+		}
+		return []Screen{{Text: fmt.Sprintf("%s: %s", title, text), Indent: indent}}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if any, ok := v.Interface().(*codectypes.Any); ok {
+			return renderAny(title, any, indent)
+		}
+		return renderField(title, v.Elem(), indent)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return renderField(title, v.Elem(), indent)
+
+	case reflect.Struct:
+		screens := []Screen{{Text: fmt.Sprintf("%s:", title), Indent: indent}}
+		nested, err := renderMessage(v, indent+1)
+		if err != nil {
+			return nil, err
+		}
+		return append(screens, nested...), nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return []Screen{{Text: fmt.Sprintf("%s: %s", title, strings.ToUpper(hex.EncodeToString(v.Bytes()))), Indent: indent}}, nil
+		}
+
+		var screens []Screen
+		for i := 0; i < v.Len(); i++ {
+			elemScreens, err := renderField(fmt.Sprintf("%s (%d/%d)", title, i+1, v.Len()), v.Index(i), indent)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+		return screens, nil
+
+	case reflect.String:
+		return []Screen{{Text: fmt.Sprintf("%s: %s", title, v.String()), Indent: indent}}, nil
+
+	case reflect.Bool:
+		text := "False"
+		if v.Bool() {
+			text = "True"
+		}
+		return []Screen{{Text: fmt.Sprintf("%s: %s", title, text), Indent: indent}}, nil
+
+	case reflect.Int32:
+		// protobuf enums are rendered by name
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			return []Screen{{Text: fmt.Sprintf("%s: %s", title, stringer.String()), Indent: indent}}, nil
+		}
+		return renderInteger(title, strconv.FormatInt(v.Int(), 10), indent)
+
+	case reflect.Int, reflect.Int64:
+		return renderInteger(title, strconv.FormatInt(v.Int(), 10), indent)
+
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return renderInteger(title, strconv.FormatUint(v.Uint(), 10), indent)
+
+	case reflect.Float32, reflect.Float64:
+		return []Screen{{Text: fmt.Sprintf("%s: %s", title, strconv.FormatFloat(v.Float(), 'f', -1, 64)), Indent: indent}}, nil
+
+	default:
+		return nil, fmt.Errorf("cannot render %s field of type %s", title, v.Type())
+	}
+}
+
+func renderInteger(title string, v string, indent int) ([]Screen, error) {
+	text, err := FormatInteger(v)
+	if err != nil {
+		return nil, err
+	}
+	return []Screen{{Text: fmt.Sprintf("%s: %s", title, text), Indent: indent}}, nil
+}
+
+// renderAny renders the message packed in an Any, or its raw bytes when it has
+// not been unpacked.
+func renderAny(title string, any *codectypes.Any, indent int) ([]Screen, error) {
+	screens := []Screen{{Text: fmt.Sprintf("%s: %s", title, any.TypeUrl), Indent: indent}}
+
+	cached := any.GetCachedValue()
+	if cached == nil {
+		return append(screens, Screen{
+			Text:   fmt.Sprintf("Value: %s", strings.ToUpper(hex.EncodeToString(any.Value))),
+			Indent: indent + 1,
+			Expert: true,
+		}), nil
+	}
+
+	nested, err := renderMessage(reflect.ValueOf(cached), indent+1)
+	if err != nil {
+		return nil, err
+	}
+	return append(screens, nested...), nil
+}
+
+// protobufFieldTitle turns the protobuf name of a field into its title, e.g.
+// "from_address" into "From address".
+func protobufFieldTitle(tag string, fallback string) string {
+	name := fallback
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			name = strings.TrimPrefix(part, "name=")
+			break
+		}
+	}
+
+	title := []rune(strings.ReplaceAll(name, "_", " "))
+	title[0] = unicode.ToUpper(title[0])
+	return string(title)
+}
@@ -0,0 +1,172 @@
+package tx
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValueRenderer formats a value into the text of a single SIGN_MODE_TEXTUAL
+// screen.
+type ValueRenderer func(v reflect.Value) (string, error)
+
+var (
+	valueRenderersMtx sync.RWMutex
+	valueRenderers    = map[reflect.Type]ValueRenderer{}
+)
+
+// RegisterValueRenderer registers the ValueRenderer used by SIGN_MODE_TEXTUAL
+// to format values of the same type as value, overriding any renderer
+// previously registered for that type. Values of types without a registered
+// renderer are rendered field by field.
+func RegisterValueRenderer(value interface{}, renderer ValueRenderer) {
+	valueRenderersMtx.Lock()
+	defer valueRenderersMtx.Unlock()
+
+	valueRenderers[reflect.TypeOf(value)] = renderer
+}
+
+func getValueRenderer(typ reflect.Type) (ValueRenderer, bool) {
+	valueRenderersMtx.RLock()
+	defer valueRenderersMtx.RUnlock()
+
+	renderer, ok := valueRenderers[typ]
+	return renderer, ok
+}
+
+func init() {
+	RegisterValueRenderer(sdk.Int{}, func(v reflect.Value) (string, error) {
+		return FormatInteger(v.Interface().(sdk.Int).String())
+	})
+	RegisterValueRenderer(sdk.Dec{}, func(v reflect.Value) (string, error) {
+		return FormatDecimal(v.Interface().(sdk.Dec).String())
+	})
+	RegisterValueRenderer(sdk.Coin{}, func(v reflect.Value) (string, error) {
+		coin := v.Interface().(sdk.Coin)
+		return formatCoin(coin.Amount.String(), coin.Denom, FormatInteger)
+	})
+	RegisterValueRenderer(sdk.Coins{}, func(v reflect.Value) (string, error) {
+		return formatCoins(v.Interface().(sdk.Coins))
+	})
+	RegisterValueRenderer([]sdk.Coin{}, func(v reflect.Value) (string, error) {
+		return formatCoins(sdk.Coins(v.Interface().([]sdk.Coin)))
+	})
+	RegisterValueRenderer(sdk.DecCoin{}, func(v reflect.Value) (string, error) {
+		coin := v.Interface().(sdk.DecCoin)
+		return formatCoin(coin.Amount.String(), coin.Denom, FormatDecimal)
+	})
+	RegisterValueRenderer(sdk.DecCoins{}, func(v reflect.Value) (string, error) {
+		return formatDecCoins(v.Interface().(sdk.DecCoins))
+	})
+	RegisterValueRenderer([]sdk.DecCoin{}, func(v reflect.Value) (string, error) {
+		return formatDecCoins(sdk.DecCoins(v.Interface().([]sdk.DecCoin)))
+	})
+	RegisterValueRenderer(time.Time{}, func(v reflect.Value) (string, error) {
+		return v.Interface().(time.Time).UTC().Format(time.RFC3339Nano), nil
+	})
+	RegisterValueRenderer(time.Duration(0), func(v reflect.Value) (string, error) {
+		return v.Interface().(time.Duration).String(), nil
+	})
+	RegisterValueRenderer(sdk.AccAddress{}, func(v reflect.Value) (string, error) {
+		return v.Interface().(sdk.AccAddress).String(), nil
+	})
+	RegisterValueRenderer(sdk.ValAddress{}, func(v reflect.Value) (string, error) {
+		return v.Interface().(sdk.ValAddress).String(), nil
+	})
+	RegisterValueRenderer(sdk.ConsAddress{}, func(v reflect.Value) (string, error) {
+		return v.Interface().(sdk.ConsAddress).String(), nil
+	})
+}
+
+// FormatInteger formats an integer string with a thousands separator, e.g.
+// "1234567" is formatted as "1'234'567".
+func FormatInteger(v string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+	if len(v) == 0 || strings.Trim(v, "0123456789") != "" {
+		return "", fmt.Errorf("invalid integer %q", v)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	for i, digit := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(digit)
+	}
+	return sb.String(), nil
+}
+
+// FormatDecimal formats a decimal string with a thousands separator in its
+// integral part and without trailing zeros in its fractional part, e.g.
+// "1234.500000000000000000" is formatted as "1'234.5".
+func FormatDecimal(v string) (string, error) {
+	parts := strings.Split(v, ".")
+	if len(parts) > 2 {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	integral, err := FormatInteger(parts[0])
+	if err != nil {
+		return "", err
+	}
+	if len(parts) == 1 {
+		return integral, nil
+	}
+
+	fractional := strings.TrimRight(parts[1], "0")
+	if strings.Trim(fractional, "0123456789") != "" {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+	if fractional == "" {
+		return integral, nil
+	}
+	return integral + "." + fractional, nil
+}
+
+func formatCoin(amount, denom string, format func(string) (string, error)) (string, error) {
+	formatted, err := format(amount)
+	if err != nil {
+		return "", err
+	}
+	return formatted + " " + denom, nil
+}
+
+func formatCoins(coins sdk.Coins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		s, err := formatCoin(coin.Amount.String(), coin.Denom, FormatInteger)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+	return strings.Join(formatted, ", "), nil
+}
+
+func formatDecCoins(coins sdk.DecCoins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		s, err := formatCoin(coin.Amount.String(), coin.Denom, FormatDecimal)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+	return strings.Join(formatted, ", "), nil
+}
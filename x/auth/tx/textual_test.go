@@ -0,0 +1,171 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualHandler_GetSignBytes(t *testing.T) {
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1234567), sdk.NewInt64Coin("uatom", 10)))
+
+	bldr := newBuilder()
+	bldr.SetFeeAmount(coins)
+	bldr.SetGasLimit(gas)
+	bldr.SetMemo(memo)
+	bldr.SetTimeoutHeight(timeout)
+	bldr.SetFeeGranter(addr2)
+	require.NoError(t, bldr.SetMsgs(send))
+	tx := bldr.GetTx()
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+	}
+
+	signDoc, err := DirectSignBytes(bldr.getBodyBytes(), bldr.getAuthInfoBytes(), signingData.ChainID, signingData.AccountNumber)
+	require.NoError(t, err)
+	hash := sha256.Sum256(signDoc)
+
+	expectedScreens := []Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Account number: 7"},
+		{Text: "Sequence: 3"},
+		{Text: "This transaction has 1 Message"},
+		{Text: "Message (1/1): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: "From address: " + addr1.String(), Indent: 1},
+		{Text: "To address: " + addr2.String(), Indent: 1},
+		{Text: "Amount: 1'234'567 stake, 10 uatom", Indent: 1},
+		{Text: "End of Messages"},
+		{Text: "Memo: foo"},
+		{Text: "Fees: 10 foocoin"},
+		{Text: "Fee granter: " + addr2.String()},
+		{Text: "Gas limit: 10'000", Expert: true},
+		{Text: "Timeout height: 10", Expert: true},
+		{Text: "Hash of raw bytes: " + hex.EncodeToString(hash[:]), Expert: true},
+	}
+
+	screens, err := TextualScreens(signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, expectedScreens, screens)
+
+	handler := signModeTextualHandler{}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+	expectedSignBz, err := json.Marshal(expectedScreens)
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBz, signBz)
+
+	// the sign bytes depend on the signer data
+	signingData.Sequence = 4
+	otherSignBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherSignBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with a non-protobuf tx
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, nil)
+	require.Error(t, err)
+}
+
+func TestTextualHandler_NestedMessages(t *testing.T) {
+	expiration := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	grant, err := authz.NewMsgGrant(addr1, addr2, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))), expiration)
+	require.NoError(t, err)
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+
+	bldr := newBuilder()
+	bldr.SetFeeAmount(coins)
+	bldr.SetGasLimit(gas)
+	require.NoError(t, bldr.SetMsgs(grant, send))
+
+	screens, err := TextualScreens(signing.SignerData{ChainID: "test-chain"}, bldr.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, []Screen{
+		{Text: "This transaction has 2 Messages"},
+		{Text: "Message (1/2): /cosmos.authz.v1beta1.MsgGrant"},
+		{Text: "Granter: " + addr1.String(), Indent: 1},
+		{Text: "Grantee: " + addr2.String(), Indent: 1},
+		{Text: "Grant:", Indent: 1},
+		{Text: "Authorization: /cosmos.bank.v1beta1.SendAuthorization", Indent: 2},
+		{Text: "Spend limit: 1'000 stake", Indent: 3},
+		{Text: "Expiration: 2022-01-02T03:04:05Z", Indent: 2},
+		{Text: "Message (2/2): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: "From address: " + addr1.String(), Indent: 1},
+		{Text: "To address: " + addr2.String(), Indent: 1},
+		{Text: "Amount: 5 stake", Indent: 1},
+		{Text: "End of Messages"},
+	}, screens[3:16])
+}
+
+func TestTextualHandler_UnpackedAny(t *testing.T) {
+	any := &cdctypes.Any{TypeUrl: "/foo.Bar", Value: []byte{0xca, 0xfe}}
+	screens, err := renderAny("Value", any, 1)
+	require.NoError(t, err)
+	require.Equal(t, []Screen{
+		{Text: "Value: /foo.Bar", Indent: 1},
+		{Text: "Value: CAFE", Indent: 2, Expert: true},
+	}, screens)
+}
+
+func TestTextualValueRenderers(t *testing.T) {
+	testCases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{sdk.NewInt(0), "0"},
+		{sdk.NewInt(999), "999"},
+		{sdk.NewInt(1000), "1'000"},
+		{sdk.NewInt(-1234567), "-1'234'567"},
+		{sdk.NewDec(1234), "1'234"},
+		{sdk.NewDecWithPrec(12345, 3), "12.345"},
+		{sdk.NewDecWithPrec(-5, 1), "-0.5"},
+		{sdk.NewInt64Coin("stake", 1000000), "1'000'000 stake"},
+		{sdk.Coins{}, "zero"},
+		{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1)), "1.5 stake"},
+		{time.Date(2022, 1, 2, 3, 4, 5, 600, time.FixedZone("CET", 3600)), "2022-01-02T02:04:05.0000006Z"},
+		{90 * time.Minute, "1h30m0s"},
+		{addr1, addr1.String()},
+		{sdk.ValAddress(addr1), sdk.ValAddress(addr1).String()},
+	}
+
+	for _, tc := range testCases {
+		screens, err := renderField("Value", reflect.ValueOf(tc.value), 0)
+		require.NoError(t, err)
+		require.Equal(t, []Screen{{Text: "Value: " + tc.expected}}, screens)
+	}
+
+	// registered renderers take precedence over the default rendering
+	type custom struct {
+		Value string `protobuf:"bytes,1,opt,name=value,proto3"`
+	}
+	RegisterValueRenderer(custom{}, func(v reflect.Value) (string, error) {
+		return "custom " + v.Interface().(custom).Value, nil
+	})
+	screens, err := renderField("Value", reflect.ValueOf(custom{Value: "foo"}), 0)
+	require.NoError(t, err)
+	require.Equal(t, []Screen{{Text: "Value: custom foo"}}, screens)
+}
+
+func TestFormatDecimal(t *testing.T) {
+	for _, v := range []string{"", "1.2.3", "1.x", "a"} {
+		_, err := FormatDecimal(v)
+		require.Error(t, err, v)
+	}
+}
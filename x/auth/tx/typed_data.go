@@ -0,0 +1,292 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// EIP712DomainType is the name of the type of the EIP-712 domain.
+const EIP712DomainType = "EIP712Domain"
+
+// TypedDataField is a field of an EIP-712 struct type.
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is the EIP-712 typed structured data signed by Ethereum wallets,
+// in the format of the eth_signTypedData_v4 JSON-RPC method.
+// Ref: https://eips.ethereum.org/EIPS/eip-712
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+var arrayTypeRegexp = regexp.MustCompile(`^(.+)\[\d*\]$`)
+
+// SignBytes returns the EIP-712 encoding of the typed data, whose Keccak256
+// hash is the digest signed by Ethereum wallets:
+// "\x19\x01" ‖ domainSeparator ‖ hashStruct(message).
+func (td TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.HashStruct(EIP712DomainType, td.Domain)
+	if err != nil {
+		return nil, err
+	}
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := append([]byte("\x19\x01"), domainSeparator...)
+	return append(bz, messageHash...), nil
+}
+
+// HashStruct returns hashStruct(s) = keccak256(typeHash ‖ encodeData(s)).
+func (td TypedData) HashStruct(primaryType string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := td.EncodeData(primaryType, data)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(encoded), nil
+}
+
+// TypeHash returns keccak256(encodeType(primaryType)).
+func (td TypedData) TypeHash(primaryType string) ([]byte, error) {
+	encodedType, err := td.EncodeType(primaryType)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256([]byte(encodedType)), nil
+}
+
+// EncodeType returns the encoding of a struct type followed by the encoding of
+// the struct types it references, sorted by name, e.g.
+// "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func (td TypedData) EncodeType(primaryType string) (string, error) {
+	if _, ok := td.Types[primaryType]; !ok {
+		return "", fmt.Errorf("unknown EIP-712 type %s", primaryType)
+	}
+
+	deps := map[string]bool{}
+	td.collectDependencies(primaryType, deps)
+	delete(deps, primaryType)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	sort.Strings(sorted)
+
+	var sb strings.Builder
+	for _, typ := range append([]string{primaryType}, sorted...) {
+		fields := make([]string, len(td.Types[typ]))
+		for i, field := range td.Types[typ] {
+			fields[i] = field.Type + " " + field.Name
+		}
+		sb.WriteString(typ + "(" + strings.Join(fields, ",") + ")")
+	}
+	return sb.String(), nil
+}
+
+func (td TypedData) collectDependencies(typ string, deps map[string]bool) {
+	typ = baseType(typ)
+	if deps[typ] {
+		return
+	}
+	if _, ok := td.Types[typ]; !ok {
+		return
+	}
+
+	deps[typ] = true
+	for _, field := range td.Types[typ] {
+		td.collectDependencies(field.Type, deps)
+	}
+}
+
+// EncodeData returns typeHash ‖ enc(value₁) ‖ … ‖ enc(valueₙ) for the fields of
+// a struct type.
+func (td TypedData) EncodeData(primaryType string, data map[string]interface{}) ([]byte, error) {
+	typeHash, err := td.TypeHash(primaryType)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(typeHash)
+	for _, field := range td.Types[primaryType] {
+		encoded, err := td.encodeValue(field.Type, data[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", primaryType, field.Name, err)
+		}
+		buf.Write(encoded)
+	}
+
+	if len(data) > len(td.Types[primaryType]) {
+		return nil, fmt.Errorf("%s has fields which are not in its type", primaryType)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeValue returns the 32 bytes encoding of a value of the given type.
+func (td TypedData) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if match := arrayTypeRegexp.FindStringSubmatch(typ); match != nil {
+		var items []interface{}
+		if value != nil {
+			var ok bool
+			if items, ok = value.([]interface{}); !ok {
+				return nil, fmt.Errorf("expected an array, got %T", value)
+			}
+		}
+
+		var buf bytes.Buffer
+		for _, item := range items {
+			encoded, err := td.encodeValue(match[1], item)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object, got %T", value)
+		}
+		return td.HashStruct(typ, data)
+	}
+
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case typ == "bytes":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", value)
+		}
+		if b {
+			return leftPad32(big.NewInt(1).Bytes()), nil
+		}
+		return make([]byte, 32), nil
+
+	case typ == "address":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("invalid address length %d", len(bz))
+		}
+		return leftPad32(bz), nil
+
+	case strings.HasPrefix(typ, "bytes"):
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) > 32 {
+			return nil, fmt.Errorf("invalid %s length %d", typ, len(bz))
+		}
+		padded := make([]byte, 32)
+		copy(padded, bz)
+		return padded, nil
+
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		n, err := parseInteger(value)
+		if err != nil {
+			return nil, err
+		}
+		if n.Sign() < 0 {
+			if strings.HasPrefix(typ, "uint") {
+				return nil, fmt.Errorf("negative %s %s", typ, n)
+			}
+			// two's complement on 256 bits
+			n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		if n.BitLen() > 256 {
+			return nil, fmt.Errorf("%s %s overflows 256 bits", typ, n)
+		}
+		return leftPad32(n.Bytes()), nil
+
+	default:
+		return nil, fmt.Errorf("unknown EIP-712 type %s", typ)
+	}
+}
+
+func baseType(typ string) string {
+	for {
+		match := arrayTypeRegexp.FindStringSubmatch(typ)
+		if match == nil {
+			return typ
+		}
+		typ = match[1]
+	}
+}
+
+func parseInteger(value interface{}) (*big.Int, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case int64:
+		return big.NewInt(v), nil
+	case float64:
+		if v != float64(int64(v)) {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		return big.NewInt(int64(v)), nil
+	default:
+		return nil, fmt.Errorf("expected an integer, got %T", value)
+	}
+
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return n, nil
+}
+
+func parseBytes(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("expected a 0x prefixed hex string, got %v", value)
+	}
+
+	return hex.DecodeString(s[2:])
+}
+
+func leftPad32(bz []byte) []byte {
+	padded := make([]byte, 32)
+	copy(padded[32-len(bz):], bz)
+	return padded
+}
+
+func keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}
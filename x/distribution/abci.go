@@ -33,24 +33,20 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
 	}
 
-	restakeFunc := func(delegator sdk.AccAddress, validator sdk.ValAddress) (stop bool) {
-		err := k.PerformRestake(ctx, delegator, validator)
-		if err != nil {
-			k.Logger(ctx).Info(fmt.Sprintf("Err: %s, Failed to perform restake for delegator-validator %s - %s", err, delegator, validator))
-		}
-
-		return err != nil
-	}
-
 	if ctx.BlockHeight()%k.GetRestakePeriod(ctx).Int64() == 0 {
-		staleKeys := k.IterateRestakeEntries(ctx, restakeFunc)
+		k.BeginRestakeRound(ctx)
+	}
 
-		for _, stale := range staleKeys {
+	restakeFunc := func(delegator sdk.AccAddress, validator sdk.ValAddress) (toRemove bool) {
+		return k.RestakeEntry(ctx, delegator, validator)
+	}
 
-			err := k.DeleteAutoRestakeEntry(ctx, stale.Delegator, stale.Validator)
-			if err != nil {
-				k.Logger(ctx).Info(fmt.Sprintf("Err: %s, Failed to delete restake key", err))
-			}
+	// restake in bounded batches so that a round is spread over several blocks
+	staleKeys := k.ProcessRestakeBatch(ctx, restakeFunc)
+	for _, stale := range staleKeys {
+		err := k.DeleteAutoRestakeEntry(ctx, stale.Delegator, stale.Validator)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("Err: %s, Failed to delete restake key", err))
 		}
 	}
 
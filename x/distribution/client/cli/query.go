@@ -32,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
 		GetAutoRestakeEntries(),
+		GetCmdQueryRestakeEntriesByValidator(),
+		GetCmdQueryAllRestakeEntries(),
+		GetCmdQueryRestakeScheduler(),
 	)
 
 	return distQueryCmd
@@ -365,3 +368,139 @@ $ %s query distribution restake-entries secret1xnjxbsdbsbfsbdfbshdfbhsdfhsfdh
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRestakeEntriesByValidator returns the command for fetching the
+// auto-restake entries of a validator's delegators.
+func GetCmdQueryRestakeEntriesByValidator() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-restake-entries [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the auto-restake entries of all the delegators of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the auto-restake entries of all the delegators of a validator, including
+the outcome of their latest restakes.
+
+Example:
+$ %s query distribution validator-restake-entries %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RestakeEntriesByValidator(
+				cmd.Context(),
+				&types.QueryRestakeEntriesByValidatorRequest{
+					ValidatorAddress: validatorAddr.String(),
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator restake entries")
+	return cmd
+}
+
+// GetCmdQueryAllRestakeEntries returns the command for fetching all auto-restake entries.
+func GetCmdQueryAllRestakeEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-restake-entries",
+		Args:  cobra.NoArgs,
+		Short: "Query all auto-restake entries",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all auto-restake entries, including the outcome of their latest restakes.
+
+Example:
+$ %s query distribution all-restake-entries --limit 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllRestakeEntries(
+				cmd.Context(),
+				&types.QueryAllRestakeEntriesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all restake entries")
+	return cmd
+}
+
+// GetCmdQueryRestakeScheduler returns the command for fetching the auto-restake
+// scheduler progress.
+func GetCmdQueryRestakeScheduler() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restake-scheduler",
+		Args:  cobra.NoArgs,
+		Short: "Query the progress of the auto-restake scheduler",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the progress of the current auto-restake round and the height of the next one.
+
+Example:
+$ %s query distribution restake-scheduler
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RestakeScheduler(cmd.Context(), &types.QueryRestakeSchedulerRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -43,6 +43,7 @@ func NewTxCmd() *cobra.Command {
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
 		NewTxRestakeCmd(),
+		NewRestakeRewardsCmd(),
 	)
 
 	return distTxCmd
@@ -383,3 +384,52 @@ $ %s tx distribution set-auto-restaking false --from mykey
 
 	return cmd
 }
+
+// NewRestakeRewardsCmd returns a CLI command handler for creating a
+// MsgRestakeDelegatorReward transaction.
+func NewRestakeRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restake-rewards [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw the rewards from a validator and delegate part of them back to it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of a delegation and delegate the given amount of them back
+to the validator. The amount must be in the bond denom and cannot exceed the withdrawn rewards.
+A grantee of a restake authorization executes it on behalf of the granter with 'tx authz exec'.
+
+Example:
+$ %s tx distribution restake-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000stake --from mykey
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delegator := clientCtx.GetFromAddress()
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRestakeDelegatorReward(delegator, validator, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -76,6 +76,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, entry := range data.AutoRestakeEntries {
+		if err := k.SetAutoRestakeEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +186,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	restakes := make([]types.AutoRestakeEntry, 0)
+	k.IterateAutoRestakeEntries(ctx,
+		func(entry types.AutoRestakeEntry) (stop bool) {
+			restakes = append(restakes, entry)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, restakes)
 }
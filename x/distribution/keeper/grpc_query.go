@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -278,6 +279,87 @@ func (k Keeper) RestakingEntries(c context.Context, req *types.QueryRestakeEntri
 	}
 
 	validators := k.GetRestakeValidatorsForDelegator(ctx, addr)
+	entries := k.GetRestakeEntriesForDelegator(ctx, addr)
 
-	return &types.QueryRestakingEntriesResponse{Validators: validators}, nil
+	return &types.QueryRestakingEntriesResponse{Validators: validators, Entries: entries}, nil
+}
+
+// RestakeEntriesByValidator queries the auto-restake entries of a validator's delegators
+func (k Keeper) RestakeEntriesByValidator(c context.Context, req *types.QueryRestakeEntriesByValidatorRequest) (*types.QueryRestakeEntriesByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetAutoRestakeValidatorIndexPrefix(valAddr))
+
+	entries := make([]types.AutoRestakeEntry, 0)
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		kv.AssertKeyAtLeastLength(key, 1)
+		delAddr := sdk.AccAddress(key[1:])
+		kv.AssertKeyLength(delAddr, int(key[0]))
+
+		entry, found := k.GetAutoRestakeEntry(ctx, delAddr, valAddr)
+		if !found {
+			return status.Errorf(codes.Internal, "auto-restake entry of %s not found", delAddr)
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRestakeEntriesByValidatorResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// AllRestakeEntries queries all auto-restake entries
+func (k Keeper) AllRestakeEntries(c context.Context, req *types.QueryAllRestakeEntriesRequest) (*types.QueryAllRestakeEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	entriesStore := prefix.NewStore(store, types.AutoRestakeEntryPrefix)
+
+	entries := make([]types.AutoRestakeEntry, 0)
+	pageRes, err := query.Paginate(entriesStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.AutoRestakeEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllRestakeEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// RestakeScheduler queries the progress of the auto-restake scheduler
+func (k Keeper) RestakeScheduler(c context.Context, req *types.QueryRestakeSchedulerRequest) (*types.QueryRestakeSchedulerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state := k.GetRestakeSchedulerState(ctx)
+	period := k.GetRestakePeriod(ctx).Int64()
+	nextRound := (ctx.BlockHeight()/period + 1) * period
+
+	return &types.QueryRestakeSchedulerResponse{State: state, NextRoundHeight: nextRound}, nil
 }
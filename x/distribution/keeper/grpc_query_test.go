@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
 	"testing"
@@ -75,6 +76,10 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
 					SecretFoundationTax: sdk.NewDecWithPrec(0, 1),
+
+					MinimumRestakeThreshold: sdk.NewDec(1_000_000),
+					RestakePeriod:           sdk.NewInt(2000),
+					MaxRestakesPerBlock:     sdk.NewInt(10),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -656,3 +661,123 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestGRPCRestakeEntries() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk2, 100, true)
+	tstaking.DelegateWithPower(addrs[1], valAddrs[0], 20)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	suite.Require().NoError(app.DistrKeeper.SaveAutoRestakeEntry(ctx, addrs[0], valAddrs[0]))
+	suite.Require().NoError(app.DistrKeeper.SaveAutoRestakeEntry(ctx, addrs[1], valAddrs[0]))
+	suite.Require().NoError(app.DistrKeeper.SaveAutoRestakeEntry(ctx, addrs[1], valAddrs[1]))
+
+	// delegator entries carry the entry records
+	delRes, err := queryClient.RestakingEntries(gocontext.Background(), &types.QueryRestakeEntriesRequest{Delegator: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(delRes.Validators, 2)
+	suite.Require().Len(delRes.Entries, 2)
+	for i, entry := range delRes.Entries {
+		suite.Require().Equal(addrs[1].String(), entry.DelegatorAddress)
+		suite.Require().Equal(delRes.Validators[i], entry.ValidatorAddress)
+		suite.Require().True(entry.TotalRestaked.IsZero())
+	}
+
+	// the entries of a validator are ordered by delegator address
+	first, second := addrs[0], addrs[1]
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+
+	var (
+		req    *types.QueryRestakeEntriesByValidatorRequest
+		expRes *types.QueryRestakeEntriesByValidatorResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryRestakeEntriesByValidatorRequest{}
+			},
+			false,
+		},
+		{
+			"invalid validator address",
+			func() {
+				req = &types.QueryRestakeEntriesByValidatorRequest{ValidatorAddress: addrs[0].String()}
+			},
+			false,
+		},
+		{
+			"all delegators of a validator",
+			func() {
+				req = &types.QueryRestakeEntriesByValidatorRequest{ValidatorAddress: valAddrs[0].String()}
+				expRes = &types.QueryRestakeEntriesByValidatorResponse{
+					Entries: []types.AutoRestakeEntry{
+						types.NewAutoRestakeEntry(first, valAddrs[0]),
+						types.NewAutoRestakeEntry(second, valAddrs[0]),
+					},
+				}
+			},
+			true,
+		},
+		{
+			"paginated delegators of a validator",
+			func() {
+				req = &types.QueryRestakeEntriesByValidatorRequest{
+					ValidatorAddress: valAddrs[0].String(),
+					Pagination:       &query.PageRequest{Limit: 1},
+				}
+				expRes = &types.QueryRestakeEntriesByValidatorResponse{
+					Entries: []types.AutoRestakeEntry{types.NewAutoRestakeEntry(first, valAddrs[0])},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.RestakeEntriesByValidator(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Entries, res.Entries)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+
+	// paginate over all the entries
+	allRes, err := queryClient.AllRestakeEntries(gocontext.Background(), &types.QueryAllRestakeEntriesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Entries, 2)
+	suite.Require().Equal(uint64(3), allRes.Pagination.Total)
+
+	allRes, err = queryClient.AllRestakeEntries(gocontext.Background(), &types.QueryAllRestakeEntriesRequest{
+		Pagination: &query.PageRequest{Key: allRes.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Entries, 1)
+	suite.Require().Nil(allRes.Pagination.NextKey)
+
+	// deleted entries are removed from the validator index
+	suite.Require().NoError(app.DistrKeeper.DeleteAutoRestakeEntry(ctx, addrs[0], valAddrs[0]))
+	valRes, err := queryClient.RestakeEntriesByValidator(gocontext.Background(), &types.QueryRestakeEntriesByValidatorRequest{ValidatorAddress: valAddrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AutoRestakeEntry{types.NewAutoRestakeEntry(addrs[1], valAddrs[0])}, valRes.Entries)
+}
@@ -91,9 +91,16 @@ func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAd
 	}
 }
 
-// create new delegation period record
+// create new delegation period record and drop the auto-restake entry of a
+// delegation that no longer qualifies for it
 func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.k.initializeDelegation(ctx, valAddr, delAddr)
+	h.k.removeAutoRestakeEntryBelowThreshold(ctx, delAddr, valAddr)
+}
+
+// remove the auto-restake entry of a delegation that is being removed
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.RemoveAutoRestakeEntry(ctx, delAddr, valAddr, "delegation removed")
 }
 
 // record the slash event
@@ -104,4 +111,3 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                         {}
 func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
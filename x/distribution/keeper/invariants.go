@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "auto-restake-entries",
+		AutoRestakeEntriesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,7 +37,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return AutoRestakeEntriesInvariant(k)(ctx)
 	}
 }
 
@@ -157,3 +163,24 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// AutoRestakeEntriesInvariant checks that every auto-restake entry references an
+// existing delegation
+func AutoRestakeEntriesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.IterateRestakeEntries(ctx, func(delegator sdk.AccAddress, validator sdk.ValAddress) (toRemove bool) {
+			if k.stakingKeeper.Delegation(ctx, delegator, validator) == nil {
+				count++
+				msg += fmt.Sprintf("\tauto-restake entry of %s with %s has no delegation\n", delegator, validator)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "auto-restake entries",
+			fmt.Sprintf("found %d auto-restake entries without a delegation\n%s", count, msg)), broken
+	}
+}
@@ -177,3 +177,29 @@ func (k msgServer) SetAutoRestake(goCtx context.Context, msg *types.MsgSetAutoRe
 	)
 	return &types.MsgSetAutoRestakeResponse{}, nil
 }
+
+func (k msgServer) RestakeDelegatorReward(goCtx context.Context, msg *types.MsgRestakeDelegatorReward) (*types.MsgRestakeDelegatorRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RestakeDelegationRewards(ctx, delegatorAddress, valAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+	return &types.MsgRestakeDelegatorRewardResponse{}, nil
+}
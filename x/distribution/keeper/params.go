@@ -65,3 +65,10 @@ func (k Keeper) GetRestakePeriod(ctx sdk.Context) (amount sdk.Int) {
 	k.paramSpace.Get(ctx, types.ParamRestakePeriod, &amount)
 	return amount
 }
+
+// GetMaxRestakesPerBlock returns the maximum number of auto-restake entries
+// processed in a single block.
+func (k Keeper) GetMaxRestakesPerBlock(ctx sdk.Context) (amount sdk.Int) {
+	k.paramSpace.Get(ctx, types.ParamMaxRestakesPerBlock, &amount)
+	return amount
+}
@@ -312,8 +312,9 @@ func queryRestakeEntriesForDelegator(ctx sdk.Context, _ []string, req abci.Reque
 	addr, err := sdk.AccAddressFromBech32(params.Delegator)
 
 	validators := k.GetRestakeValidatorsForDelegator(ctx, addr)
+	entries := k.GetRestakeEntriesForDelegator(ctx, addr)
 
-	resp := types.NewRestakingEntriesResponse(validators)
+	resp := types.NewRestakingEntriesResponse(validators, entries)
 
 	bz, err := legacyQuerierCdc.MarshalJSON(resp)
 	if err != nil {
@@ -1,34 +1,66 @@
 package keeper
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
+// GetRestakeValidatorsForDelegator returns the validators a delegator auto-restakes with.
 func (k Keeper) GetRestakeValidatorsForDelegator(ctx sdk.Context, delegator sdk.AccAddress) (validators []string) {
-	delegatorPrefix := keyPrefixFromDelegator(delegator)
-	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, delegatorPrefix)
+	for _, entry := range k.GetRestakeEntriesForDelegator(ctx, delegator) {
+		validators = append(validators, entry.ValidatorAddress)
+	}
+	return
+}
 
+// GetRestakeEntriesForDelegator returns all the auto-restake entries of a delegator.
+func (k Keeper) GetRestakeEntriesForDelegator(ctx sdk.Context, delegator sdk.AccAddress) (entries []types.AutoRestakeEntry) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAutoRestakeEntriesPrefix(delegator))
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
 
-		key := iter.Key()
-		// k.Logger(ctx).Info(fmt.Sprintf("from iter - %s", hex.EncodeToString(key)))
-		_, validator := addressesFromRestakeKeyStore(key)
-		// k.Logger(ctx).Info(fmt.Sprintf("from iter - %s %s - %s", delegator, validator, hex.EncodeToString(key)))
-		validators = append(validators, validator.String())
+	for ; iter.Valid(); iter.Next() {
+		var entry types.AutoRestakeEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		entries = append(entries, entry)
 	}
 	return
 }
 
-// SaveAutoRestakeEntry method does stuff
-func (k Keeper) SaveAutoRestakeEntry(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) error {
+// GetAutoRestakeEntry returns the auto-restake entry of a delegator-validator pair.
+func (k Keeper) GetAutoRestakeEntry(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (entry types.AutoRestakeEntry, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAutoRestakeEntryKey(delegator, validator))
+	if b == nil {
+		return entry, false
+	}
+	k.cdc.MustUnmarshal(b, &entry)
+	return entry, true
+}
+
+// SetAutoRestakeEntry stores an auto-restake entry and indexes it by validator.
+func (k Keeper) SetAutoRestakeEntry(ctx sdk.Context, entry types.AutoRestakeEntry) error {
+	delegator, err := sdk.AccAddressFromBech32(entry.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	validator, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoRestakeEntryKey(delegator, validator), k.cdc.MustMarshal(&entry))
+	store.Set(types.GetAutoRestakeValidatorIndexKey(validator, delegator), []byte{})
+	return nil
+}
 
+// SaveAutoRestakeEntry enables auto-restaking for a delegator-validator pair. An
+// existing entry keeps its restake history.
+func (k Keeper) SaveAutoRestakeEntry(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) error {
 	delegation := k.stakingKeeper.Delegation(ctx, delegator, validator)
 	valInfo := k.stakingKeeper.Validator(ctx, validator)
 
@@ -38,29 +70,37 @@ func (k Keeper) SaveAutoRestakeEntry(ctx sdk.Context, delegator sdk.AccAddress,
 		return types.ErrNotEnoughStakeForAuto
 	}
 
-	skey := autoRestakeKey(delegator, validator)
+	if _, found := k.GetAutoRestakeEntry(ctx, delegator, validator); found {
+		return nil
+	}
 
-	store.Set(skey, []byte("k"))
-	return nil
+	return k.SetAutoRestakeEntry(ctx, types.NewAutoRestakeEntry(delegator, validator))
 }
 
-// DeleteAutoRestakeEntry does more stuff
+// DeleteAutoRestakeEntry disables auto-restaking for a delegator-validator pair.
 func (k Keeper) DeleteAutoRestakeEntry(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) error {
 	store := ctx.KVStore(k.storeKey)
-	skey := autoRestakeKey(delegator, validator)
+	skey := types.GetAutoRestakeEntryKey(delegator, validator)
 	found := store.Has(skey)
 	if !found {
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
 	store.Delete(skey)
+	store.Delete(types.GetAutoRestakeValidatorIndexKey(validator, delegator))
 	return nil
 }
 
-// PerformRestake does the thing it's meant to do
+// PerformRestake withdraws the rewards of a delegation and delegates the bond
+// denom part of them back to the same validator.
 func (k Keeper) PerformRestake(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) error {
+	_, err := k.performRestake(ctx, delegator, validator)
+	return err
+}
+
+func (k Keeper) performRestake(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.Int, error) {
 	coins, err := k.WithdrawDelegationRewards(ctx, delegator, validator)
 	if err != nil {
-		return err
+		return sdk.ZeroInt(), err
 	}
 
 	baseDenom := k.stakingKeeper.BondDenom(ctx)
@@ -68,80 +108,195 @@ func (k Keeper) PerformRestake(ctx sdk.Context, delegator sdk.AccAddress, valida
 	coinsToRedelegate := coins.AmountOf(baseDenom)
 
 	if coinsToRedelegate.IsZero() {
-		return sdkerrors.ErrInsufficientFunds
+		return sdk.ZeroInt(), types.ErrNoRestakeRewards
 	}
 
-	val := k.stakingKeeper.Validator(ctx, validator)
+	if err := k.delegateRewards(ctx, delegator, validator, coinsToRedelegate); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return coinsToRedelegate, nil
+}
 
-	_, err = k.stakingKeeper.DoDelegate(ctx, delegator, coinsToRedelegate, 1, val, true)
+// RestakeDelegationRewards withdraws the rewards of a delegation and delegates
+// amount of them back to the same validator. The amount must be in the bond
+// denom and cannot exceed the withdrawn rewards, so that no other tokens of the
+// delegator are delegated.
+func (k Keeper) RestakeDelegationRewards(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coin) error {
+	if baseDenom := k.stakingKeeper.BondDenom(ctx); amount.Denom != baseDenom {
+		return sdkerrors.ErrInvalidCoins.Wrapf("cannot restake %s, expected denom %s", amount.Denom, baseDenom)
+	}
+
+	coins, err := k.WithdrawDelegationRewards(ctx, delegator, validator)
 	if err != nil {
 		return err
 	}
 
+	if rewards := coins.AmountOf(amount.Denom); rewards.LT(amount.Amount) {
+		return types.ErrRestakeExceedsRewards.Wrapf("%s exceeds the withdrawn %s%s", amount, rewards, amount.Denom)
+	}
+
+	return k.delegateRewards(ctx, delegator, validator, amount.Amount)
+}
+
+// delegateRewards delegates withdrawn rewards back to their validator and
+// records the restake in the auto-restake entry of the delegation, if any.
+func (k Keeper) delegateRewards(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Int) error {
+	val := k.stakingKeeper.Validator(ctx, validator)
+
+	if _, err := k.stakingKeeper.DoDelegate(ctx, delegator, amount, 1, val, true); err != nil {
+		return err
+	}
+
+	if entry, found := k.GetAutoRestakeEntry(ctx, delegator, validator); found {
+		entry.LastRestakeHeight = ctx.BlockHeight()
+		entry.LastRestakeAmount = amount
+		entry.TotalRestaked = entry.TotalRestaked.Add(amount)
+		if err := k.SetAutoRestakeEntry(ctx, entry); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// autoRestakeKey returns the byte array that we use in the store
-func autoRestakeKey(delegator sdk.AccAddress, validator sdk.ValAddress) []byte {
-	// key is of format:
-	// 0xF0<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>
-	delegator = address.MustLengthPrefix(delegator)
-	validator = address.MustLengthPrefix(validator)
+// IsPermanentRestakeError returns true if a restake failed because the entry can
+// never be restaked again, as opposed to errors that only skip the current period.
+func IsPermanentRestakeError(err error) bool {
+	return sdkerrors.IsOf(err, types.ErrNoValidatorDistInfo, types.ErrEmptyDelegationDistInfo)
+}
 
-	// fmt.Println("saving key: ", hex.EncodeToString(delegator), hex.EncodeToString(validator))
+// RestakeEntry restakes the rewards of a single entry. The restake runs in its own
+// cached context, so that a failure in any of its steps leaves no partial state
+// behind. It returns true if the entry failed permanently and must be removed.
+func (k Keeper) RestakeEntry(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (toRemove bool) {
+	cacheCtx, write := ctx.CacheContext()
+	amount, err := k.performRestake(cacheCtx, delegator, validator)
 
-	////	l := 1 + len(grantee) + len(granter) + len(m)
-	////	key := make([]byte, l)
-	////	copy(key, GrantKey)
-	////	copy(key[1:], granter)
-	////	copy(key[1+len(granter):], grantee)
-	////	copy(key[l-len(m):], m)
-	////	//	fmt.Println(">>>> len", l, key)
-	////	return key
+	switch {
+	case err == nil:
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		k.emitRestakeEvent(ctx, &types.EventAutoRestake{
+			Delegator: delegator.String(),
+			Validator: validator.String(),
+			Amount:    amount,
+		})
+		return false
 
-	l := 1 + len(delegator) + len(validator)
-	key := make([]byte, l)
-	copy(key, types.AutoRestakeEntryPrefix)
-	copy(key[1:], delegator)
-	copy(key[1+len(delegator):], validator)
+	case IsPermanentRestakeError(err):
+		k.Logger(ctx).Info("removing auto-restake entry", "delegator", delegator, "validator", validator, "err", err)
+		k.emitRestakeEvent(ctx, &types.EventAutoRestakeEntryRemoved{
+			Delegator: delegator.String(),
+			Validator: validator.String(),
+			Reason:    err.Error(),
+		})
+		return true
 
-	return key
+	default:
+		k.Logger(ctx).Debug("skipping auto-restake", "delegator", delegator, "validator", validator, "err", err)
+		if recordErr := k.RecordRestakeFailure(ctx, delegator, validator, err); recordErr != nil {
+			k.Logger(ctx).Error("failed to record auto-restake failure", "delegator", delegator, "validator", validator, "err", recordErr)
+		}
+		k.emitRestakeEvent(ctx, &types.EventAutoRestakeSkipped{
+			Delegator: delegator.String(),
+			Validator: validator.String(),
+			Reason:    err.Error(),
+		})
+		return false
+	}
 }
 
-func addressesFromRestakeKeyStore(key []byte) (delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) {
-	// key is of format:
-	// 0xF0<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>
-	kv.AssertKeyAtLeastLength(key, 2)
+// RemoveAutoRestakeEntry removes the auto-restake entry of a delegator-validator
+// pair, if any, and emits an event with the reason of the removal.
+func (k Keeper) RemoveAutoRestakeEntry(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, reason string) {
+	if err := k.DeleteAutoRestakeEntry(ctx, delegator, validator); err != nil {
+		return
+	}
 
-	delAddrLen := key[1] // remove prefix key
-	kv.AssertKeyAtLeastLength(key, int(3+delAddrLen))
-	valAddrLen := int(key[2+delAddrLen])
-	kv.AssertKeyAtLeastLength(key, 3+int(delAddrLen+byte(valAddrLen)))
+	k.emitRestakeEvent(ctx, &types.EventAutoRestakeEntryRemoved{
+		Delegator: delegator.String(),
+		Validator: validator.String(),
+		Reason:    reason,
+	})
+}
 
-	// lol go code sucks
-	delegatorAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
-	validatorAddr = sdk.ValAddress(key[3+delAddrLen : 3+delAddrLen+byte(valAddrLen)])
+// removeAutoRestakeEntryBelowThreshold removes the auto-restake entry of a
+// delegation whose stake fell below the minimum restake threshold.
+func (k Keeper) removeAutoRestakeEntryBelowThreshold(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetAutoRestakeEntryKey(delegator, validator)) {
+		return
+	}
 
-	return delegatorAddr, validatorAddr
+	delegation := k.stakingKeeper.Delegation(ctx, delegator, validator)
+	val := k.stakingKeeper.Validator(ctx, validator)
+	if delegation == nil || val == nil {
+		return
+	}
+
+	if k.GetMinimumRestakeThreshold(ctx).GT(val.TokensFromShares(delegation.GetShares())) {
+		k.RemoveAutoRestakeEntry(ctx, delegator, validator, types.ErrNotEnoughStakeForAuto.Error())
+	}
 }
 
-// // delegatorAddressFromRestakeKeyStore parses the delegator address only - will be useful for iterating by delegator
-// // (probably)
-// func delegatorAddressFromRestakeKeyStore(key []byte) sdk.AccAddress {
-//	addrLen := key[0]
-//	return sdk.AccAddress(key[1 : 1+addrLen])
-// }
+func (k Keeper) emitRestakeEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit auto-restake event", "err", err)
+	}
+}
 
-// delegatorAddressFromRestakeKeyStore parses the delegator address only - will be useful for iterating by delegator
-// (probably)
-func keyPrefixFromDelegator(delegator sdk.AccAddress) []byte {
-	// this is a stupid function name
-	delegator = address.MustLengthPrefix(delegator)
+// RecordRestakeFailure records the reason a restake of an existing entry failed.
+func (k Keeper) RecordRestakeFailure(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, reason error) error {
+	entry, found := k.GetAutoRestakeEntry(ctx, delegator, validator)
+	if !found {
+		return nil
+	}
+
+	entry.LastFailureHeight = ctx.BlockHeight()
+	entry.LastFailureReason = reason.Error()
+	return k.SetAutoRestakeEntry(ctx, entry)
+}
 
-	l := 1 + len(delegator)
-	key := make([]byte, l)
-	copy(key, types.AutoRestakeEntryPrefix)
-	copy(key[1:], delegator)
+// BeginRestakeRound starts a new auto-restake round at the current height. If the
+// previous round has not visited every entry yet, it keeps running and no new round
+// is started, so that no entry is restaked twice within the same round.
+func (k Keeper) BeginRestakeRound(ctx sdk.Context) {
+	state := k.GetRestakeSchedulerState(ctx)
+	if state.InProgress {
+		k.Logger(ctx).Info(
+			"previous restake round still in progress, not starting a new one",
+			"round_start_height", state.RoundStartHeight, "processed", state.Processed,
+		)
+		return
+	}
+
+	state.InProgress = true
+	state.RoundStartHeight = ctx.BlockHeight()
+	state.Cursor = nil
+	state.Processed = 0
+	k.SetRestakeSchedulerState(ctx, state)
+}
+
+// ProcessRestakeBatch runs the handler on at most MaxRestakesPerBlock entries of the
+// current restake round, continuing from where the previous block stopped, and
+// returns the entries for which the handler reported they should be removed.
+func (k Keeper) ProcessRestakeBatch(ctx sdk.Context, handler func(delegator sdk.AccAddress, validator sdk.ValAddress) (toRemove bool)) []EntryToRemove {
+	state := k.GetRestakeSchedulerState(ctx)
+	if !state.InProgress {
+		return nil
+	}
+
+	limit := k.GetMaxRestakesPerBlock(ctx).Int64()
+	next, processed, staleKeys := k.IterateRestakeEntriesFrom(ctx, state.Cursor, limit, handler)
+
+	state.Processed += processed
+	state.Cursor = next
+	if next == nil {
+		state.InProgress = false
+		state.LastCompletedHeight = ctx.BlockHeight()
+	}
+	k.SetRestakeSchedulerState(ctx, state)
 
-	return key
+	return staleKeys
 }
@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -69,6 +73,13 @@ func TestRestake(t *testing.T) {
 	err = app.DistrKeeper.PerformRestake(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
 	require.Nil(t, err)
 
+	// the entry records the restake
+	entry, found := app.DistrKeeper.GetAutoRestakeEntry(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight(), entry.LastRestakeHeight)
+	require.Equal(t, rewards.AmountOf(sdk.DefaultBondDenom).TruncateInt(), entry.LastRestakeAmount)
+	require.Equal(t, entry.LastRestakeAmount, entry.TotalRestaked)
+
 	// calculate delegation rewards
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
@@ -265,3 +276,291 @@ func TestAutoRemoveRestakeEntry(t *testing.T) {
 	err = app.DistrKeeper.DeleteAutoRestakeEntry(ctx, addr[1], valAddrs[0])
 	require.NotNil(t, err)
 }
+
+func TestRestakeSchedulerBatches(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 5, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator and delegate from all the other accounts
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	for _, delAddr := range addr {
+		if !delAddr.Equals(sdk.AccAddress(valAddrs[0])) {
+			tstaking.DelegateWithPower(delAddr, valAddrs[0], 10)
+		}
+		require.NoError(t, app.DistrKeeper.SaveAutoRestakeEntry(ctx, delAddr, valAddrs[0]))
+	}
+
+	// process at most two entries per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.MaxRestakesPerBlock = sdk.NewInt(2)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	visited := make(map[string]int)
+	handler := func(delegator sdk.AccAddress, _ sdk.ValAddress) bool {
+		visited[delegator.String()]++
+		return false
+	}
+
+	// nothing is processed before a round starts
+	require.Empty(t, app.DistrKeeper.ProcessRestakeBatch(ctx, handler))
+	require.Empty(t, visited)
+
+	ctx = ctx.WithBlockHeight(1000)
+	app.DistrKeeper.BeginRestakeRound(ctx)
+
+	for i, expProcessed := range []uint64{2, 4, 5} {
+		app.DistrKeeper.ProcessRestakeBatch(ctx, handler)
+		state := app.DistrKeeper.GetRestakeSchedulerState(ctx)
+		require.Equal(t, expProcessed, state.Processed)
+		require.Equal(t, int64(1000), state.RoundStartHeight)
+		require.Equal(t, i < 2, state.InProgress)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	// every entry was visited exactly once
+	require.Len(t, visited, 5)
+	for _, count := range visited {
+		require.Equal(t, 1, count)
+	}
+
+	state := app.DistrKeeper.GetRestakeSchedulerState(ctx)
+	require.Equal(t, int64(1002), state.LastCompletedHeight)
+	require.Empty(t, state.Cursor)
+
+	// the round is complete, further batches are no-ops
+	app.DistrKeeper.ProcessRestakeBatch(ctx, handler)
+	require.Len(t, visited, 5)
+}
+
+func TestRestakeSchedulerEvents(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	require.NoError(t, app.DistrKeeper.SaveAutoRestakeEntry(ctx, addr[0], valAddrs[0]))
+
+	// no rewards were allocated, so the restake is skipped for this period
+	ctx = ctx.WithBlockHeight(1000).WithEventManager(sdk.NewEventManager())
+	distribution.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.DistrKeeper)
+
+	attrs := findEvent(t, ctx.EventManager().ABCIEvents(), "cosmos.distribution.v1beta1.EventAutoRestakeSkipped")
+	require.Equal(t, fmt.Sprintf("%q", addr[0].String()), attrs["delegator"])
+	require.Equal(t, fmt.Sprintf("%q", valAddrs[0].String()), attrs["validator"])
+	require.Equal(t, fmt.Sprintf("%q", types.ErrNoRestakeRewards.Error()), attrs["reason"])
+
+	// the entry is kept and records the failure
+	entry, found := app.DistrKeeper.GetAutoRestakeEntry(ctx, addr[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(1000), entry.LastFailureHeight)
+	require.Equal(t, types.ErrNoRestakeRewards.Error(), entry.LastFailureReason)
+
+	res, err := app.DistrKeeper.RestakeScheduler(sdk.WrapSDKContext(ctx), &types.QueryRestakeSchedulerRequest{})
+	require.NoError(t, err)
+	require.False(t, res.State.InProgress)
+	require.Equal(t, uint64(1), res.State.Processed)
+	require.Equal(t, int64(2000), res.NextRoundHeight)
+
+	_, err = app.DistrKeeper.RestakeScheduler(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestRestakeEntryPermanentFailure(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// an entry whose validator and delegation do not exist
+	require.NoError(t, app.DistrKeeper.SetAutoRestakeEntry(ctx, types.NewAutoRestakeEntry(addr[0], valAddrs[0])))
+
+	msg, broken := keeper.AutoRestakeEntriesInvariant(app.DistrKeeper)(ctx)
+	require.True(t, broken, msg)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.True(t, app.DistrKeeper.RestakeEntry(ctx, addr[0], valAddrs[0]))
+	findEvent(t, ctx.EventManager().ABCIEvents(), "cosmos.distribution.v1beta1.EventAutoRestakeEntryRemoved")
+
+	ctx = ctx.WithBlockHeight(1000)
+	distribution.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.DistrKeeper)
+
+	_, found := app.DistrKeeper.GetAutoRestakeEntry(ctx, addr[0], valAddrs[0])
+	require.False(t, found)
+
+	_, broken = keeper.AutoRestakeEntriesInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestRestakeEntryRemovedByStakingHooks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	threshold := app.DistrKeeper.GetMinimumRestakeThreshold(ctx).TruncateInt()
+	tstaking.Delegate(addr[1], valAddrs[0], threshold.MulRaw(2))
+	tstaking.Delegate(addr[2], valAddrs[0], threshold.MulRaw(2))
+	require.NoError(t, app.DistrKeeper.SaveAutoRestakeEntry(ctx, addr[1], valAddrs[0]))
+	require.NoError(t, app.DistrKeeper.SaveAutoRestakeEntry(ctx, addr[2], valAddrs[0]))
+
+	// undelegating above the threshold keeps the entry
+	tstaking.Undelegate(addr[1], valAddrs[0], threshold.QuoRaw(2), true)
+	_, found := app.DistrKeeper.GetAutoRestakeEntry(ctx, addr[1], valAddrs[0])
+	require.True(t, found)
+
+	// undelegating below the threshold removes it
+	res := tstaking.Undelegate(addr[1], valAddrs[0], threshold, true)
+	_, found = app.DistrKeeper.GetAutoRestakeEntry(ctx, addr[1], valAddrs[0])
+	require.False(t, found)
+	attrs := findEvent(t, res.Events, "cosmos.distribution.v1beta1.EventAutoRestakeEntryRemoved")
+	require.Equal(t, fmt.Sprintf("%q", types.ErrNotEnoughStakeForAuto.Error()), attrs["reason"])
+
+	// removing the delegation removes the entry
+	tstaking.Undelegate(addr[2], valAddrs[0], threshold.MulRaw(2), true)
+	_, found = app.DistrKeeper.GetAutoRestakeEntry(ctx, addr[2], valAddrs[0])
+	require.False(t, found)
+
+	_, broken := keeper.AutoRestakeEntriesInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+// findEvent returns the attributes of the first event of the given type.
+func findEvent(t *testing.T, events []abci.Event, eventType string) map[string]string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		return attrs
+	}
+	require.FailNow(t, "event not found", eventType)
+	return nil
+}
+
+func TestAutoRestakeEntriesGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	entry := types.NewAutoRestakeEntry(addrs[0], valAddrs[1])
+	entry.LastRestakeHeight = 5
+	entry.LastRestakeAmount = sdk.NewInt(10)
+	entry.TotalRestaked = sdk.NewInt(30)
+	entry.LastFailureHeight = 7
+	entry.LastFailureReason = "no rewards"
+	require.NoError(t, app.DistrKeeper.SetAutoRestakeEntry(ctx, entry))
+	require.NoError(t, app.DistrKeeper.SetAutoRestakeEntry(ctx, types.NewAutoRestakeEntry(addrs[1], valAddrs[0])))
+
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.AutoRestakeEntries, 2)
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	// clear the entries and import them back
+	require.NoError(t, app.DistrKeeper.DeleteAutoRestakeEntry(ctx, addrs[0], valAddrs[1]))
+	require.NoError(t, app.DistrKeeper.DeleteAutoRestakeEntry(ctx, addrs[1], valAddrs[0]))
+	require.Empty(t, app.DistrKeeper.ExportGenesis(ctx).AutoRestakeEntries)
+
+	app.DistrKeeper.InitGenesis(ctx, *genState)
+
+	imported, found := app.DistrKeeper.GetAutoRestakeEntry(ctx, addrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, entry, imported)
+	_, found = app.DistrKeeper.GetAutoRestakeEntry(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+
+	// the validator index is rebuilt as well
+	res, err := app.DistrKeeper.RestakeEntriesByValidator(sdk.WrapSDKContext(ctx), &types.QueryRestakeEntriesByValidatorRequest{
+		ValidatorAddress: valAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, addrs[0].String(), res.Entries[0].DelegatorAddress)
+}
+
+func TestRestakeDelegatorRewardThroughAuthz(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	granter, grantee := addrs[0], addrs[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	del := app.StakingKeeper.Delegation(ctx, granter, valAddrs[0])
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod).AmountOf(sdk.DefaultBondDenom).TruncateInt()
+	require.True(t, rewards.IsPositive())
+
+	periodMax := sdk.NewCoin(sdk.DefaultBondDenom, rewards.MulRaw(10))
+	auth, err := types.NewRestakeAuthorization([]sdk.ValAddress{valAddrs[0]}, periodMax, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, grantee, granter, auth, ctx.BlockTime().Add(time.Hour)))
+
+	balance := app.BankKeeper.GetBalance(ctx, granter, sdk.DefaultBondDenom)
+	shares := del.GetShares()
+
+	// a delegation larger than the rewards is rejected, even within the period limit
+	cacheCtx, _ := ctx.CacheContext()
+	msg := types.NewMsgRestakeDelegatorReward(granter, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, rewards.AddRaw(1)))
+	_, err = app.AuthzKeeper.DispatchActions(cacheCtx, grantee, []sdk.Msg{msg})
+	require.ErrorIs(t, err, types.ErrRestakeExceedsRewards)
+
+	// only the rewards of the allowed validators can be restaked
+	cacheCtx, _ = ctx.CacheContext()
+	msg = types.NewMsgRestakeDelegatorReward(granter, valAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, rewards))
+	_, err = app.AuthzKeeper.DispatchActions(cacheCtx, grantee, []sdk.Msg{msg})
+	require.Error(t, err)
+
+	// the rewards are withdrawn and delegated back by the single grant, the
+	// liquid balance of the granter is left untouched
+	msg = types.NewMsgRestakeDelegatorReward(granter, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, rewards))
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, granter, sdk.DefaultBondDenom))
+	del = app.StakingKeeper.Delegation(ctx, granter, valAddrs[0])
+	require.True(t, del.GetShares().GT(shares))
+
+	// nothing is left to restake
+	cacheCtx, _ = ctx.CacheContext()
+	msg = types.NewMsgRestakeDelegatorReward(granter, valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	_, err = app.AuthzKeeper.DispatchActions(cacheCtx, grantee, []sdk.Msg{msg})
+	require.ErrorIs(t, err, types.ErrRestakeExceedsRewards)
+}
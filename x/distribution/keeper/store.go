@@ -391,10 +391,7 @@ func (k Keeper) IterateRestakeEntries(ctx sdk.Context, handler func(delegator sd
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 
-		key := iter.Key()
-		// k.Logger(ctx).Info(fmt.Sprintf("from iter - %s", hex.EncodeToString(key)))
-		delegator, validator := addressesFromRestakeKeyStore(key)
-		// k.Logger(ctx).Info(fmt.Sprintf("from iter - %s %s - %s", delegator, validator, hex.EncodeToString(key)))
+		delegator, validator := types.GetAutoRestakeEntryAddresses(iter.Key())
 
 		if handler(delegator, validator) {
 			staleKeys = append(staleKeys,
@@ -407,6 +404,74 @@ func (k Keeper) IterateRestakeEntries(ctx sdk.Context, handler func(delegator sd
 	return staleKeys
 }
 
+// iterate over the auto-restake entry records
+func (k Keeper) IterateAutoRestakeEntries(ctx sdk.Context, handler func(entry types.AutoRestakeEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakeEntryPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.AutoRestakeEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// iterate over at most limit restake entries, starting at the entry stored under
+// start (or at the first entry if start is empty). It returns the key of the next
+// entry to visit, or nil if the end of the entries was reached. The entries are
+// collected before calling the handler, which may write the restake entries.
+func (k Keeper) IterateRestakeEntriesFrom(ctx sdk.Context, start []byte, limit int64, handler func(delegator sdk.AccAddress, validator sdk.ValAddress) (toRemove bool)) (next []byte, processed uint64, staleKeys []EntryToRemove) {
+	store := ctx.KVStore(k.storeKey)
+	if len(start) == 0 {
+		start = types.AutoRestakeEntryPrefix
+	}
+
+	var entries []EntryToRemove
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoRestakeEntryPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if int64(len(entries)) >= limit {
+			next = append([]byte{}, iter.Key()...)
+			break
+		}
+
+		key := append([]byte{}, iter.Key()...)
+		delegator, validator := types.GetAutoRestakeEntryAddresses(key)
+		entries = append(entries, EntryToRemove{
+			Delegator: delegator,
+			Validator: validator,
+		})
+	}
+	iter.Close()
+
+	for _, entry := range entries {
+		processed++
+		if handler(entry.Delegator, entry.Validator) {
+			staleKeys = append(staleKeys, entry)
+		}
+	}
+	return next, processed, staleKeys
+}
+
+// get the auto-restake scheduler state
+func (k Keeper) GetRestakeSchedulerState(ctx sdk.Context) (state types.RestakeSchedulerState) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.RestakeSchedulerStateKey)
+	if b == nil {
+		return state
+	}
+	k.cdc.MustUnmarshal(b, &state)
+	return state
+}
+
+// set the auto-restake scheduler state
+func (k Keeper) SetRestakeSchedulerState(ctx sdk.Context, state types.RestakeSchedulerState) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.RestakeSchedulerStateKey, b)
+}
+
 type EntryToRemove struct {
 	Delegator sdk.AccAddress
 	Validator sdk.ValAddress
@@ -0,0 +1,48 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MaxRestakesPerBlock is the number of auto-restake entries processed per block
+// after the migration.
+var MaxRestakesPerBlock = sdk.NewInt(100)
+
+// MigrateStore performs in-place store migrations for consensus version 4
+// in the distribution module.
+// The migration includes:
+//
+// - Setting the max restakes per block param in the paramstore.
+// - Replacing the placeholder value of auto-restake entries with an entry record.
+// - Indexing auto-restake entries by validator.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, subspace paramtypes.Subspace) error {
+	migrateParamsStore(ctx, subspace)
+	migrateAutoRestakeEntries(ctx.KVStore(storeKey), cdc)
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, subspace paramtypes.Subspace) {
+	subspace.Set(ctx, types.ParamMaxRestakesPerBlock, MaxRestakesPerBlock)
+}
+
+func migrateAutoRestakeEntries(store sdk.KVStore, cdc codec.BinaryCodec) {
+	// collect the entries first, the store must not be written while it is
+	// being iterated over
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakeEntryPrefix)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		delAddr, valAddr := types.GetAutoRestakeEntryAddresses(key)
+		entry := types.NewAutoRestakeEntry(delAddr, valAddr)
+
+		store.Set(key, cdc.MustMarshal(&entry))
+		store.Set(types.GetAutoRestakeValidatorIndexKey(valAddr, delAddr), []byte{})
+	}
+}
@@ -0,0 +1,50 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestDistributionStoreMigrationToV4ConsensusVersion(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distrKey := sdk.NewKVStoreKey("distribution")
+	transientTestKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distrKey, transientTestKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, distrKey, transientTestKey, "distribution")
+
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	require.False(t, paramstore.Has(ctx, types.ParamMaxRestakesPerBlock))
+
+	// store an auto-restake entry the way it was stored before the migration
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(delAddr)
+	oldKey := append(types.AutoRestakeEntryPrefix, address.MustLengthPrefix(delAddr)...)
+	oldKey = append(oldKey, address.MustLengthPrefix(valAddr)...)
+	store := ctx.KVStore(distrKey)
+	store.Set(oldKey, []byte("k"))
+
+	// Run migrations.
+	err := v4.MigrateStore(ctx, distrKey, encCfg.Marshaler, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set.
+	var maxRestakes sdk.Int
+	paramstore.Get(ctx, types.ParamMaxRestakesPerBlock, &maxRestakes)
+	require.Equal(t, v4.MaxRestakesPerBlock, maxRestakes)
+
+	// Make sure the entry was migrated and indexed.
+	var entry types.AutoRestakeEntry
+	encCfg.Marshaler.MustUnmarshal(store.Get(types.GetAutoRestakeEntryKey(delAddr, valAddr)), &entry)
+	require.Equal(t, types.NewAutoRestakeEntry(delAddr, valAddr), entry)
+	require.True(t, store.Has(types.GetAutoRestakeValidatorIndexKey(valAddr, delAddr)))
+}
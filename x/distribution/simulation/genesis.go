@@ -23,6 +23,8 @@ const (
 	FoundationTax           = "foundation_tax"
 	MinimumRestakeThreshold = "minimum_restake_threshold"
 	RestakePeriod           = "restake_period"
+	MaxRestakesPerBlock     = "max_restakes_per_block"
+	AutoRestakeEntries      = "auto_restake_entries"
 )
 
 // GenSecretFoundationTax returns a randomized secret foundation tax parameter.
@@ -35,9 +37,32 @@ func GenMinimumRestakeThreshold(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(int64(r.Intn(100_000_000)))
 }
 
-// GenMinimumRestakeThreshold returns a randomized restake threshold.
+// GenRestakePeriod returns a randomized restake period of at least 1000 blocks.
 func GenRestakePeriod(r *rand.Rand) sdk.Int {
-	return sdk.NewInt(int64(r.Intn(100_000_000)))
+	return sdk.NewInt(int64(1000 + r.Intn(100_000_000)))
+}
+
+// GenMaxRestakesPerBlock returns a randomized number of restakes per block.
+func GenMaxRestakesPerBlock(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(1 + r.Intn(1000)))
+}
+
+// GenAutoRestakeEntries returns auto-restake entries for a random subset of the
+// initial self-delegations created by the staking simulation genesis. Entries
+// are only generated when the initial stake meets the restake threshold.
+func GenAutoRestakeEntries(r *rand.Rand, accs []simulation.Account, numBonded, initialStake int64, threshold sdk.Dec) []types.AutoRestakeEntry {
+	entries := make([]types.AutoRestakeEntry, 0)
+	if sdk.NewDec(initialStake).LT(threshold) {
+		return entries
+	}
+
+	for i := 0; i < int(numBonded) && i < len(accs); i++ {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		entries = append(entries, types.NewAutoRestakeEntry(accs[i].Address, sdk.ValAddress(accs[i].Address)))
+	}
+	return entries
 }
 
 // GenCommunityTax randomized CommunityTax
@@ -103,6 +128,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { restakePeriod = GenRestakePeriod(r) },
 	)
 
+	var maxRestakesPerBlock sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxRestakesPerBlock, &maxRestakesPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxRestakesPerBlock = GenMaxRestakesPerBlock(r) },
+	)
+
+	// NOTE: the staking simulation genesis creates a self-delegation for each of
+	// the first NumBonded accounts, which is what the entries are generated for
+	var autoRestakeEntries []types.AutoRestakeEntry
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoRestakeEntries, &autoRestakeEntries, simState.Rand,
+		func(r *rand.Rand) {
+			autoRestakeEntries = GenAutoRestakeEntries(r, simState.Accounts, simState.NumBonded, simState.InitialStake, restakeThreshold)
+		},
+	)
+
 	foundationTaxAcc, _ := simulation.RandomAcc(simState.Rand, simState.Accounts)
 
 	distrGenesis := types.GenesisState{
@@ -116,7 +157,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 			WithdrawAddrEnabled:     withdrawEnabled,
 			MinimumRestakeThreshold: restakeThreshold,
 			RestakePeriod:           restakePeriod,
+			MaxRestakesPerBlock:     maxRestakesPerBlock,
 		},
+		AutoRestakeEntries: autoRestakeEntries,
 	}
 
 	bz, err := json.MarshalIndent(&distrGenesis, "", " ")
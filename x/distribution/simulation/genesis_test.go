@@ -50,6 +50,29 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
+	require.NoError(t, types.ValidateGenesis(&distrGenesis))
+}
+
+// TestGenAutoRestakeEntries tests that auto-restake entries are only generated
+// for the initial self-delegations meeting the restake threshold.
+func TestGenAutoRestakeEntries(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 10)
+
+	entries := simulation.GenAutoRestakeEntries(r, accs, 5, 1000, sdk.NewDec(1000))
+	require.NotEmpty(t, entries)
+	for _, entry := range entries {
+		acc, found := simtypes.FindAccount(accs[:5], sdk.MustAccAddressFromBech32(entry.DelegatorAddress))
+		require.True(t, found)
+		require.Equal(t, sdk.ValAddress(acc.Address).String(), entry.ValidatorAddress)
+	}
+	require.NoError(t, types.ValidateGenesis(&types.GenesisState{
+		Params:             types.DefaultParams(),
+		FeePool:            types.InitialFeePool(),
+		AutoRestakeEntries: entries,
+	}))
+
+	require.Empty(t, simulation.GenAutoRestakeEntries(r, accs, 5, 1000, sdk.NewDec(1001)))
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
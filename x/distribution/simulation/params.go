@@ -19,6 +19,7 @@ const (
 	keySecretFoundationTax     = "secretfoundationtax"
 	keyMinimumRestakeThreshold = "minimumrestakethreshold"
 	keyRestakePeriod           = "restakeperiod"
+	keyMaxRestakesPerBlock     = "maxrestakesperblock"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -57,5 +58,11 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenRestakePeriod(r))
 			},
 		),
+
+		simulation.NewSimParamChange(types.ModuleName, keyMaxRestakesPerBlock,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxRestakesPerBlock(r))
+			},
+		),
 	}
 }
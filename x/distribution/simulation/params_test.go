@@ -23,11 +23,14 @@ func TestParamChanges(t *testing.T) {
 		{"distribution/secretfoundationtax", "secretfoundationtax", "\"0.280000000000000000\"", "distribution"},
 		{"distribution/baseproposerreward", "baseproposerreward", "\"0.180000000000000000\"", "distribution"},
 		{"distribution/bonusproposerreward", "bonusproposerreward", "\"0.300000000000000000\"", "distribution"},
+		{"distribution/minimumrestakethreshold", "minimumrestakethreshold", "\"11902081.000000000000000000\"", "distribution"},
+		{"distribution/restakeperiod", "restakeperiod", "\"74942318\"", "distribution"},
+		{"distribution/maxrestakesperblock", "maxrestakesperblock", "\"426\"", "distribution"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 4)
+	require.Len(t, paramChanges, 7)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas consumed for each allowed validator checked
+// by RestakeAuthorization.Accept, as for the staking authorizations.
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &RestakeAuthorization{}
+
+// NewRestakeAuthorization creates a new RestakeAuthorization object allowing up
+// to periodMax tokens of the rewards from the allowed validators to be restaked
+// every period.
+func NewRestakeAuthorization(allowed []sdk.ValAddress, periodMax sdk.Coin, period time.Duration) (*RestakeAuthorization, error) {
+	if len(allowed) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("allowed list cannot be empty")
+	}
+
+	allowedValidators := make([]string, len(allowed))
+	for i, validator := range allowed {
+		allowedValidators[i] = validator.String()
+	}
+
+	return &RestakeAuthorization{
+		AllowedValidators: allowedValidators,
+		PeriodMaxTokens:   periodMax,
+		Period:            period,
+		PeriodTokensLeft:  periodMax,
+	}, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RestakeAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgRestakeDelegatorReward{})
+}
+
+func (a RestakeAuthorization) ValidateBasic() error {
+	if len(a.AllowedValidators) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowed list cannot be empty")
+	}
+	for _, validator := range a.AllowedValidators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address %s: %s", validator, err)
+		}
+	}
+	if !a.PeriodMaxTokens.IsValid() || a.PeriodMaxTokens.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid period max tokens: %v", a.PeriodMaxTokens)
+	}
+	if !a.PeriodTokensLeft.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid period tokens left: %v", a.PeriodTokensLeft)
+	}
+	if a.PeriodTokensLeft.Denom != a.PeriodMaxTokens.Denom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period tokens left denom %s differs from period max tokens denom %s",
+			a.PeriodTokensLeft.Denom, a.PeriodMaxTokens.Denom)
+	}
+	if a.PeriodMaxTokens.IsLT(a.PeriodTokensLeft) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "period tokens left cannot exceed period max tokens")
+	}
+	if a.Period <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "period must be positive")
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. It only accepts restaking the rewards
+// of one of the allowed validators within the tokens left for the current
+// period. The msg handler rejects amounts exceeding the withdrawn rewards, so
+// that no other tokens of the granter are delegated.
+func (a RestakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	restake, ok := msg.(*MsgRestakeDelegatorReward)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	isValidatorExists := false
+	for _, validator := range a.AllowedValidators {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "restake authorization")
+		if validator == restake.ValidatorAddress {
+			isValidatorExists = true
+			break
+		}
+	}
+	if !isValidatorExists {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot restake the rewards of %s validator", restake.ValidatorAddress)
+	}
+
+	if restake.Amount.Denom != a.PeriodMaxTokens.Denom {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrapf("cannot restake %s, expected denom %s", restake.Amount.Denom, a.PeriodMaxTokens.Denom)
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+	if a.PeriodTokensLeft.IsLT(restake.Amount) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("restake of %s exceeds the %s left in the period", restake.Amount, a.PeriodTokensLeft)
+	}
+	a.PeriodTokensLeft = a.PeriodTokensLeft.Sub(restake.Amount)
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// tryResetPeriod resets the tokens left to the period maximum once the current
+// period has ended.
+func (a *RestakeAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodTokensLeft = a.PeriodMaxTokens
+
+	// step from the last reset while we are within a period of it, otherwise
+	// start the next period from this block
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/distribution/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RestakeAuthorization defines authorization for compounding the granter's
+// staking rewards: the grantee may withdraw the rewards of the granter from an
+// allowlist of validators and delegate them back, up to period_max_tokens every
+// period. It grants MsgRestakeDelegatorReward, which only delegates tokens out
+// of the rewards it withdraws.
+type RestakeAuthorization struct {
+	// allowed_validators is the list of validator addresses the grantee can
+	// restake the rewards of on behalf of granter's account.
+	AllowedValidators []string `protobuf:"bytes,1,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty"`
+	// period_max_tokens specifies the maximum amount of tokens that can be
+	// restaked in a period.
+	PeriodMaxTokens types.Coin `protobuf:"bytes,2,opt,name=period_max_tokens,json=periodMaxTokens,proto3" json:"period_max_tokens"`
+	// period specifies the time duration after which period_tokens_left is reset
+	// to period_max_tokens.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// period_tokens_left is the amount of tokens that can still be restaked
+	// before the period_reset time.
+	PeriodTokensLeft types.Coin `protobuf:"bytes,4,opt,name=period_tokens_left,json=periodTokensLeft,proto3" json:"period_tokens_left"`
+	// period_reset is the time at which the current period ends, it is calculated
+	// from the time of the first restake after the last period ended.
+	PeriodReset time.Time `protobuf:"bytes,5,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *RestakeAuthorization) Reset()         { *m = RestakeAuthorization{} }
+func (m *RestakeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RestakeAuthorization) ProtoMessage()    {}
+func (*RestakeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4334195c58df3b, []int{0}
+}
+func (m *RestakeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakeAuthorization.Merge(m, src)
+}
+func (m *RestakeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakeAuthorization proto.InternalMessageInfo
+
+func (m *RestakeAuthorization) GetAllowedValidators() []string {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+func (m *RestakeAuthorization) GetPeriodMaxTokens() types.Coin {
+	if m != nil {
+		return m.PeriodMaxTokens
+	}
+	return types.Coin{}
+}
+
+func (m *RestakeAuthorization) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *RestakeAuthorization) GetPeriodTokensLeft() types.Coin {
+	if m != nil {
+		return m.PeriodTokensLeft
+	}
+	return types.Coin{}
+}
+
+func (m *RestakeAuthorization) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*RestakeAuthorization)(nil), "cosmos.distribution.v1beta1.RestakeAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/authz.proto", fileDescriptor_6f4334195c58df3b)
+}
+
+var fileDescriptor_6f4334195c58df3b = []byte{
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x8e, 0xda, 0x40,
+	0x10, 0x86, 0x6d, 0x20, 0x28, 0x31, 0x89, 0x12, 0x56, 0x14, 0x86, 0x48, 0x06, 0xa5, 0x09, 0x0d,
+	0x6b, 0x91, 0x74, 0x49, 0x15, 0x12, 0x29, 0x05, 0xa1, 0xb1, 0x50, 0x8a, 0x34, 0xd6, 0x1a, 0x2f,
+	0x66, 0x85, 0xed, 0xb1, 0xbc, 0x6b, 0x42, 0x78, 0x0a, 0xca, 0x3c, 0xc8, 0x3d, 0x04, 0x25, 0xba,
+	0xea, 0xaa, 0xbb, 0x13, 0xbc, 0xc5, 0x55, 0x27, 0x7b, 0xd7, 0xe8, 0xb8, 0x6b, 0xae, 0xb2, 0x77,
+	0xff, 0xf9, 0x66, 0xfe, 0x7f, 0xb4, 0xc6, 0xc7, 0x19, 0xf0, 0x08, 0xb8, 0xed, 0x33, 0x2e, 0x52,
+	0xe6, 0x65, 0x82, 0x41, 0x6c, 0xaf, 0x86, 0x1e, 0x15, 0x64, 0x68, 0x93, 0x4c, 0x2c, 0x36, 0x38,
+	0x49, 0x41, 0x00, 0x7a, 0x2f, 0x0b, 0xf1, 0xc3, 0x42, 0xac, 0x0a, 0x3b, 0xad, 0x00, 0x02, 0x28,
+	0xea, 0xec, 0xfc, 0x4f, 0x22, 0x9d, 0xb6, 0x44, 0x5c, 0x29, 0x28, 0x5e, 0x4a, 0x96, 0x1a, 0xeb,
+	0x11, 0x4e, 0x4f, 0xe3, 0x66, 0xc0, 0xe2, 0x52, 0x0f, 0x00, 0x82, 0x90, 0xda, 0xc5, 0xc9, 0xcb,
+	0xe6, 0xb6, 0x9f, 0xa5, 0xa4, 0x18, 0x29, 0xf5, 0xee, 0x63, 0x5d, 0xb0, 0x88, 0x72, 0x41, 0xa2,
+	0x44, 0x16, 0x7c, 0xb8, 0xab, 0x18, 0x2d, 0x27, 0xbf, 0x59, 0xd2, 0x6f, 0x99, 0x58, 0x40, 0xca,
+	0x36, 0x05, 0x8f, 0x06, 0x06, 0x22, 0x61, 0x08, 0x7f, 0xa9, 0xef, 0xae, 0x48, 0xc8, 0x7c, 0x22,
+	0x20, 0xe5, 0xa6, 0xde, 0xab, 0xf6, 0x5f, 0x39, 0x4d, 0xa5, 0xfc, 0x3e, 0x09, 0x68, 0x6c, 0x34,
+	0x13, 0x9a, 0x32, 0xf0, 0xdd, 0x88, 0xac, 0x5d, 0x01, 0x4b, 0x1a, 0x73, 0xb3, 0xd2, 0xd3, 0xfb,
+	0x8d, 0x4f, 0x6d, 0xac, 0x22, 0xe5, 0x21, 0xca, 0x55, 0xe0, 0xef, 0xc0, 0xe2, 0x51, 0x6d, 0x77,
+	0xdd, 0xd5, 0x9c, 0xb7, 0x92, 0x9c, 0x90, 0xf5, 0xb4, 0xe0, 0xd0, 0x57, 0xa3, 0x2e, 0xaf, 0xcc,
+	0xaa, 0xea, 0x20, 0x63, 0xe0, 0x32, 0x06, 0xfe, 0xa1, 0x62, 0x8e, 0x5e, 0xe6, 0x1d, 0xfe, 0xdf,
+	0x74, 0x75, 0x47, 0x21, 0x68, 0x62, 0x20, 0xe5, 0x44, 0xba, 0x70, 0x43, 0x3a, 0x17, 0x66, 0xed,
+	0x79, 0x56, 0xde, 0x49, 0x54, 0xfa, 0xf8, 0x45, 0xe7, 0x02, 0xfd, 0x34, 0x5e, 0xab, 0x76, 0x29,
+	0xe5, 0x54, 0x98, 0x2f, 0x8a, 0x46, 0x9d, 0x27, 0x8e, 0xa6, 0xe5, 0x62, 0xa5, 0xa5, 0x6d, 0x6e,
+	0xa9, 0x21, 0x49, 0x27, 0x07, 0xbf, 0x34, 0x2f, 0x2f, 0x06, 0x6f, 0xce, 0x76, 0x3c, 0x1a, 0xef,
+	0x0e, 0x96, 0xbe, 0x3f, 0x58, 0xfa, 0xed, 0xc1, 0xd2, 0xb7, 0x47, 0x4b, 0xdb, 0x1f, 0x2d, 0xed,
+	0xea, 0x68, 0x69, 0x7f, 0x86, 0x01, 0x13, 0x8b, 0xcc, 0xc3, 0x33, 0x88, 0xd4, 0x83, 0x50, 0x9f,
+	0x01, 0xf7, 0x97, 0xf6, 0xfa, 0xfc, 0x19, 0x8a, 0x7f, 0x09, 0xe5, 0x5e, 0xbd, 0xb0, 0xf2, 0xf9,
+	0x7e, 0x00, 0x7e, 0xa1, 0xa1, 0xcc, 0xaa, 0x02, 0x00, 0x00,
+}
+
+func (m *RestakeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestakeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestakeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PeriodTokensLeft.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PeriodMaxTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidators[iNdEx])
+			copy(dAtA[i:], m.AllowedValidators[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RestakeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedValidators) > 0 {
+		for _, s := range m.AllowedValidators {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.PeriodMaxTokens.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = m.PeriodTokensLeft.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RestakeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestakeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestakeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodMaxTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTokensLeft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodTokensLeft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRestakeAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.NewContext(nil, tmproto.Header{Time: now}, false, log.NewNopLogger())
+	day := 24 * time.Hour
+	coin100 := sdk.NewInt64Coin("steak", 100)
+	coin50 := sdk.NewInt64Coin("steak", 50)
+
+	// verify constructor and ValidateBasic
+	_, err := NewRestakeAuthorization([]sdk.ValAddress{}, coin100, day)
+	require.Error(t, err)
+	auth, err := NewRestakeAuthorization([]sdk.ValAddress{valAddr1, valAddr2}, coin100, day)
+	require.NoError(t, err)
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&MsgRestakeDelegatorReward{}), auth.MsgTypeURL())
+
+	invalid := *auth
+	invalid.Period = 0
+	require.Error(t, invalid.ValidateBasic())
+	invalid = *auth
+	invalid.PeriodMaxTokens = sdk.NewInt64Coin("steak", 0)
+	require.Error(t, invalid.ValidateBasic())
+	invalid = *auth
+	invalid.PeriodTokensLeft = sdk.NewInt64Coin("steak", 101)
+	require.Error(t, invalid.ValidateBasic())
+	invalid = *auth
+	invalid.AllowedValidators = []string{delAddr1.String()}
+	require.Error(t, invalid.ValidateBasic())
+
+	// only restakes are accepted
+	_, err = auth.Accept(ctx, NewMsgWithdrawDelegatorReward(delAddr1, valAddr1))
+	require.Error(t, err)
+
+	// only allowed validators are accepted
+	_, err = auth.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr3, coin50))
+	require.Error(t, err)
+
+	// only the period denom is accepted
+	_, err = auth.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr1, sdk.NewInt64Coin("stake", 50)))
+	require.Error(t, err)
+
+	// the first restake starts the period
+	resp, err := auth.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr1, coin50))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*RestakeAuthorization)
+	require.Equal(t, coin50, updated.PeriodTokensLeft)
+	require.Equal(t, now.Add(day), updated.PeriodReset)
+
+	// the period limit cannot be exceeded
+	_, err = updated.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr2, coin100))
+	require.Error(t, err)
+
+	// the remaining tokens can be restaked without deleting the grant
+	resp, err = updated.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr2, coin50))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated = resp.Updated.(*RestakeAuthorization)
+	require.True(t, updated.PeriodTokensLeft.IsZero())
+
+	// the limit resets once the period ends
+	ctx = ctx.WithBlockTime(now.Add(day))
+	resp, err = updated.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr1, coin100))
+	require.NoError(t, err)
+	updated = resp.Updated.(*RestakeAuthorization)
+	require.True(t, updated.PeriodTokensLeft.IsZero())
+	require.Equal(t, now.Add(2*day), updated.PeriodReset)
+
+	// after a long inactivity the next period starts from the block time
+	ctx = ctx.WithBlockTime(now.Add(10 * day))
+	resp, err = updated.Accept(ctx, NewMsgRestakeDelegatorReward(delAddr1, valAddr1, coin50))
+	require.NoError(t, err)
+	updated = resp.Updated.(*RestakeAuthorization)
+	require.Equal(t, coin50, updated.PeriodTokensLeft)
+	require.Equal(t, now.Add(11*day), updated.PeriodReset)
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,7 @@ type Params struct {
 	SecretFoundationAddress string                                 `protobuf:"bytes,6,opt,name=secret_foundation_address,json=secretFoundationAddress,proto3" json:"secret_foundation_address,omitempty" yaml:"secret_foundation_address"`
 	MinimumRestakeThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=minimum_restake_threshold,json=minimumRestakeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_restake_threshold" yaml:"minimum_restake_threshold"`
 	RestakePeriod           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=restake_period,json=restakePeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"restake_period" yaml:"restake_period"`
+	MaxRestakesPerBlock     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_restakes_per_block,json=maxRestakesPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_restakes_per_block" yaml:"max_restakes_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -617,6 +619,180 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// RestakeSchedulerState tracks the progress of the auto-restake scheduler
+// through the current restake round. A round starts every restake period and
+// processes at most max_restakes_per_block entries per block until all entries
+// have been visited.
+type RestakeSchedulerState struct {
+	// in_progress is true while a restake round has entries left to process.
+	InProgress bool `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty" yaml:"in_progress"`
+	// round_start_height is the height at which the current (or last) round started.
+	RoundStartHeight int64 `protobuf:"varint,2,opt,name=round_start_height,json=roundStartHeight,proto3" json:"round_start_height,omitempty" yaml:"round_start_height"`
+	// cursor is the store key of the next entry to process. It is empty when the
+	// round has not processed any entry yet.
+	Cursor []byte `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// processed is the number of entries processed so far in the current round.
+	Processed uint64 `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+	// last_completed_height is the height at which the last round finished.
+	LastCompletedHeight int64 `protobuf:"varint,5,opt,name=last_completed_height,json=lastCompletedHeight,proto3" json:"last_completed_height,omitempty" yaml:"last_completed_height"`
+}
+
+func (m *RestakeSchedulerState) Reset()         { *m = RestakeSchedulerState{} }
+func (m *RestakeSchedulerState) String() string { return proto.CompactTextString(m) }
+func (*RestakeSchedulerState) ProtoMessage()    {}
+func (*RestakeSchedulerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *RestakeSchedulerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakeSchedulerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakeSchedulerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakeSchedulerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakeSchedulerState.Merge(m, src)
+}
+func (m *RestakeSchedulerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakeSchedulerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakeSchedulerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakeSchedulerState proto.InternalMessageInfo
+
+func (m *RestakeSchedulerState) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
+func (m *RestakeSchedulerState) GetRoundStartHeight() int64 {
+	if m != nil {
+		return m.RoundStartHeight
+	}
+	return 0
+}
+
+func (m *RestakeSchedulerState) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *RestakeSchedulerState) GetProcessed() uint64 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
+func (m *RestakeSchedulerState) GetLastCompletedHeight() int64 {
+	if m != nil {
+		return m.LastCompletedHeight
+	}
+	return 0
+}
+
+// AutoRestakeEntry is a delegator's opt-in to have the rewards of a delegation
+// automatically restaked, together with the outcome of its latest restakes.
+type AutoRestakeEntry struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// last_restake_height is the height of the last successful restake.
+	LastRestakeHeight int64 `protobuf:"varint,3,opt,name=last_restake_height,json=lastRestakeHeight,proto3" json:"last_restake_height,omitempty" yaml:"last_restake_height"`
+	// last_restake_amount is the amount of bond denom compounded by the last
+	// successful restake.
+	LastRestakeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=last_restake_amount,json=lastRestakeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_restake_amount" yaml:"last_restake_amount"`
+	// total_restaked is the amount of bond denom compounded since the entry was created.
+	TotalRestaked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_restaked,json=totalRestaked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_restaked" yaml:"total_restaked"`
+	// last_failure_height is the height of the last failed restake.
+	LastFailureHeight int64 `protobuf:"varint,6,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty" yaml:"last_failure_height"`
+	// last_failure_reason is the error returned by the last failed restake.
+	LastFailureReason string `protobuf:"bytes,7,opt,name=last_failure_reason,json=lastFailureReason,proto3" json:"last_failure_reason,omitempty" yaml:"last_failure_reason"`
+}
+
+func (m *AutoRestakeEntry) Reset()         { *m = AutoRestakeEntry{} }
+func (m *AutoRestakeEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoRestakeEntry) ProtoMessage()    {}
+func (*AutoRestakeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoRestakeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestakeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestakeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestakeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestakeEntry.Merge(m, src)
+}
+func (m *AutoRestakeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestakeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestakeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestakeEntry proto.InternalMessageInfo
+
+func (m *AutoRestakeEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoRestakeEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoRestakeEntry) GetLastRestakeHeight() int64 {
+	if m != nil {
+		return m.LastRestakeHeight
+	}
+	return 0
+}
+
+func (m *AutoRestakeEntry) GetLastFailureHeight() int64 {
+	if m != nil {
+		return m.LastFailureHeight
+	}
+	return 0
+}
+
+func (m *AutoRestakeEntry) GetLastFailureReason() string {
+	if m != nil {
+		return m.LastFailureReason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -630,6 +806,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*RestakeSchedulerState)(nil), "cosmos.distribution.v1beta1.RestakeSchedulerState")
+	proto.RegisterType((*AutoRestakeEntry)(nil), "cosmos.distribution.v1beta1.AutoRestakeEntry")
 }
 
 func init() {
@@ -637,85 +815,104 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xcf, 0xb6, 0xa9, 0x93, 0x4e, 0x9b, 0xb4, 0x9d, 0x38, 0x89, 0xf3, 0xf1, 0x7a, 0xad, 0xd1,
-	0xdb, 0x2a, 0x08, 0xea, 0xf4, 0xe3, 0x82, 0x72, 0x40, 0xaa, 0xdd, 0x04, 0x8a, 0x80, 0x46, 0xd3,
-	0x00, 0x12, 0x17, 0xb3, 0xde, 0x9d, 0xd8, 0xa3, 0xec, 0xee, 0x98, 0x99, 0xb1, 0xdb, 0x1e, 0x10,
-	0x12, 0x27, 0x2e, 0x7c, 0x89, 0x0b, 0x07, 0x40, 0x3d, 0xf2, 0xf5, 0x3f, 0x70, 0xed, 0xb1, 0x47,
-	0x04, 0xd2, 0x82, 0x52, 0x21, 0x21, 0x8e, 0xbe, 0x71, 0x43, 0xf3, 0xb1, 0xbb, 0xb6, 0xeb, 0x54,
-	0x31, 0xa8, 0xa7, 0x64, 0x9f, 0xe7, 0x99, 0xdf, 0xfc, 0x9e, 0x8f, 0x79, 0x9e, 0xc7, 0xa0, 0xea,
-	0x33, 0x11, 0x31, 0xb1, 0x19, 0x50, 0x21, 0x39, 0x6d, 0x76, 0x25, 0x65, 0xf1, 0x66, 0xef, 0x6a,
-	0x93, 0x48, 0xef, 0xea, 0x90, 0xb0, 0xda, 0xe1, 0x4c, 0x32, 0xb8, 0x66, 0xec, 0xab, 0x43, 0x2a,
-	0x6b, 0xbf, 0x5a, 0x6c, 0xb1, 0x16, 0xd3, 0x76, 0x9b, 0xea, 0x3f, 0x73, 0x64, 0xb5, 0x6c, 0xaf,
-	0x68, 0x7a, 0x82, 0x64, 0xd0, 0x3e, 0xa3, 0x16, 0x12, 0xfd, 0x34, 0x03, 0x0a, 0xbb, 0x1e, 0xf7,
-	0x22, 0x01, 0x0f, 0xc0, 0x9c, 0xcf, 0xa2, 0xa8, 0x1b, 0x53, 0x79, 0xbf, 0x21, 0xbd, 0x7b, 0x25,
-	0xa7, 0xe2, 0x6c, 0x9c, 0xae, 0xed, 0x3c, 0x4c, 0xdc, 0xa9, 0x5f, 0x12, 0xf7, 0x52, 0x8b, 0xca,
-	0x76, 0xb7, 0x59, 0xf5, 0x59, 0xb4, 0x69, 0x41, 0xcd, 0x9f, 0xcb, 0x22, 0x38, 0xd8, 0x94, 0xf7,
-	0x3b, 0x44, 0x54, 0x6f, 0x12, 0xbf, 0x9f, 0xb8, 0xc5, 0xfb, 0x5e, 0x14, 0x6e, 0xa1, 0x21, 0x30,
-	0x84, 0xcf, 0x66, 0xdf, 0x7b, 0xde, 0x3d, 0xf8, 0x01, 0x28, 0x2a, 0x4a, 0x8d, 0x0e, 0x67, 0x1d,
-	0x26, 0x08, 0x6f, 0x70, 0x72, 0xd7, 0xe3, 0x41, 0xe9, 0x84, 0xbe, 0xf3, 0xf5, 0x89, 0xef, 0x5c,
-	0x33, 0x77, 0x8e, 0xc3, 0x44, 0x18, 0x2a, 0xf1, 0xae, 0x95, 0x62, 0x2d, 0x84, 0x1f, 0x3a, 0x60,
-	0xb1, 0xc9, 0xe2, 0xae, 0x78, 0x82, 0xc2, 0x49, 0x4d, 0xe1, 0x8d, 0x89, 0x29, 0xac, 0x5b, 0x0a,
-	0xe3, 0x40, 0x11, 0x5e, 0xd0, 0xf2, 0x11, 0x12, 0x7b, 0x60, 0xf1, 0x2e, 0x95, 0xed, 0x80, 0x7b,
-	0x77, 0x1b, 0x5e, 0x10, 0xf0, 0x06, 0x89, 0xbd, 0x66, 0x48, 0x82, 0xd2, 0x74, 0xc5, 0xd9, 0x98,
-	0xad, 0x55, 0x72, 0xd4, 0xb1, 0x66, 0x08, 0x2f, 0xa4, 0xf2, 0x1b, 0x41, 0xc0, 0xb7, 0x8d, 0x54,
-	0xbb, 0x26, 0x88, 0xcf, 0x89, 0x6c, 0xec, 0xb3, 0x6e, 0x1c, 0x78, 0xaa, 0x4e, 0x74, 0x46, 0x4f,
-	0xfd, 0x37, 0xd7, 0xc6, 0x82, 0x22, 0xbc, 0x60, 0xe4, 0x3b, 0x99, 0x58, 0x25, 0xf8, 0x5d, 0xb0,
-	0xf2, 0xa4, 0xb9, 0x22, 0x4f, 0x84, 0x28, 0x15, 0x34, 0x8f, 0xff, 0xf7, 0x13, 0xb7, 0x72, 0x14,
-	0xb2, 0x35, 0x45, 0x78, 0x79, 0x14, 0xfd, 0x86, 0xd1, 0xc0, 0x4f, 0x1c, 0xb0, 0x12, 0xd1, 0x98,
-	0x46, 0xdd, 0xa8, 0xc1, 0x89, 0x90, 0xde, 0x01, 0x69, 0xc8, 0x36, 0x27, 0xa2, 0xcd, 0xc2, 0xa0,
-	0x34, 0xa3, 0xaf, 0xc0, 0x13, 0xbb, 0x6a, 0x09, 0x1d, 0x09, 0x8c, 0xf0, 0xb2, 0xd5, 0x61, 0xa3,
-	0xda, 0x4b, 0x35, 0x30, 0x06, 0xf3, 0xa9, 0x79, 0x87, 0x70, 0xca, 0x82, 0xd2, 0xac, 0x26, 0xf1,
-	0xf2, 0x04, 0x24, 0x6e, 0xc5, 0xb2, 0x9f, 0xb8, 0x8b, 0x86, 0xc4, 0x30, 0x1a, 0xc2, 0x73, 0x56,
-	0xb0, 0xab, 0xbf, 0xb7, 0xa6, 0xbf, 0x7c, 0xe0, 0x4e, 0xa1, 0x4f, 0x4f, 0x80, 0xd5, 0xb7, 0xbc,
-	0x90, 0x06, 0x9e, 0x64, 0xfc, 0x15, 0x2a, 0x24, 0xe3, 0xd4, 0xf7, 0x42, 0x53, 0x61, 0x02, 0xfe,
-	0xe0, 0x80, 0x65, 0xbf, 0x1b, 0x75, 0x43, 0x4f, 0xd2, 0x1e, 0xb1, 0xe5, 0xd8, 0xe0, 0x2a, 0x92,
-	0x25, 0xa7, 0x72, 0x72, 0xe3, 0xcc, 0xb5, 0x75, 0xdb, 0x86, 0xaa, 0xea, 0x95, 0xa4, 0xed, 0x44,
-	0x45, 0xa3, 0xce, 0x68, 0x5c, 0x7b, 0x53, 0x91, 0xef, 0x27, 0x6e, 0xd9, 0x3e, 0xea, 0xf1, 0x50,
-	0xe8, 0xfb, 0xdf, 0xdc, 0xe7, 0x8f, 0x17, 0x63, 0x85, 0x2a, 0xf0, 0x62, 0x0e, 0x64, 0x98, 0x62,
-	0x05, 0x03, 0xeb, 0xe0, 0x1c, 0x27, 0xfb, 0x84, 0x93, 0xd8, 0x27, 0x0d, 0x9f, 0x75, 0x63, 0xa9,
-	0x3b, 0xc2, 0x5c, 0x6d, 0xb5, 0x9f, 0xb8, 0x4b, 0x69, 0x54, 0x86, 0x0c, 0x10, 0x9e, 0xcf, 0x24,
-	0x75, 0x2d, 0xf8, 0xc6, 0x01, 0xcb, 0x59, 0x44, 0xea, 0x5d, 0xce, 0x49, 0x2c, 0xd3, 0x70, 0x1c,
-	0x80, 0x19, 0xc3, 0x5b, 0x1c, 0xcb, 0xfb, 0xeb, 0xca, 0xfb, 0x49, 0x7d, 0x4b, 0x6f, 0x80, 0x4b,
-	0xa0, 0x60, 0x0b, 0x41, 0x39, 0x31, 0x8d, 0xed, 0x17, 0xfa, 0xc2, 0x01, 0xe5, 0x8c, 0xe0, 0x0d,
-	0xdf, 0x86, 0x82, 0x04, 0x75, 0x16, 0x45, 0x54, 0x08, 0xca, 0x62, 0xf8, 0x1e, 0x00, 0x7e, 0xf6,
-	0xf5, 0xec, 0xa8, 0x0e, 0x5c, 0x82, 0xbe, 0x72, 0xc0, 0x5a, 0xc6, 0xea, 0x76, 0x57, 0x0a, 0xe9,
-	0xc5, 0x01, 0x8d, 0x5b, 0x69, 0xe8, 0xde, 0x9f, 0x2c, 0x74, 0xdb, 0xb6, 0x70, 0xe6, 0xd3, 0xac,
-	0xe9, 0xa3, 0xe8, 0xdf, 0x06, 0x13, 0x7d, 0xe7, 0x80, 0x85, 0x8c, 0xde, 0x9d, 0xd0, 0x13, 0xed,
-	0xed, 0x1e, 0x89, 0x25, 0xdc, 0x01, 0xe7, 0x7b, 0xa9, 0x38, 0x7d, 0x77, 0x6a, 0x72, 0x4d, 0xd7,
-	0xd6, 0xfa, 0x89, 0xbb, 0x6c, 0x6e, 0x1f, 0xb5, 0x40, 0xf8, 0x5c, 0x26, 0x32, 0xaf, 0x09, 0xbe,
-	0x0a, 0x66, 0xf7, 0xb9, 0xe7, 0xab, 0x0e, 0x63, 0xa7, 0x50, 0x75, 0xb2, 0xe6, 0x81, 0xb3, 0xf3,
-	0xe8, 0x47, 0x07, 0x14, 0xc7, 0x70, 0x15, 0xf0, 0x63, 0x07, 0x2c, 0xe5, 0x5c, 0x84, 0xd2, 0x34,
-	0x88, 0x56, 0xd9, 0x98, 0x5e, 0xa9, 0x3e, 0x65, 0xc6, 0x57, 0xc7, 0x60, 0xd6, 0x2e, 0xda, 0x38,
-	0xff, 0x6f, 0xd4, 0xd3, 0x41, 0x74, 0x84, 0x8b, 0xbd, 0x31, 0x7c, 0x6c, 0x0b, 0xf9, 0xda, 0x01,
-	0x33, 0x3b, 0x84, 0xec, 0x32, 0x16, 0xc2, 0xcf, 0x1d, 0x30, 0x9f, 0x4f, 0xee, 0x0e, 0x63, 0xe1,
-	0xb1, 0xb2, 0xfd, 0x9a, 0x65, 0xb1, 0x38, 0x3a, 0xfb, 0x15, 0xc2, 0xc4, 0x49, 0xcf, 0x17, 0x11,
-	0xc5, 0x09, 0xfd, 0xe1, 0x80, 0xd5, 0xfa, 0xa0, 0xe4, 0x4e, 0x87, 0xc4, 0x81, 0x99, 0xa5, 0x5e,
-	0x08, 0x8b, 0xe0, 0x94, 0xa4, 0x32, 0x24, 0x66, 0x61, 0xc1, 0xe6, 0x03, 0x56, 0xc0, 0x99, 0x80,
-	0x08, 0x9f, 0xd3, 0x4e, 0x9e, 0x52, 0x3c, 0x28, 0x82, 0xeb, 0xe0, 0x34, 0x27, 0x3e, 0xed, 0x50,
-	0x12, 0x4b, 0x33, 0xf5, 0x71, 0x2e, 0x80, 0x3e, 0x28, 0x78, 0x91, 0xee, 0x40, 0xd3, 0xda, 0xff,
-	0x95, 0xb1, 0xfe, 0x6b, 0xe7, 0xaf, 0xd8, 0xa7, 0xb7, 0x71, 0x0c, 0x1f, 0x8d, 0x83, 0x16, 0x7a,
-	0xeb, 0xec, 0x47, 0x0f, 0xdc, 0x29, 0x95, 0x83, 0x3f, 0x55, 0x1e, 0xfe, 0x76, 0xc0, 0xe2, 0x4d,
-	0x12, 0x92, 0x96, 0x4e, 0x93, 0xf4, 0xb8, 0xa4, 0x71, 0xeb, 0x56, 0xbc, 0xaf, 0xfb, 0x62, 0x87,
-	0x93, 0x1e, 0x65, 0x6a, 0xb5, 0x18, 0xac, 0xf1, 0x81, 0xbe, 0x38, 0x62, 0x80, 0xf0, 0x7c, 0x2a,
-	0xb1, 0x15, 0xbe, 0x07, 0x4e, 0xe9, 0xf1, 0x61, 0xcb, 0xfb, 0xa5, 0x89, 0x67, 0xe3, 0x59, 0x3b,
-	0xac, 0x15, 0x08, 0xc2, 0x06, 0x0c, 0x6e, 0x83, 0x42, 0x9b, 0xd0, 0x56, 0xdb, 0x84, 0x70, 0xba,
-	0x76, 0xf9, 0xaf, 0xc4, 0x3d, 0xe7, 0x73, 0x62, 0xc6, 0xb8, 0x51, 0xe5, 0x24, 0x47, 0x14, 0x08,
-	0xdb, 0xc3, 0xe8, 0x57, 0x07, 0xac, 0x58, 0xdf, 0x29, 0x8b, 0xb3, 0x28, 0xd8, 0x45, 0xe9, 0x16,
-	0xb8, 0x90, 0x17, 0x76, 0xba, 0x45, 0x98, 0xfd, 0x74, 0xbd, 0x9f, 0xb8, 0xa5, 0xd1, 0xda, 0xcf,
-	0xb6, 0x87, 0xbc, 0x37, 0xa4, 0x6b, 0x03, 0x05, 0x85, 0x6c, 0xd7, 0x7c, 0x46, 0x5d, 0xd5, 0x5e,
-	0xb0, 0x35, 0x6b, 0xb3, 0xeb, 0xa0, 0x07, 0x27, 0xc0, 0xc5, 0xa3, 0x2b, 0xf8, 0x6d, 0x2a, 0xdb,
-	0x37, 0x49, 0x87, 0x09, 0x2a, 0xe1, 0xa5, 0xa1, 0x62, 0xae, 0x9d, 0xcf, 0xc3, 0xae, 0xc5, 0x28,
-	0x2d, 0xef, 0x17, 0xc7, 0x94, 0x77, 0x6d, 0xa9, 0x9f, 0xb8, 0xd0, 0x58, 0x0f, 0x28, 0xd1, 0x70,
-	0xd9, 0x5f, 0x7b, 0xa2, 0xec, 0x6b, 0xc5, 0x7e, 0xe2, 0x9e, 0x4f, 0xfb, 0xb4, 0x55, 0xa1, 0xc1,
-	0xc7, 0xf0, 0xdc, 0xc0, 0x63, 0x50, 0x07, 0x2e, 0xf4, 0x13, 0x77, 0xce, 0x1c, 0x30, 0x72, 0x94,
-	0x96, 0x34, 0x7c, 0x01, 0xcc, 0x04, 0xc6, 0x17, 0xbb, 0x6e, 0xc2, 0x7c, 0x08, 0x58, 0x05, 0xc2,
-	0xa9, 0x49, 0x1e, 0xa2, 0xda, 0xed, 0x6f, 0x0f, 0xcb, 0xce, 0xc3, 0xc3, 0xb2, 0xf3, 0xe8, 0xb0,
-	0xec, 0xfc, 0x7e, 0x58, 0x76, 0x3e, 0x7b, 0x5c, 0x9e, 0x7a, 0xf4, 0xb8, 0x3c, 0xf5, 0xf3, 0xe3,
-	0xf2, 0xd4, 0x3b, 0x57, 0x9f, 0x1a, 0xff, 0x7b, 0xc3, 0x3f, 0xa1, 0x74, 0x3a, 0x9a, 0x05, 0xfd,
-	0x0b, 0xe7, 0xfa, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x24, 0xec, 0x63, 0x66, 0x0d, 0x00,
-	0x00,
+	// 1542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6f, 0xdb, 0x46,
+	0x1a, 0x37, 0xfd, 0x90, 0x9d, 0x89, 0xed, 0x38, 0xf4, 0x4b, 0x7e, 0x44, 0x34, 0x06, 0x9b, 0xc0,
+	0x8b, 0xdd, 0xc8, 0x79, 0x1c, 0x76, 0xe1, 0xc3, 0x02, 0x96, 0x63, 0x6f, 0xb2, 0x9b, 0x4d, 0x8c,
+	0x89, 0x77, 0x17, 0xe8, 0x45, 0xa5, 0xc8, 0xb1, 0x34, 0x30, 0xc9, 0x51, 0x67, 0x86, 0x8e, 0x0d,
+	0xb4, 0x28, 0x50, 0x14, 0x45, 0x2f, 0x7d, 0xa1, 0x97, 0x1e, 0xda, 0x22, 0xc7, 0xa6, 0xed, 0xdf,
+	0x51, 0xe4, 0x98, 0x63, 0xd1, 0x02, 0x6a, 0xe1, 0xa0, 0x40, 0xd1, 0xa3, 0x6e, 0xbd, 0x15, 0xf3,
+	0x20, 0x45, 0xc9, 0x74, 0x60, 0x25, 0xc8, 0xc9, 0xe6, 0x6f, 0x3e, 0x7e, 0xdf, 0xef, 0x7b, 0xce,
+	0x47, 0x81, 0xb2, 0x47, 0x79, 0x48, 0xf9, 0x9a, 0x4f, 0xb8, 0x60, 0xa4, 0x16, 0x0b, 0x42, 0xa3,
+	0xb5, 0x83, 0xeb, 0x35, 0x2c, 0xdc, 0xeb, 0x5d, 0x60, 0xb9, 0xc9, 0xa8, 0xa0, 0xf6, 0x92, 0x96,
+	0x2f, 0x77, 0x1d, 0x19, 0xf9, 0xc5, 0x99, 0x3a, 0xad, 0x53, 0x25, 0xb7, 0x26, 0xff, 0xd3, 0xaf,
+	0x2c, 0x96, 0x8c, 0x89, 0x9a, 0xcb, 0x71, 0xaa, 0xda, 0xa3, 0xc4, 0xa8, 0x84, 0xdf, 0x8d, 0x81,
+	0xc2, 0x8e, 0xcb, 0xdc, 0x90, 0xdb, 0xfb, 0x60, 0xc2, 0xa3, 0x61, 0x18, 0x47, 0x44, 0x1c, 0x55,
+	0x85, 0x7b, 0x58, 0xb4, 0x56, 0xac, 0xd5, 0x73, 0x95, 0xed, 0x27, 0x2d, 0x67, 0xe0, 0x87, 0x96,
+	0x73, 0xa5, 0x4e, 0x44, 0x23, 0xae, 0x95, 0x3d, 0x1a, 0xae, 0x19, 0xa5, 0xfa, 0xcf, 0x55, 0xee,
+	0xef, 0xaf, 0x89, 0xa3, 0x26, 0xe6, 0xe5, 0x5b, 0xd8, 0x6b, 0xb7, 0x9c, 0x99, 0x23, 0x37, 0x0c,
+	0xd6, 0x61, 0x97, 0x32, 0x88, 0xc6, 0xd3, 0xe7, 0x5d, 0xf7, 0xd0, 0x7e, 0x1b, 0xcc, 0x48, 0x4a,
+	0xd5, 0x26, 0xa3, 0x4d, 0xca, 0x31, 0xab, 0x32, 0xfc, 0xd0, 0x65, 0x7e, 0x71, 0x50, 0xd9, 0xfc,
+	0x4f, 0xdf, 0x36, 0x97, 0xb4, 0xcd, 0x3c, 0x9d, 0x10, 0xd9, 0x12, 0xde, 0x31, 0x28, 0x52, 0xa0,
+	0xfd, 0x8e, 0x05, 0x66, 0x6b, 0x34, 0x8a, 0xf9, 0x09, 0x0a, 0x43, 0x8a, 0xc2, 0xbd, 0xbe, 0x29,
+	0x2c, 0x1b, 0x0a, 0x79, 0x4a, 0x21, 0x9a, 0x56, 0x78, 0x0f, 0x89, 0x5d, 0x30, 0xfb, 0x90, 0x88,
+	0x86, 0xcf, 0xdc, 0x87, 0x55, 0xd7, 0xf7, 0x59, 0x15, 0x47, 0x6e, 0x2d, 0xc0, 0x7e, 0x71, 0x78,
+	0xc5, 0x5a, 0x1d, 0xab, 0xac, 0x74, 0xb4, 0xe6, 0x8a, 0x41, 0x34, 0x9d, 0xe0, 0x1b, 0xbe, 0xcf,
+	0xb6, 0x34, 0xaa, 0x5c, 0xe3, 0xd8, 0x63, 0x58, 0x54, 0xf7, 0x68, 0x1c, 0xf9, 0xae, 0xac, 0x13,
+	0x95, 0xd1, 0x91, 0x97, 0x73, 0x2d, 0x57, 0x29, 0x44, 0xd3, 0x1a, 0xdf, 0x4e, 0x61, 0x99, 0xe0,
+	0xd7, 0xc1, 0xc2, 0x49, 0x71, 0x49, 0x1e, 0x73, 0x5e, 0x2c, 0x28, 0x1e, 0x7f, 0x6a, 0xb7, 0x9c,
+	0x95, 0xd3, 0x34, 0x1b, 0x51, 0x88, 0xe6, 0x7b, 0xb5, 0x6f, 0xe8, 0x13, 0xfb, 0x43, 0x0b, 0x2c,
+	0x84, 0x24, 0x22, 0x61, 0x1c, 0x56, 0x19, 0xe6, 0xc2, 0xdd, 0xc7, 0x55, 0xd1, 0x60, 0x98, 0x37,
+	0x68, 0xe0, 0x17, 0x47, 0x95, 0x09, 0xd4, 0xb7, 0xab, 0x86, 0xd0, 0xa9, 0x8a, 0x21, 0x9a, 0x37,
+	0x67, 0x48, 0x1f, 0xed, 0x26, 0x27, 0x76, 0x04, 0x26, 0x13, 0xf1, 0x26, 0x66, 0x84, 0xfa, 0xc5,
+	0x31, 0x45, 0xe2, 0x9f, 0x7d, 0x90, 0xb8, 0x13, 0x89, 0x76, 0xcb, 0x99, 0xd5, 0x24, 0xba, 0xb5,
+	0x41, 0x34, 0x61, 0x80, 0x1d, 0xf5, 0x6c, 0xbf, 0x6b, 0x81, 0xb9, 0xd0, 0x3d, 0x4c, 0x38, 0x72,
+	0x29, 0x57, 0xad, 0x05, 0xd4, 0xdb, 0x2f, 0x9e, 0x53, 0x86, 0xef, 0xf7, 0x6d, 0xf8, 0x92, 0xf1,
+	0x3e, 0x57, 0x2b, 0x44, 0xd3, 0xa1, 0x7b, 0x68, 0xdc, 0xe6, 0x3b, 0x98, 0x55, 0x24, 0xba, 0x3e,
+	0xfc, 0xd9, 0x23, 0x67, 0x00, 0x7e, 0x34, 0x08, 0x16, 0xff, 0xe7, 0x06, 0xc4, 0x77, 0x05, 0x65,
+	0xb7, 0x09, 0x17, 0x94, 0x11, 0xcf, 0x0d, 0x74, 0xa1, 0x73, 0xfb, 0x1b, 0x0b, 0xcc, 0x7b, 0x71,
+	0x18, 0x07, 0xae, 0x20, 0x07, 0xd8, 0x74, 0x45, 0x95, 0xc9, 0x84, 0x16, 0xad, 0x95, 0xa1, 0xd5,
+	0xf3, 0x37, 0x96, 0xcd, 0x34, 0x2c, 0xcb, 0x66, 0x4d, 0xa6, 0x9a, 0x4c, 0xca, 0x26, 0x25, 0x51,
+	0xe5, 0xbf, 0xd2, 0x95, 0x76, 0xcb, 0x29, 0x99, 0xd9, 0x92, 0xaf, 0x0a, 0x7e, 0xfd, 0x93, 0xf3,
+	0x97, 0xb3, 0xa5, 0x5a, 0x6a, 0xe5, 0x68, 0xb6, 0xa3, 0x48, 0x33, 0x45, 0x52, 0x8d, 0xbd, 0x09,
+	0x2e, 0x30, 0xbc, 0x87, 0x19, 0x8e, 0x3c, 0x5c, 0xf5, 0x68, 0x1c, 0x09, 0x35, 0x98, 0x26, 0x2a,
+	0x8b, 0xed, 0x96, 0x33, 0x97, 0x24, 0xa7, 0x4b, 0x00, 0xa2, 0xc9, 0x14, 0xd9, 0x54, 0xc0, 0x97,
+	0x16, 0x98, 0x4f, 0x23, 0xb2, 0x19, 0x33, 0x86, 0x23, 0x91, 0x84, 0x63, 0x1f, 0x8c, 0x6a, 0xde,
+	0xfc, 0x4c, 0xde, 0xdf, 0x94, 0xde, 0xf7, 0xeb, 0x5b, 0x62, 0xc1, 0x9e, 0x03, 0x05, 0x53, 0x8f,
+	0xd2, 0x89, 0x61, 0x64, 0x9e, 0xe0, 0xa7, 0x16, 0x28, 0xa5, 0x04, 0x37, 0x3c, 0x13, 0x0a, 0xec,
+	0x6f, 0xd2, 0x30, 0x24, 0x9c, 0x13, 0x1a, 0xd9, 0x6f, 0x00, 0xe0, 0xa5, 0x4f, 0xaf, 0x8e, 0x6a,
+	0xc6, 0x08, 0xfc, 0xdc, 0x02, 0x4b, 0x29, 0xab, 0xfb, 0xb1, 0xe0, 0xc2, 0x8d, 0x7c, 0x12, 0xd5,
+	0x93, 0xd0, 0xbd, 0xd5, 0x5f, 0xe8, 0xb6, 0x4c, 0xe1, 0x4c, 0x26, 0x59, 0x53, 0xaf, 0xc2, 0x17,
+	0x0d, 0x26, 0x7c, 0x6c, 0x81, 0xe9, 0x94, 0xde, 0x83, 0xc0, 0xe5, 0x8d, 0xad, 0x03, 0x1c, 0x09,
+	0x7b, 0x1b, 0x4c, 0x1d, 0x24, 0x70, 0xd2, 0xfe, 0xf2, 0x02, 0x1d, 0xae, 0x2c, 0xb5, 0x5b, 0xce,
+	0xbc, 0xb6, 0xde, 0x2b, 0x01, 0xd1, 0x85, 0x14, 0x32, 0x4d, 0xfd, 0x2f, 0x30, 0xb6, 0xc7, 0x5c,
+	0x4f, 0x0e, 0x3a, 0x73, 0x19, 0x96, 0xfb, 0x9b, 0x61, 0x28, 0x7d, 0x1f, 0x7e, 0x6b, 0x81, 0x99,
+	0x1c, 0xae, 0xdc, 0xfe, 0xc0, 0x02, 0x73, 0x1d, 0x2e, 0x5c, 0x9e, 0x54, 0xb1, 0x3a, 0x32, 0x31,
+	0xbd, 0x56, 0x7e, 0xce, 0xaa, 0x51, 0xce, 0xd1, 0x59, 0xb9, 0x6c, 0xe2, 0x7c, 0xa9, 0xd7, 0xd3,
+	0xac, 0x76, 0x88, 0x66, 0x0e, 0x72, 0xf8, 0x98, 0x11, 0xf2, 0x85, 0x05, 0x46, 0xb7, 0x31, 0xde,
+	0xa1, 0x34, 0xb0, 0x3f, 0xb1, 0xc0, 0x64, 0x67, 0x81, 0x68, 0x52, 0x1a, 0x9c, 0x29, 0xdb, 0x77,
+	0x0d, 0x8b, 0xd9, 0xde, 0x15, 0x44, 0x6a, 0xe8, 0x3b, 0xe9, 0x9d, 0x7d, 0x48, 0x72, 0x82, 0xbf,
+	0x58, 0x60, 0x71, 0x33, 0x8b, 0x3c, 0x68, 0xe2, 0xc8, 0xd7, 0x57, 0xba, 0x1b, 0xd8, 0x33, 0x60,
+	0x44, 0x10, 0x11, 0x60, 0xbd, 0x37, 0x21, 0xfd, 0x60, 0xaf, 0x80, 0xf3, 0x3e, 0xe6, 0x1e, 0x23,
+	0xcd, 0x4e, 0x4a, 0x51, 0x16, 0xb2, 0x97, 0xc1, 0x39, 0x86, 0x3d, 0xd2, 0x24, 0x38, 0x12, 0x7a,
+	0xf9, 0x40, 0x1d, 0xc0, 0xf6, 0x40, 0xc1, 0x0d, 0xd5, 0x04, 0x1a, 0x56, 0xfe, 0x2f, 0xe4, 0xfa,
+	0xaf, 0x9c, 0xbf, 0x66, 0x5a, 0x6f, 0xf5, 0x0c, 0x3e, 0x6a, 0x07, 0x8d, 0xea, 0xf5, 0xf1, 0xf7,
+	0x1f, 0x39, 0x03, 0x32, 0x07, 0xbf, 0xca, 0x3c, 0xfc, 0x6e, 0x81, 0xd9, 0x5b, 0x38, 0xc0, 0x75,
+	0x95, 0x26, 0xe1, 0x32, 0x41, 0xa2, 0xfa, 0x9d, 0x68, 0x4f, 0xcd, 0xc5, 0x26, 0xc3, 0x07, 0x84,
+	0xc6, 0xbc, 0xbb, 0xc6, 0x33, 0x73, 0xb1, 0x47, 0x00, 0xa2, 0xc9, 0x04, 0x31, 0x15, 0xbe, 0x0b,
+	0x46, 0xd4, 0x0d, 0x62, 0xca, 0xfb, 0x1f, 0x7d, 0x5f, 0xd1, 0xe3, 0xda, 0x90, 0x52, 0x02, 0x91,
+	0x56, 0x66, 0x6f, 0x81, 0x42, 0x03, 0x93, 0x7a, 0x43, 0x87, 0x70, 0xb8, 0x72, 0xf5, 0xb7, 0x96,
+	0x73, 0xc1, 0x63, 0x58, 0x6f, 0x13, 0xfa, 0xa8, 0x43, 0xb2, 0xe7, 0x00, 0x22, 0xf3, 0x32, 0xfc,
+	0xd1, 0x02, 0x0b, 0xc6, 0x77, 0x42, 0xa3, 0x34, 0x0a, 0x66, 0x5f, 0xbb, 0x03, 0x2e, 0x76, 0x0a,
+	0x3b, 0x59, 0x66, 0xf4, 0x9a, 0xbc, 0xdc, 0x6e, 0x39, 0xc5, 0xde, 0xda, 0x4f, 0x97, 0x98, 0xce,
+	0x6c, 0x48, 0xb6, 0x17, 0x02, 0x0a, 0xe9, 0xca, 0xfb, 0x8a, 0xa6, 0xaa, 0x31, 0xb0, 0x3e, 0x66,
+	0xb2, 0x6b, 0xc1, 0x47, 0x83, 0xe0, 0xf2, 0xe9, 0x15, 0xfc, 0x7f, 0x22, 0x1a, 0xb7, 0x70, 0x93,
+	0x72, 0x22, 0xec, 0x2b, 0x5d, 0xc5, 0x5c, 0x99, 0xea, 0x84, 0x5d, 0xc1, 0x30, 0x29, 0xef, 0xbf,
+	0xe7, 0x94, 0x77, 0x65, 0xae, 0xdd, 0x72, 0x6c, 0x2d, 0x9d, 0x39, 0x84, 0xdd, 0x65, 0x7f, 0xe3,
+	0x44, 0xd9, 0x57, 0x66, 0xda, 0x2d, 0x67, 0x2a, 0x99, 0xd3, 0xe6, 0x08, 0x66, 0x9b, 0xe1, 0xcf,
+	0x99, 0x66, 0x90, 0x2f, 0x5c, 0x6c, 0xb7, 0x9c, 0x09, 0xfd, 0x82, 0xc6, 0x61, 0x52, 0xd2, 0xf6,
+	0x5f, 0xc1, 0xa8, 0xaf, 0x7d, 0x31, 0x5b, 0xaf, 0xdd, 0xb9, 0x04, 0xcc, 0x01, 0x44, 0x89, 0x48,
+	0x26, 0x44, 0x8f, 0x07, 0xc1, 0xac, 0x59, 0x71, 0x1e, 0x78, 0x0d, 0xec, 0xc7, 0x01, 0x96, 0x3d,
+	0x20, 0xb0, 0xfd, 0x37, 0x70, 0x9e, 0x44, 0x72, 0xb1, 0xaf, 0xa7, 0x69, 0x1f, 0xcb, 0xba, 0x9a,
+	0x39, 0x84, 0x08, 0x90, 0x68, 0xc7, 0x3c, 0xd8, 0xff, 0x06, 0x36, 0x93, 0xdb, 0x6b, 0x95, 0xcb,
+	0x5e, 0x32, 0x25, 0xa7, 0x42, 0x35, 0x54, 0xb9, 0xd4, 0x6e, 0x39, 0x0b, 0xc6, 0xe5, 0x13, 0x32,
+	0x10, 0x4d, 0x29, 0x50, 0xf5, 0xe0, 0x6d, 0x05, 0xc9, 0xcb, 0xdc, 0x8b, 0x19, 0xa7, 0x4c, 0xc5,
+	0x6c, 0x1c, 0x99, 0x27, 0x39, 0x45, 0x9a, 0x8c, 0x7a, 0x98, 0x73, 0xf3, 0xf9, 0x30, 0x8c, 0x3a,
+	0x80, 0xfc, 0xd0, 0x08, 0x5c, 0x2e, 0xaa, 0x1e, 0x0d, 0x9b, 0x01, 0x16, 0xd8, 0x4f, 0x58, 0x8c,
+	0x28, 0x16, 0x99, 0x0f, 0x8d, 0x5c, 0x31, 0x88, 0xa6, 0x25, 0xbe, 0x99, 0xc0, 0x9a, 0x0b, 0x7c,
+	0x6f, 0x04, 0x4c, 0x6d, 0xc4, 0x82, 0x9a, 0x78, 0x6d, 0x45, 0x82, 0x1d, 0xc9, 0x1e, 0xf1, 0x93,
+	0xb6, 0x39, 0xbd, 0x47, 0x4e, 0x88, 0x40, 0x34, 0x95, 0x62, 0x49, 0x8f, 0xe4, 0xb6, 0xdb, 0xe0,
+	0x0b, 0xb5, 0xdb, 0x3d, 0xa0, 0x3c, 0x48, 0xf7, 0xf9, 0xcc, 0xac, 0x18, 0xaa, 0x94, 0xda, 0x2d,
+	0x67, 0x31, 0xe3, 0x7e, 0xb7, 0x10, 0x44, 0x17, 0x25, 0x6a, 0x9c, 0x34, 0x69, 0x78, 0xb3, 0x47,
+	0x5f, 0x57, 0x59, 0xde, 0xed, 0x7b, 0xef, 0xce, 0xb3, 0x9e, 0x54, 0x74, 0xd6, 0xfa, 0x86, 0x2e,
+	0xee, 0x08, 0x4c, 0x0a, 0x2a, 0xdc, 0x20, 0x91, 0xf5, 0x8b, 0x23, 0x2f, 0xf7, 0xa5, 0xd1, 0xad,
+	0x0d, 0xa2, 0x09, 0x05, 0x18, 0xa3, 0x7e, 0x1a, 0xbd, 0x3d, 0x97, 0x04, 0x31, 0x4b, 0xa3, 0x57,
+	0xc8, 0x8d, 0x5e, 0xb7, 0x90, 0xe1, 0xbf, 0xad, 0x41, 0x13, 0xbd, 0x5e, 0x7d, 0x0c, 0xbb, 0x9c,
+	0x46, 0xe6, 0x9b, 0xed, 0x34, 0x7d, 0x5a, 0xa8, 0x5b, 0x1f, 0x52, 0x58, 0xe5, 0xfe, 0x57, 0xc7,
+	0x25, 0xeb, 0xc9, 0x71, 0xc9, 0x7a, 0x7a, 0x5c, 0xb2, 0x7e, 0x3e, 0x2e, 0x59, 0x1f, 0x3f, 0x2b,
+	0x0d, 0x3c, 0x7d, 0x56, 0x1a, 0xf8, 0xfe, 0x59, 0x69, 0xe0, 0xb5, 0xeb, 0xcf, 0x8d, 0xc6, 0x61,
+	0xf7, 0xcf, 0x2f, 0x2a, 0x38, 0xb5, 0x82, 0xfa, 0x75, 0xe4, 0xe6, 0x1f, 0x03, 0x00, 0xb2, 0xa7,
+	0x24, 0xf7, 0xa2, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -761,6 +958,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RestakePeriod.Equal(that1.RestakePeriod) {
 		return false
 	}
+	if !this.MaxRestakesPerBlock.Equal(that1.MaxRestakesPerBlock) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1068,6 +1268,84 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *RestakeSchedulerState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RestakeSchedulerState)
+	if !ok {
+		that2, ok := that.(RestakeSchedulerState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InProgress != that1.InProgress {
+		return false
+	}
+	if this.RoundStartHeight != that1.RoundStartHeight {
+		return false
+	}
+	if !bytes.Equal(this.Cursor, that1.Cursor) {
+		return false
+	}
+	if this.Processed != that1.Processed {
+		return false
+	}
+	if this.LastCompletedHeight != that1.LastCompletedHeight {
+		return false
+	}
+	return true
+}
+func (this *AutoRestakeEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoRestakeEntry)
+	if !ok {
+		that2, ok := that.(AutoRestakeEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.LastRestakeHeight != that1.LastRestakeHeight {
+		return false
+	}
+	if !this.LastRestakeAmount.Equal(that1.LastRestakeAmount) {
+		return false
+	}
+	if !this.TotalRestaked.Equal(that1.TotalRestaked) {
+		return false
+	}
+	if this.LastFailureHeight != that1.LastFailureHeight {
+		return false
+	}
+	if this.LastFailureReason != that1.LastFailureReason {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1088,6 +1366,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxRestakesPerBlock.Size()
+		i -= size
+		if _, err := m.MaxRestakesPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.RestakePeriod.Size()
 		i -= size
@@ -1641,6 +1929,135 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *RestakeSchedulerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestakeSchedulerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestakeSchedulerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCompletedHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.LastCompletedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Processed != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Processed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RoundStartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RoundStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InProgress {
+		i--
+		if m.InProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRestakeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestakeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastFailureReason) > 0 {
+		i -= len(m.LastFailureReason)
+		copy(dAtA[i:], m.LastFailureReason)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.LastFailureReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastFailureHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.LastFailureHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalRestaked.Size()
+		i -= size
+		if _, err := m.TotalRestaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LastRestakeAmount.Size()
+		i -= size
+		if _, err := m.LastRestakeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LastRestakeHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.LastRestakeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1677,6 +2094,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovDistribution(uint64(l))
 	l = m.RestakePeriod.Size()
 	n += 1 + l + sovDistribution(uint64(l))
+	l = m.MaxRestakesPerBlock.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1882,14 +2301,70 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *RestakeSchedulerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InProgress {
+		n += 2
+	}
+	if m.RoundStartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.RoundStartHeight))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Processed != 0 {
+		n += 1 + sovDistribution(uint64(m.Processed))
+	}
+	if m.LastCompletedHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.LastCompletedHeight))
+	}
+	return n
+}
+
+func (m *AutoRestakeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.LastRestakeHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.LastRestakeHeight))
+	}
+	l = m.LastRestakeAmount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.TotalRestaked.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.LastFailureHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.LastFailureHeight))
+	}
+	l = len(m.LastFailureReason)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -2173,6 +2648,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestakesPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRestakesPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3467,6 +3976,419 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestakeSchedulerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestakeSchedulerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestakeSchedulerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProgress = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundStartHeight", wireType)
+			}
+			m.RoundStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			m.Processed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletedHeight", wireType)
+			}
+			m.LastCompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRestakeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRestakeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRestakeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRestakeHeight", wireType)
+			}
+			m.LastRestakeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRestakeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRestakeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastRestakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRestaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRestaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
+			}
+			m.LastFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFailureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,4 +20,6 @@ var (
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
 
 	ErrNotEnoughStakeForAuto = sdkerrors.Register(ModuleName, 201, "Not enough stake for auto-restaking")
+	ErrNoRestakeRewards      = sdkerrors.Register(ModuleName, 202, "no rewards to restake")
+	ErrRestakeExceedsRewards = sdkerrors.Register(ModuleName, 203, "restake amount exceeds the withdrawn rewards")
 )
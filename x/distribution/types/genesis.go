@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	restakes []AutoRestakeEntry,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +24,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakeEntries:              restakes,
 	}
 }
 
@@ -37,6 +41,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakeEntries:              []AutoRestakeEntry{},
 	}
 }
 
@@ -45,5 +50,29 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
-	return gs.FeePool.ValidateGenesis()
+	if err := gs.FeePool.ValidateGenesis(); err != nil {
+		return err
+	}
+	return validateAutoRestakeEntries(gs.AutoRestakeEntries)
+}
+
+// validateAutoRestakeEntries checks that every auto-restake entry has valid
+// addresses and that no delegator-validator pair appears twice
+func validateAutoRestakeEntries(entries []AutoRestakeEntry) error {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if _, err := sdk.AccAddressFromBech32(entry.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-restake entry delegator address %s: %w", entry.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-restake entry validator address %s: %w", entry.ValidatorAddress, err)
+		}
+
+		pair := entry.DelegatorAddress + "/" + entry.ValidatorAddress
+		if seen[pair] {
+			return fmt.Errorf("duplicate auto-restake entry for %s with %s", entry.DelegatorAddress, entry.ValidatorAddress)
+		}
+		seen[pair] = true
+	}
+	return nil
 }
@@ -346,6 +346,9 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// auto_restake_entries defines the auto-restake entries of all delegators at
+	// genesis.
+	AutoRestakeEntries []AutoRestakeEntry `protobuf:"bytes,11,rep,name=auto_restake_entries,json=autoRestakeEntries,proto3" json:"auto_restake_entries" yaml:"auto_restake_entries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -397,71 +400,75 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x45,
-	0x04, 0x55, 0xac, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
-	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0xc2, 0x3f, 0x00, 0x47,
-	0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a, 0x28,
-	0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x33, 0xb3, 0x5f, 0xf6, 0xda, 0x38, 0xa1, 0x39, 0x25,
-	0x1e, 0xff, 0xf6, 0xbd, 0xf7, 0x7b, 0xf3, 0xfb, 0x58, 0x83, 0xd7, 0x3d, 0xca, 0x3b, 0x94, 0x57,
-	0x7d, 0xc2, 0x05, 0x23, 0xf5, 0x9e, 0x20, 0x34, 0xa8, 0xf6, 0x37, 0xeb, 0x58, 0xb8, 0x9b, 0xd5,
-	0x26, 0x0e, 0x30, 0x27, 0xdc, 0xee, 0x32, 0x2a, 0xa8, 0xb9, 0xaa, 0x42, 0xed, 0x74, 0xa8, 0xad,
-	0x43, 0xcb, 0x8b, 0x4d, 0xda, 0xa4, 0x32, 0xae, 0x1a, 0xfe, 0xa7, 0x1e, 0x29, 0x57, 0x34, 0x7a,
-	0xdd, 0xe5, 0x38, 0x46, 0xf5, 0x28, 0x09, 0xf4, 0xf7, 0xf6, 0x38, 0xf6, 0x0c, 0x8f, 0x8c, 0x87,
-	0x4f, 0x0d, 0xb0, 0xf4, 0x00, 0xb7, 0x71, 0xd3, 0x15, 0x94, 0x7d, 0x46, 0x44, 0xcb, 0x67, 0xee,
-	0xc1, 0xa3, 0xa0, 0x41, 0xcd, 0x47, 0xe0, 0x15, 0x3f, 0xfa, 0xa2, 0xe6, 0xfa, 0x3e, 0xc3, 0x9c,
-	0x97, 0x8c, 0x75, 0x63, 0xe3, 0xaa, 0xb3, 0x76, 0x76, 0x6c, 0x95, 0x0e, 0xdd, 0x4e, 0x7b, 0x1b,
-	0x0e, 0x85, 0x40, 0xb4, 0x10, 0x9f, 0xed, 0xa8, 0x23, 0xf3, 0x21, 0x58, 0x38, 0xd0, 0xd0, 0x31,
-	0x52, 0x51, 0x22, 0xad, 0x9e, 0x1d, 0x5b, 0x2b, 0x0a, 0x69, 0x30, 0x02, 0xa2, 0xeb, 0xd1, 0x91,
-	0xc6, 0xd9, 0x9e, 0xfd, 0xf6, 0xc8, 0x2a, 0xfc, 0x75, 0x64, 0x15, 0xe0, 0x93, 0x22, 0xb8, 0xfd,
-	0xa9, 0xdb, 0x26, 0x7e, 0x48, 0xf3, 0xb8, 0x27, 0xb8, 0x70, 0x03, 0x9f, 0x04, 0x4d, 0x84, 0x0f,
-	0x5c, 0xe6, 0x73, 0x84, 0x3d, 0xca, 0xfc, 0x30, 0x85, 0x7e, 0x14, 0x34, 0x3a, 0x85, 0xa1, 0x10,
-	0x88, 0x16, 0xe2, 0xb3, 0x28, 0x85, 0x23, 0x03, 0xdc, 0xa0, 0x09, 0x4f, 0x8d, 0x29, 0xa2, 0x52,
-	0x71, 0x7d, 0x6a, 0x63, 0x6e, 0x6b, 0x4d, 0xdb, 0x6e, 0x87, 0xd7, 0x12, 0xdd, 0xa0, 0xfd, 0x00,
-	0x7b, 0xbb, 0x94, 0x04, 0xce, 0x27, 0xcf, 0x8e, 0xad, 0xc2, 0xd9, 0xb1, 0x55, 0x56, 0x7c, 0x39,
-	0x30, 0xf0, 0xe7, 0x3f, 0xac, 0x7b, 0x4d, 0x22, 0x5a, 0xbd, 0xba, 0xed, 0xd1, 0x4e, 0x55, 0x5f,
-	0xa2, 0xfa, 0xf3, 0x06, 0xf7, 0xbf, 0xac, 0x8a, 0xc3, 0x2e, 0xe6, 0x11, 0x22, 0x47, 0x26, 0x1d,
-	0xca, 0x39, 0xe5, 0xce, 0xdf, 0x06, 0xb8, 0x1b, 0xbb, 0xb3, 0xe3, 0x79, 0xbd, 0x4e, 0xaf, 0xed,
-	0x0a, 0xec, 0xef, 0xd2, 0x4e, 0x87, 0x70, 0x4e, 0x68, 0xf0, 0xe2, 0x0d, 0x3a, 0x04, 0x73, 0x6e,
-	0xc2, 0x24, 0xaf, 0x77, 0x6e, 0xeb, 0x3d, 0x7b, 0x4c, 0x85, 0xdb, 0xe3, 0x25, 0x3a, 0x65, 0x6d,
-	0x9b, 0xa9, 0x54, 0xa4, 0xd0, 0x21, 0x4a, 0x73, 0xa5, 0x12, 0xff, 0xc7, 0x00, 0xeb, 0x31, 0xea,
-	0xc7, 0x84, 0x0b, 0xca, 0x88, 0xe7, 0xb6, 0x2f, 0xad, 0x2a, 0x96, 0xc1, 0x74, 0x17, 0x33, 0x42,
-	0x55, 0xbe, 0x57, 0x90, 0xfe, 0x64, 0x12, 0x30, 0x13, 0x15, 0xc8, 0x94, 0x34, 0xe2, 0x9d, 0xc9,
-	0x8c, 0x18, 0x92, 0xec, 0x2c, 0x6b, 0x13, 0x5e, 0x56, 0xaa, 0xa2, 0x7a, 0x41, 0x11, 0x7e, 0x2a,
-	0xf9, 0xdf, 0x0d, 0x70, 0x2b, 0x46, 0xda, 0xed, 0x31, 0x86, 0x03, 0x71, 0x69, 0x99, 0x37, 0x92,
-	0x0c, 0xd5, 0x55, 0xbf, 0x35, 0x59, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x69, 0x11, 0xac, 0xc6,
-	0x93, 0x6a, 0x5f, 0xb8, 0x4c, 0x90, 0xa0, 0x19, 0x4e, 0xaa, 0x24, 0xb9, 0x17, 0x35, 0xaf, 0x72,
-	0x7d, 0x2a, 0x5e, 0xc8, 0xa7, 0x1e, 0xb8, 0xc6, 0xb5, 0xd6, 0x1a, 0x09, 0x1a, 0x54, 0xd7, 0xc3,
-	0xd6, 0x58, 0xb7, 0x72, 0xd3, 0x74, 0xd6, 0xb4, 0x57, 0x8b, 0x8a, 0x3e, 0x03, 0x0b, 0xd1, 0x3c,
-	0x4f, 0xc5, 0xa6, 0x6c, 0xfb, 0xb1, 0x08, 0x6e, 0xc6, 0xee, 0xef, 0xb7, 0x5d, 0xde, 0xfa, 0xb0,
-	0x2f, 0x2f, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0x25, 0xa2, 0x5e, 0x50, 0x9f, 0x52, 0x3d,
-	0x32, 0x95, 0xe9, 0x91, 0xaf, 0xc1, 0x52, 0x82, 0xcb, 0x43, 0x61, 0x35, 0x1c, 0x2a, 0x2b, 0x5d,
-	0x91, 0x0e, 0xdd, 0x9f, 0xac, 0x9e, 0x92, 0x8c, 0x9c, 0x45, 0xed, 0xcf, 0xbc, 0x12, 0x2d, 0xc1,
-	0x20, 0xba, 0xd1, 0x1f, 0x0e, 0x4d, 0xd9, 0xf3, 0xcd, 0x1c, 0x98, 0xff, 0x48, 0x2d, 0xe5, 0x7d,
-	0xe1, 0x0a, 0x6c, 0x22, 0x30, 0xdd, 0x75, 0x99, 0xdb, 0x51, 0x36, 0xcc, 0x6d, 0xdd, 0x19, 0xab,
-	0x63, 0x4f, 0x86, 0x3a, 0x4b, 0x9a, 0xfa, 0x9a, 0xa2, 0x56, 0x00, 0x10, 0x69, 0x24, 0xf3, 0x73,
-	0x30, 0xdb, 0xc0, 0xb8, 0xd6, 0xa5, 0xb4, 0xad, 0xbb, 0xe5, 0xee, 0x58, 0xd4, 0x87, 0x18, 0xef,
-	0x51, 0xda, 0x76, 0x56, 0x34, 0xec, 0x75, 0x05, 0x1b, 0x61, 0x40, 0x34, 0xd3, 0x50, 0x11, 0xe6,
-	0x0f, 0x06, 0x28, 0x25, 0x25, 0x1d, 0xaf, 0xd0, 0xb0, 0x24, 0xc2, 0xd1, 0x33, 0x35, 0x79, 0xa9,
-	0xa5, 0x77, 0xbf, 0xf3, 0x9a, 0x26, 0xb6, 0x06, 0x9b, 0x26, 0xcb, 0x00, 0xd1, 0xb2, 0x9f, 0xf7,
-	0xbc, 0xec, 0xa0, 0x2e, 0xc3, 0x7d, 0x42, 0x7b, 0xbc, 0xd6, 0x65, 0xb4, 0x4b, 0x39, 0x66, 0xf2,
-	0x62, 0x33, 0x75, 0x35, 0x14, 0x02, 0xd1, 0x42, 0x74, 0xb6, 0xa7, 0x8f, 0xcc, 0xef, 0x47, 0x6c,
-	0xde, 0x97, 0x64, 0x76, 0x1f, 0x4c, 0x56, 0x26, 0xa3, 0x5e, 0x11, 0x1c, 0xf8, 0xdf, 0xbb, 0x39,
-	0x6f, 0xd9, 0x9a, 0xbf, 0x1a, 0xe0, 0x76, 0xaa, 0x2d, 0x92, 0x6d, 0x54, 0xf3, 0xe2, 0x0d, 0xc6,
-	0x4b, 0xd3, 0x52, 0xe3, 0xce, 0xff, 0xd8, 0x82, 0x5a, 0xe6, 0x7d, 0x2d, 0x73, 0x63, 0xa8, 0x21,
-	0xf3, 0x99, 0x21, 0xb2, 0xfa, 0x63, 0x71, 0xb9, 0xf9, 0x8b, 0x01, 0xd6, 0x12, 0x9c, 0x56, 0xbc,
-	0x79, 0x62, 0x83, 0x67, 0xa4, 0xf8, 0xf7, 0x2f, 0xb8, 0xb9, 0xb4, 0xf0, 0x7b, 0x5a, 0xf8, 0x9d,
-	0x41, 0xe1, 0xc3, 0x84, 0x10, 0x95, 0xfb, 0x23, 0xe1, 0xc2, 0x17, 0xb0, 0x9b, 0xc9, 0xd3, 0x9e,
-	0x5a, 0x23, 0xb1, 0xd6, 0x59, 0xa9, 0x75, 0xfb, 0x22, 0x3b, 0x48, 0x0b, 0xdd, 0xd0, 0x42, 0xd7,
-	0x07, 0x85, 0x0e, 0x50, 0x41, 0xb4, 0xd2, 0xcf, 0x07, 0x32, 0x9f, 0x64, 0x9a, 0x31, 0x33, 0x9f,
-	0x79, 0xe9, 0xaa, 0x54, 0xf8, 0xee, 0xf9, 0xe7, 0xbe, 0xd6, 0x37, 0xb2, 0x25, 0xb3, 0x3c, 0xe9,
-	0x96, 0x4c, 0xa3, 0xf0, 0xb0, 0x8f, 0x96, 0x73, 0x07, 0x2e, 0x2f, 0x01, 0xa9, 0xed, 0xed, 0xf3,
-	0x4e, 0x5c, 0xad, 0xec, 0x55, 0xad, 0xec, 0xd6, 0xa0, 0x73, 0x69, 0x0e, 0x88, 0x16, 0x73, 0x06,
-	0x71, 0x6a, 0xbf, 0x3b, 0x8f, 0x7f, 0x3a, 0xa9, 0x18, 0xcf, 0x4e, 0x2a, 0xc6, 0xf3, 0x93, 0x8a,
-	0xf1, 0xe7, 0x49, 0xc5, 0xf8, 0xee, 0xb4, 0x52, 0x78, 0x7e, 0x5a, 0x29, 0xfc, 0x76, 0x5a, 0x29,
-	0x7c, 0xb1, 0x39, 0xf6, 0xed, 0xf8, 0xab, 0xec, 0xef, 0x1d, 0xf9, 0xb2, 0x5c, 0x9f, 0x96, 0xbf,
-	0x70, 0xde, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xee, 0xfe, 0x4a, 0x91, 0x0d, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x21, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0xfc, 0x6c, 0x93, 0xd4, 0x4e, 0x27, 0x45,
+	0x04, 0x55, 0xb5, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x5b, 0xe8, 0xa9, 0x61, 0x22, 0x01,
+	0xe2, 0x62, 0x4d, 0x76, 0xc7, 0xf6, 0xa8, 0xf6, 0x8e, 0x35, 0x33, 0xeb, 0x10, 0xce, 0x1c, 0x38,
+	0x21, 0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a,
+	0x28, 0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x3b, 0xb3, 0x7f, 0xf6, 0xda, 0x38, 0xa1, 0x39,
+	0x25, 0x1e, 0xbf, 0xf9, 0xbe, 0xef, 0x7d, 0xf3, 0xde, 0xbc, 0x31, 0x78, 0xd3, 0x61, 0xa2, 0xcd,
+	0x44, 0xd5, 0xa5, 0x42, 0x72, 0xba, 0xef, 0x4b, 0xca, 0xbc, 0x6a, 0x77, 0x63, 0x9f, 0x48, 0xbc,
+	0x51, 0x6d, 0x10, 0x8f, 0x08, 0x2a, 0x2a, 0x1d, 0xce, 0x24, 0x33, 0x97, 0x55, 0x68, 0x25, 0x1d,
+	0x5a, 0xd1, 0xa1, 0x4b, 0x73, 0x0d, 0xd6, 0x60, 0x61, 0x5c, 0x35, 0xf8, 0x4f, 0x6d, 0x59, 0x2a,
+	0x69, 0xf4, 0x7d, 0x2c, 0x48, 0x8c, 0xea, 0x30, 0xea, 0xe9, 0xef, 0x2b, 0xc3, 0xd8, 0x33, 0x3c,
+	0x61, 0x3c, 0x7c, 0x66, 0x80, 0xf9, 0xfb, 0xa4, 0x45, 0x1a, 0x58, 0x32, 0xfe, 0x19, 0x95, 0x4d,
+	0x97, 0xe3, 0x83, 0x47, 0x5e, 0x9d, 0x99, 0x8f, 0xc0, 0x6b, 0x6e, 0xf4, 0x45, 0x0d, 0xbb, 0x2e,
+	0x27, 0x42, 0x58, 0xc6, 0xaa, 0xb1, 0x7e, 0xc5, 0x5e, 0x39, 0x3b, 0x2e, 0x5b, 0x87, 0xb8, 0xdd,
+	0xda, 0x82, 0x7d, 0x21, 0x10, 0xcd, 0xc6, 0x6b, 0xdb, 0x6a, 0xc9, 0x7c, 0x08, 0x66, 0x0f, 0x34,
+	0x74, 0x8c, 0x54, 0x0c, 0x91, 0x96, 0xcf, 0x8e, 0xcb, 0x8b, 0x0a, 0xa9, 0x37, 0x02, 0xa2, 0x6b,
+	0xd1, 0x92, 0xc6, 0xd9, 0x9a, 0xfa, 0xe6, 0xa8, 0x5c, 0xf8, 0xeb, 0xa8, 0x5c, 0x80, 0x4f, 0x8b,
+	0xe0, 0xd6, 0xa7, 0xb8, 0x45, 0xdd, 0x80, 0xe6, 0xb1, 0x2f, 0x85, 0xc4, 0x9e, 0x4b, 0xbd, 0x06,
+	0x22, 0x07, 0x98, 0xbb, 0x02, 0x11, 0x87, 0x71, 0x37, 0x48, 0xa1, 0x1b, 0x05, 0x0d, 0x4e, 0xa1,
+	0x2f, 0x04, 0xa2, 0xd9, 0x78, 0x2d, 0x4a, 0xe1, 0xc8, 0x00, 0xd7, 0x59, 0xc2, 0x53, 0xe3, 0x8a,
+	0xc8, 0x2a, 0xae, 0x8e, 0xad, 0x4f, 0x6f, 0xae, 0x68, 0xdb, 0x2b, 0xc1, 0xb1, 0x44, 0x27, 0x58,
+	0xb9, 0x4f, 0x9c, 0x1d, 0x46, 0x3d, 0xfb, 0x93, 0xe7, 0xc7, 0xe5, 0xc2, 0xd9, 0x71, 0x79, 0x49,
+	0xf1, 0xe5, 0xc0, 0xc0, 0x9f, 0xff, 0x28, 0xdf, 0x69, 0x50, 0xd9, 0xf4, 0xf7, 0x2b, 0x0e, 0x6b,
+	0x57, 0xf5, 0x21, 0xaa, 0x3f, 0x77, 0x85, 0xfb, 0xa4, 0x2a, 0x0f, 0x3b, 0x44, 0x44, 0x88, 0x02,
+	0x99, 0xac, 0x2f, 0xe7, 0x94, 0x3b, 0x7f, 0x1b, 0xe0, 0x76, 0xec, 0xce, 0xb6, 0xe3, 0xf8, 0x6d,
+	0xbf, 0x85, 0x25, 0x71, 0x77, 0x58, 0xbb, 0x4d, 0x85, 0xa0, 0xcc, 0x7b, 0xf9, 0x06, 0x1d, 0x82,
+	0x69, 0x9c, 0x30, 0x85, 0xc7, 0x3b, 0xbd, 0xf9, 0x7e, 0x65, 0x48, 0x85, 0x57, 0x86, 0x4b, 0xb4,
+	0x97, 0xb4, 0x6d, 0xa6, 0x52, 0x91, 0x42, 0x87, 0x28, 0xcd, 0x95, 0x4a, 0xfc, 0x1f, 0x03, 0xac,
+	0xc6, 0xa8, 0x1f, 0x53, 0x21, 0x19, 0xa7, 0x0e, 0x6e, 0x5d, 0x5a, 0x55, 0x2c, 0x80, 0x89, 0x0e,
+	0xe1, 0x94, 0xa9, 0x7c, 0xc7, 0x91, 0xfe, 0x64, 0x52, 0x30, 0x19, 0x15, 0xc8, 0x58, 0x68, 0xc4,
+	0xbb, 0xa3, 0x19, 0xd1, 0x27, 0xd9, 0x5e, 0xd0, 0x26, 0xbc, 0xaa, 0x54, 0x45, 0xf5, 0x82, 0x22,
+	0xfc, 0x54, 0xf2, 0xbf, 0x1b, 0xe0, 0x66, 0x8c, 0xb4, 0xe3, 0x73, 0x4e, 0x3c, 0x79, 0x69, 0x99,
+	0xd7, 0x93, 0x0c, 0xd5, 0x51, 0xbf, 0x3d, 0x5a, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x59, 0x11,
+	0x2c, 0xc7, 0x37, 0xd5, 0x9e, 0xc4, 0x5c, 0x52, 0xaf, 0x11, 0xdc, 0x54, 0x49, 0x72, 0x2f, 0xeb,
+	0xbe, 0xca, 0xf5, 0xa9, 0x78, 0x21, 0x9f, 0x7c, 0x70, 0x55, 0x68, 0xad, 0x35, 0xea, 0xd5, 0x99,
+	0xae, 0x87, 0xcd, 0xa1, 0x6e, 0xe5, 0xa6, 0x69, 0xaf, 0x68, 0xaf, 0xe6, 0x14, 0x7d, 0x06, 0x16,
+	0xa2, 0x19, 0x91, 0x8a, 0x4d, 0xd9, 0xf6, 0x63, 0x11, 0xdc, 0x88, 0xdd, 0xdf, 0x6b, 0x61, 0xd1,
+	0x7c, 0xd0, 0x0d, 0x0f, 0xe0, 0x12, 0x7a, 0xa1, 0x49, 0x68, 0xa3, 0x29, 0xa3, 0x5e, 0x50, 0x9f,
+	0x52, 0x3d, 0x32, 0x96, 0xe9, 0x91, 0xaf, 0xc0, 0x7c, 0x82, 0x2b, 0x02, 0x61, 0x35, 0x12, 0x28,
+	0xb3, 0xc6, 0x43, 0x87, 0xee, 0x8d, 0x56, 0x4f, 0x49, 0x46, 0xf6, 0x9c, 0xf6, 0x67, 0x46, 0x89,
+	0x0e, 0xc1, 0x20, 0xba, 0xde, 0xed, 0x0f, 0x4d, 0xd9, 0xf3, 0xed, 0x0c, 0x98, 0xf9, 0x48, 0x0d,
+	0xe5, 0x3d, 0x89, 0x25, 0x31, 0x11, 0x98, 0xe8, 0x60, 0x8e, 0xdb, 0xca, 0x86, 0xe9, 0xcd, 0xb5,
+	0xa1, 0x3a, 0x76, 0xc3, 0x50, 0x7b, 0x5e, 0x53, 0x5f, 0x55, 0xd4, 0x0a, 0x00, 0x22, 0x8d, 0x64,
+	0x7e, 0x0e, 0xa6, 0xea, 0x84, 0xd4, 0x3a, 0x8c, 0xb5, 0x74, 0xb7, 0xdc, 0x1e, 0x8a, 0xfa, 0x90,
+	0x90, 0x5d, 0xc6, 0x5a, 0xf6, 0xa2, 0x86, 0xbd, 0xa6, 0x60, 0x23, 0x0c, 0x88, 0x26, 0xeb, 0x2a,
+	0xc2, 0xfc, 0xc1, 0x00, 0x56, 0x52, 0xd2, 0xf1, 0x08, 0x0d, 0x4a, 0x22, 0xb8, 0x7a, 0xc6, 0x46,
+	0x2f, 0xb5, 0xf4, 0xec, 0xb7, 0xdf, 0xd0, 0xc4, 0xe5, 0xde, 0xa6, 0xc9, 0x32, 0x40, 0xb4, 0xe0,
+	0xe6, 0xed, 0x0f, 0x3b, 0xa8, 0xc3, 0x49, 0x97, 0x32, 0x5f, 0xd4, 0x3a, 0x9c, 0x75, 0x98, 0x20,
+	0xdc, 0x1a, 0xef, 0xad, 0xab, 0xbe, 0x10, 0x88, 0x66, 0xa3, 0xb5, 0x5d, 0xbd, 0x64, 0x7e, 0x3f,
+	0x60, 0xf2, 0xbe, 0x12, 0x66, 0xf7, 0xe1, 0x68, 0x65, 0x32, 0xe8, 0x89, 0x60, 0xc3, 0xff, 0x9e,
+	0xcd, 0x79, 0xc3, 0xd6, 0xfc, 0xd5, 0x00, 0xb7, 0x52, 0x6d, 0x91, 0x4c, 0xa3, 0x9a, 0x13, 0x4f,
+	0x30, 0x61, 0x4d, 0x84, 0x1a, 0xb7, 0xff, 0xc7, 0x14, 0xd4, 0x32, 0xef, 0x69, 0x99, 0xeb, 0x7d,
+	0x0d, 0x99, 0xcf, 0x0c, 0x51, 0xb9, 0x3b, 0x14, 0x57, 0x98, 0xbf, 0x18, 0x60, 0x25, 0xc1, 0x69,
+	0xc6, 0x93, 0x27, 0x36, 0x78, 0x32, 0x14, 0xff, 0xc1, 0x05, 0x27, 0x97, 0x16, 0x7e, 0x47, 0x0b,
+	0x5f, 0xeb, 0x15, 0xde, 0x4f, 0x08, 0xd1, 0x52, 0x77, 0x20, 0x5c, 0xf0, 0x00, 0xbb, 0x91, 0xec,
+	0x76, 0xd4, 0x18, 0x89, 0xb5, 0x4e, 0x85, 0x5a, 0xb7, 0x2e, 0x32, 0x83, 0xb4, 0xd0, 0x75, 0x2d,
+	0x74, 0xb5, 0x57, 0x68, 0x0f, 0x15, 0x44, 0x8b, 0xdd, 0x7c, 0x20, 0xf3, 0x69, 0xa6, 0x19, 0x33,
+	0xf7, 0xb3, 0xb0, 0xae, 0x84, 0x0a, 0xdf, 0x3b, 0xff, 0xbd, 0xaf, 0xf5, 0x0d, 0x6c, 0xc9, 0x2c,
+	0x4f, 0xba, 0x25, 0xd3, 0x28, 0x22, 0xe8, 0xa3, 0x85, 0xdc, 0x0b, 0x57, 0x58, 0x20, 0xd4, 0xf6,
+	0xce, 0x79, 0x6f, 0x5c, 0xad, 0xec, 0x75, 0xad, 0xec, 0x66, 0xaf, 0x73, 0x69, 0x0e, 0x88, 0xe6,
+	0x72, 0x2e, 0x62, 0x61, 0x7e, 0x6d, 0x80, 0x39, 0xec, 0x4b, 0x56, 0xe3, 0x44, 0x48, 0xfc, 0x84,
+	0xd4, 0x88, 0x27, 0x39, 0x25, 0xc2, 0x9a, 0x0e, 0x35, 0xdd, 0x1d, 0xaa, 0x69, 0xdb, 0x97, 0x0c,
+	0xa9, 0x7d, 0x0f, 0x3c, 0xc9, 0x0f, 0xed, 0x35, 0x2d, 0x65, 0x59, 0x49, 0xc9, 0x03, 0x86, 0xc8,
+	0xc4, 0xd9, 0x6d, 0x94, 0xa4, 0x9e, 0x19, 0xf6, 0xe3, 0x9f, 0x4e, 0x4a, 0xc6, 0xf3, 0x93, 0x92,
+	0xf1, 0xe2, 0xa4, 0x64, 0xfc, 0x79, 0x52, 0x32, 0xbe, 0x3b, 0x2d, 0x15, 0x5e, 0x9c, 0x96, 0x0a,
+	0xbf, 0x9d, 0x96, 0x0a, 0x5f, 0x6c, 0x0c, 0x7d, 0xa4, 0x7f, 0x99, 0xfd, 0xd9, 0x15, 0xbe, 0xd9,
+	0xf7, 0x27, 0xc2, 0x1f, 0x5a, 0x6f, 0xfd, 0x3b, 0x00, 0xab, 0xf9, 0x5f, 0xd6, 0x18, 0x0e, 0x00,
+	0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -787,6 +794,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoRestakeEntries) > 0 {
+		for iNdEx := len(m.AutoRestakeEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakeEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1106,6 +1127,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoRestakeEntries) > 0 {
+		for _, e := range m.AutoRestakeEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2374,6 +2401,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakeEntries = append(m.AutoRestakeEntries, AutoRestakeEntry{})
+			if err := m.AutoRestakeEntries[len(m.AutoRestakeEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesisAutoRestakeEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []AutoRestakeEntry
+		expErr  bool
+	}{
+		{"no entries", nil, false},
+		{"valid entries", []AutoRestakeEntry{
+			NewAutoRestakeEntry(delAddr1, valAddr1),
+			NewAutoRestakeEntry(delAddr1, valAddr2),
+			NewAutoRestakeEntry(delAddr2, valAddr1),
+		}, false},
+		{"duplicate entry", []AutoRestakeEntry{
+			NewAutoRestakeEntry(delAddr1, valAddr1),
+			NewAutoRestakeEntry(delAddr2, valAddr1),
+			NewAutoRestakeEntry(delAddr1, valAddr1),
+		}, true},
+		{"invalid delegator address", []AutoRestakeEntry{
+			{DelegatorAddress: "invalid", ValidatorAddress: valAddr1.String()},
+		}, true},
+		{"empty delegator address", []AutoRestakeEntry{
+			NewAutoRestakeEntry(emptyDelAddr, valAddr1),
+		}, true},
+		{"validator address as delegator", []AutoRestakeEntry{
+			{DelegatorAddress: valAddr1.String(), ValidatorAddress: valAddr1.String()},
+		}, true},
+		{"invalid validator address", []AutoRestakeEntry{
+			{DelegatorAddress: delAddr1.String(), ValidatorAddress: delAddr1.String()},
+		}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.AutoRestakeEntries = tc.entries
+			err := ValidateGenesis(gs)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -54,7 +54,9 @@ var (
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
-	AutoRestakeEntryPrefix = []byte{0xF0}
+	AutoRestakeEntryPrefix          = []byte{0xF0} // prefix for auto-restake entries
+	RestakeSchedulerStateKey        = []byte{0xF1} // key for the auto-restake scheduler state
+	AutoRestakeValidatorIndexPrefix = []byte{0xF2} // prefix for auto-restake entries indexed by validator
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -215,3 +217,47 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoRestakeEntryKey creates the key for a delegator's auto-restake entry
+// with a validator.
+func GetAutoRestakeEntryKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	// key is in the format:
+	// 0xF0<delAddrLen (1 Byte)><delAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	return append(GetAutoRestakeEntriesPrefix(delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetAutoRestakeEntriesPrefix creates the prefix for all the auto-restake entries
+// of a delegator.
+func GetAutoRestakeEntriesPrefix(delAddr sdk.AccAddress) []byte {
+	return append(AutoRestakeEntryPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoRestakeEntryAddresses gets the addresses from an auto-restake entry key.
+func GetAutoRestakeEntryAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0xF0<delAddrLen (1 Byte)><delAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+
+	valAddrLen := int(key[2+delAddrLen])
+	addr := key[3+delAddrLen:]
+	kv.AssertKeyLength(addr, valAddrLen)
+
+	return delAddr, sdk.ValAddress(addr)
+}
+
+// GetAutoRestakeValidatorIndexKey creates the key indexing a delegator's
+// auto-restake entry by validator.
+func GetAutoRestakeValidatorIndexKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	// key is in the format:
+	// 0xF2<valAddrLen (1 Byte)><valAddr_Bytes><delAddrLen (1 Byte)><delAddr_Bytes>
+	return append(GetAutoRestakeValidatorIndexPrefix(valAddr), address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoRestakeValidatorIndexPrefix creates the prefix of the index of all the
+// auto-restake entries of a validator.
+func GetAutoRestakeValidatorIndexPrefix(valAddr sdk.ValAddress) []byte {
+	return append(AutoRestakeValidatorIndexPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
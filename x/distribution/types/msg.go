@@ -13,6 +13,7 @@ const (
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
 	TypeMsgSetAutoRestake              = "set_auto_restake"
+	TypeMsgRestakeDelegatorReward      = "restake_delegator_reward"
 )
 
 // Verify interface at compile time
@@ -209,3 +210,51 @@ func (msg MsgSetAutoRestake) GetSignBytes() []byte {
 }
 
 var _ legacytx.LegacyMsg = (*MsgSetAutoRestake)(nil)
+
+// NewMsgRestakeDelegatorReward returns a new MsgRestakeDelegatorReward
+// restaking amount of the rewards of a delegation.
+func NewMsgRestakeDelegatorReward(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgRestakeDelegatorReward {
+	return &MsgRestakeDelegatorReward{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route returns the MsgRestakeDelegatorReward message route.
+func (msg MsgRestakeDelegatorReward) Route() string { return ModuleName }
+
+// Type returns the MsgRestakeDelegatorReward message type.
+func (msg MsgRestakeDelegatorReward) Type() string { return TypeMsgRestakeDelegatorReward }
+
+// GetSigners returns the delegator, whose rewards are restaked.
+func (msg MsgRestakeDelegatorReward) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgRestakeDelegatorReward message
+// that the expected signer needs to sign.
+func (msg MsgRestakeDelegatorReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgRestakeDelegatorReward message validation.
+func (msg MsgRestakeDelegatorReward) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+var _ legacytx.LegacyMsg = (*MsgRestakeDelegatorReward)(nil)
@@ -93,3 +93,27 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgRestakeDelegatorReward
+func TestMsgRestakeDelegatorReward(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, sdk.NewInt64Coin("stake", 10), true},
+		{emptyDelAddr, valAddr1, sdk.NewInt64Coin("stake", 10), false},
+		{delAddr1, emptyValAddr, sdk.NewInt64Coin("stake", 10), false},
+		{delAddr1, valAddr1, sdk.NewInt64Coin("stake", 0), false},
+		{delAddr1, valAddr1, sdk.Coin{Denom: "1stake", Amount: sdk.NewInt(10)}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgRestakeDelegatorReward(tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -19,6 +19,7 @@ var (
 	ParamSecretFoundationAddress     = []byte("secretfoundationaddress")
 	ParamMinimumRestakeThreshold     = []byte("minimumrestakethreshold")
 	ParamRestakePeriod               = []byte("restakeperiod")
+	ParamMaxRestakesPerBlock         = []byte("maxrestakesperblock")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -37,6 +38,7 @@ func DefaultParams() Params {
 		WithdrawAddrEnabled:     true,
 		MinimumRestakeThreshold: sdk.NewDec(10_000_000),
 		RestakePeriod:           sdk.NewInt(1000),
+		MaxRestakesPerBlock:     sdk.NewInt(100),
 	}
 }
 
@@ -56,6 +58,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamSecretFoundationAddress, &p.SecretFoundationAddress, validateSecretFoundationAddress),
 		paramtypes.NewParamSetPair(ParamMinimumRestakeThreshold, &p.MinimumRestakeThreshold, validateMinimumRestakeThreshold),
 		paramtypes.NewParamSetPair(ParamRestakePeriod, &p.RestakePeriod, validateRestakePeriod),
+		paramtypes.NewParamSetPair(ParamMaxRestakesPerBlock, &p.MaxRestakesPerBlock, validateMaxRestakesPerBlock),
 	}
 }
 
@@ -88,6 +91,13 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	if err := validateRestakePeriod(p.RestakePeriod); err != nil {
+		return err
+	}
+	if err := validateMaxRestakesPerBlock(p.MaxRestakesPerBlock); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -227,3 +237,19 @@ func validateRestakePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxRestakesPerBlock(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid max restakes per block parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max restakes per block must be not nil")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("max restakes per block must be positive: %s", v)
+	}
+
+	return nil
+}
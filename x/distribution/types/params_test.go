@@ -11,6 +11,7 @@ import (
 
 func TestParams_ValidateBasic(t *testing.T) {
 	toDec := sdk.MustNewDecFromStr
+	period, maxRestakes := sdk.NewInt(1000), sdk.NewInt(100)
 
 	type fields struct {
 		CommunityTax            sdk.Dec
@@ -19,18 +20,24 @@ func TestParams_ValidateBasic(t *testing.T) {
 		WithdrawAddrEnabled     bool
 		SecretFoundationTax     sdk.Dec
 		MinimumRestakeThreshold sdk.Dec
+		RestakePeriod           sdk.Int
+		MaxRestakesPerBlock     sdk.Int
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("1000000000")}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0")}, true},
-		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0")}, true},
-		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, toDec("0.1"), toDec("0")}, true},
-		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0")}, true},
-		{"negative restake threshold", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("-3")}, false},
+		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("1000000000"), period, maxRestakes}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), period, maxRestakes}, true},
+		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), period, maxRestakes}, true},
+		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, toDec("0.1"), toDec("0"), period, maxRestakes}, true},
+		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), period, maxRestakes}, true},
+		{"negative restake threshold", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("-3"), period, maxRestakes}, false},
+		{"nil restake period", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), sdk.Int{}, maxRestakes}, true},
+		{"restake period too short", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), sdk.NewInt(999), maxRestakes}, true},
+		{"nil max restakes per block", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), period, sdk.Int{}}, true},
+		{"zero max restakes per block", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, toDec("0.1"), toDec("0"), period, sdk.ZeroInt()}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -40,6 +47,8 @@ func TestParams_ValidateBasic(t *testing.T) {
 				BonusProposerReward: tt.fields.BonusProposerReward,
 				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
 				SecretFoundationTax: tt.fields.SecretFoundationTax,
+				RestakePeriod:       tt.fields.RestakePeriod,
+				MaxRestakesPerBlock: tt.fields.MaxRestakesPerBlock,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
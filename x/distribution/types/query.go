@@ -43,6 +43,6 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 }
 
 // NewRestakingEntriesResponse constructs a QueryRestakingEntriesResponse
-func NewRestakingEntriesResponse(validators []string) QueryRestakingEntriesResponse {
-	return QueryRestakingEntriesResponse{Validators: validators}
+func NewRestakingEntriesResponse(validators []string, entries []AutoRestakeEntry) QueryRestakingEntriesResponse {
+	return QueryRestakingEntriesResponse{Validators: validators, Entries: entries}
 }
@@ -32,6 +32,98 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryRestakeSchedulerRequest is the request type for the Query/RestakeScheduler RPC method.
+type QueryRestakeSchedulerRequest struct {
+}
+
+func (m *QueryRestakeSchedulerRequest) Reset()         { *m = QueryRestakeSchedulerRequest{} }
+func (m *QueryRestakeSchedulerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakeSchedulerRequest) ProtoMessage()    {}
+func (*QueryRestakeSchedulerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{0}
+}
+func (m *QueryRestakeSchedulerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakeSchedulerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakeSchedulerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakeSchedulerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakeSchedulerRequest.Merge(m, src)
+}
+func (m *QueryRestakeSchedulerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakeSchedulerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakeSchedulerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakeSchedulerRequest proto.InternalMessageInfo
+
+// QueryRestakeSchedulerResponse is the response type for the Query/RestakeScheduler RPC method.
+type QueryRestakeSchedulerResponse struct {
+	// state is the current state of the auto-restake scheduler.
+	State RestakeSchedulerState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	// next_round_height is the height at which the next restake round is due.
+	NextRoundHeight int64 `protobuf:"varint,2,opt,name=next_round_height,json=nextRoundHeight,proto3" json:"next_round_height,omitempty"`
+}
+
+func (m *QueryRestakeSchedulerResponse) Reset()         { *m = QueryRestakeSchedulerResponse{} }
+func (m *QueryRestakeSchedulerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakeSchedulerResponse) ProtoMessage()    {}
+func (*QueryRestakeSchedulerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{1}
+}
+func (m *QueryRestakeSchedulerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakeSchedulerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakeSchedulerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakeSchedulerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakeSchedulerResponse.Merge(m, src)
+}
+func (m *QueryRestakeSchedulerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakeSchedulerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakeSchedulerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakeSchedulerResponse proto.InternalMessageInfo
+
+func (m *QueryRestakeSchedulerResponse) GetState() RestakeSchedulerState {
+	if m != nil {
+		return m.State
+	}
+	return RestakeSchedulerState{}
+}
+
+func (m *QueryRestakeSchedulerResponse) GetNextRoundHeight() int64 {
+	if m != nil {
+		return m.NextRoundHeight
+	}
+	return 0
+}
+
 // QueryRestakeThresholdRequest is the request type for the Query/Params RPC method.
 type QueryRestakeEntriesRequest struct {
 	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
@@ -41,7 +133,7 @@ func (m *QueryRestakeEntriesRequest) Reset()         { *m = QueryRestakeEntriesR
 func (m *QueryRestakeEntriesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRestakeEntriesRequest) ProtoMessage()    {}
 func (*QueryRestakeEntriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{0}
+	return fileDescriptor_5efd02cbc06efdc9, []int{2}
 }
 func (m *QueryRestakeEntriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -81,13 +173,15 @@ func (m *QueryRestakeEntriesRequest) GetDelegator() string {
 type QueryRestakingEntriesResponse struct {
 	// threshold = minimum amount in uscrt that you need to have delegated to enable restaking
 	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// entries are the auto-restake entries of the delegator.
+	Entries []AutoRestakeEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
 }
 
 func (m *QueryRestakingEntriesResponse) Reset()         { *m = QueryRestakingEntriesResponse{} }
 func (m *QueryRestakingEntriesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRestakingEntriesResponse) ProtoMessage()    {}
 func (*QueryRestakingEntriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{1}
+	return fileDescriptor_5efd02cbc06efdc9, []int{3}
 }
 func (m *QueryRestakingEntriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +217,230 @@ func (m *QueryRestakingEntriesResponse) GetValidators() []string {
 	return nil
 }
 
+func (m *QueryRestakingEntriesResponse) GetEntries() []AutoRestakeEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// QueryRestakeEntriesByValidatorRequest is the request type for the
+// Query/RestakeEntriesByValidator RPC method.
+type QueryRestakeEntriesByValidatorRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRestakeEntriesByValidatorRequest) Reset()         { *m = QueryRestakeEntriesByValidatorRequest{} }
+func (m *QueryRestakeEntriesByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakeEntriesByValidatorRequest) ProtoMessage()    {}
+func (*QueryRestakeEntriesByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{4}
+}
+func (m *QueryRestakeEntriesByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakeEntriesByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakeEntriesByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakeEntriesByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakeEntriesByValidatorRequest.Merge(m, src)
+}
+func (m *QueryRestakeEntriesByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakeEntriesByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakeEntriesByValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakeEntriesByValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryRestakeEntriesByValidatorRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryRestakeEntriesByValidatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRestakeEntriesByValidatorResponse is the response type for the
+// Query/RestakeEntriesByValidator RPC method.
+type QueryRestakeEntriesByValidatorResponse struct {
+	// entries are the auto-restake entries of the validator's delegators.
+	Entries []AutoRestakeEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRestakeEntriesByValidatorResponse) Reset() {
+	*m = QueryRestakeEntriesByValidatorResponse{}
+}
+func (m *QueryRestakeEntriesByValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakeEntriesByValidatorResponse) ProtoMessage()    {}
+func (*QueryRestakeEntriesByValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{5}
+}
+func (m *QueryRestakeEntriesByValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakeEntriesByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakeEntriesByValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakeEntriesByValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakeEntriesByValidatorResponse.Merge(m, src)
+}
+func (m *QueryRestakeEntriesByValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakeEntriesByValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakeEntriesByValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakeEntriesByValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryRestakeEntriesByValidatorResponse) GetEntries() []AutoRestakeEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryRestakeEntriesByValidatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRestakeEntriesRequest is the request type for the
+// Query/AllRestakeEntries RPC method.
+type QueryAllRestakeEntriesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRestakeEntriesRequest) Reset()         { *m = QueryAllRestakeEntriesRequest{} }
+func (m *QueryAllRestakeEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRestakeEntriesRequest) ProtoMessage()    {}
+func (*QueryAllRestakeEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{6}
+}
+func (m *QueryAllRestakeEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRestakeEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRestakeEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRestakeEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRestakeEntriesRequest.Merge(m, src)
+}
+func (m *QueryAllRestakeEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRestakeEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRestakeEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRestakeEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryAllRestakeEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRestakeEntriesResponse is the response type for the
+// Query/AllRestakeEntries RPC method.
+type QueryAllRestakeEntriesResponse struct {
+	// entries are the auto-restake entries.
+	Entries []AutoRestakeEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRestakeEntriesResponse) Reset()         { *m = QueryAllRestakeEntriesResponse{} }
+func (m *QueryAllRestakeEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRestakeEntriesResponse) ProtoMessage()    {}
+func (*QueryAllRestakeEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{7}
+}
+func (m *QueryAllRestakeEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRestakeEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRestakeEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRestakeEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRestakeEntriesResponse.Merge(m, src)
+}
+func (m *QueryAllRestakeEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRestakeEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRestakeEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRestakeEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryAllRestakeEntriesResponse) GetEntries() []AutoRestakeEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryAllRestakeEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRestakeThresholdRequest is the request type for the Query/Params RPC method.
 type QueryRestakeThresholdRequest struct {
 }
@@ -131,7 +449,7 @@ func (m *QueryRestakeThresholdRequest) Reset()         { *m = QueryRestakeThresh
 func (m *QueryRestakeThresholdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRestakeThresholdRequest) ProtoMessage()    {}
 func (*QueryRestakeThresholdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{2}
+	return fileDescriptor_5efd02cbc06efdc9, []int{8}
 }
 func (m *QueryRestakeThresholdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +488,7 @@ func (m *QueryRestakeThresholdResponse) Reset()         { *m = QueryRestakeThres
 func (m *QueryRestakeThresholdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRestakeThresholdResponse) ProtoMessage()    {}
 func (*QueryRestakeThresholdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{3}
+	return fileDescriptor_5efd02cbc06efdc9, []int{9}
 }
 func (m *QueryRestakeThresholdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +525,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{4}
+	return fileDescriptor_5efd02cbc06efdc9, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +564,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{5}
+	return fileDescriptor_5efd02cbc06efdc9, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +613,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Reset() {
 func (m *QueryValidatorOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorOutstandingRewardsRequest) ProtoMessage()    {}
 func (*QueryValidatorOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{6}
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
 }
 func (m *QueryValidatorOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +661,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Reset() {
 func (m *QueryValidatorOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorOutstandingRewardsResponse) ProtoMessage()    {}
 func (*QueryValidatorOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{7}
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
 }
 func (m *QueryValidatorOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +708,7 @@ func (m *QueryValidatorCommissionRequest) Reset()         { *m = QueryValidatorC
 func (m *QueryValidatorCommissionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorCommissionRequest) ProtoMessage()    {}
 func (*QueryValidatorCommissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{8}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryValidatorCommissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +755,7 @@ func (m *QueryValidatorCommissionResponse) Reset()         { *m = QueryValidator
 func (m *QueryValidatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorCommissionResponse) ProtoMessage()    {}
 func (*QueryValidatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{9}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryValidatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +808,7 @@ func (m *QueryValidatorSlashesRequest) Reset()         { *m = QueryValidatorSlas
 func (m *QueryValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesRequest) ProtoMessage()    {}
 func (*QueryValidatorSlashesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{10}
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
 }
 func (m *QueryValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +850,7 @@ func (m *QueryValidatorSlashesResponse) Reset()         { *m = QueryValidatorSla
 func (m *QueryValidatorSlashesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesResponse) ProtoMessage()    {}
 func (*QueryValidatorSlashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{11}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryValidatorSlashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +906,7 @@ func (m *QueryDelegationRewardsRequest) Reset()         { *m = QueryDelegationRe
 func (m *QueryDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,7 +946,7 @@ func (m *QueryDelegationRewardsResponse) Reset()         { *m = QueryDelegationR
 func (m *QueryDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +993,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +1035,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +1089,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1129,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1169,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1209,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +1247,7 @@ func (m *QueryFoundationTaxRequest) Reset()         { *m = QueryFoundationTaxReq
 func (m *QueryFoundationTaxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFoundationTaxRequest) ProtoMessage()    {}
 func (*QueryFoundationTaxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+	return fileDescriptor_5efd02cbc06efdc9, []int{26}
 }
 func (m *QueryFoundationTaxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1288,7 @@ func (m *QueryFoundationTaxResponse) Reset()         { *m = QueryFoundationTaxRe
 func (m *QueryFoundationTaxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFoundationTaxResponse) ProtoMessage()    {}
 func (*QueryFoundationTaxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+	return fileDescriptor_5efd02cbc06efdc9, []int{27}
 }
 func (m *QueryFoundationTaxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1326,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+	return fileDescriptor_5efd02cbc06efdc9, []int{28}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1366,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+	return fileDescriptor_5efd02cbc06efdc9, []int{29}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,8 +1403,14 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 }
 
 func init() {
+	proto.RegisterType((*QueryRestakeSchedulerRequest)(nil), "cosmos.distribution.v1beta1.QueryRestakeSchedulerRequest")
+	proto.RegisterType((*QueryRestakeSchedulerResponse)(nil), "cosmos.distribution.v1beta1.QueryRestakeSchedulerResponse")
 	proto.RegisterType((*QueryRestakeEntriesRequest)(nil), "cosmos.distribution.v1beta1.QueryRestakeEntriesRequest")
 	proto.RegisterType((*QueryRestakingEntriesResponse)(nil), "cosmos.distribution.v1beta1.QueryRestakingEntriesResponse")
+	proto.RegisterType((*QueryRestakeEntriesByValidatorRequest)(nil), "cosmos.distribution.v1beta1.QueryRestakeEntriesByValidatorRequest")
+	proto.RegisterType((*QueryRestakeEntriesByValidatorResponse)(nil), "cosmos.distribution.v1beta1.QueryRestakeEntriesByValidatorResponse")
+	proto.RegisterType((*QueryAllRestakeEntriesRequest)(nil), "cosmos.distribution.v1beta1.QueryAllRestakeEntriesRequest")
+	proto.RegisterType((*QueryAllRestakeEntriesResponse)(nil), "cosmos.distribution.v1beta1.QueryAllRestakeEntriesResponse")
 	proto.RegisterType((*QueryRestakeThresholdRequest)(nil), "cosmos.distribution.v1beta1.QueryRestakeThresholdRequest")
 	proto.RegisterType((*QueryRestakeThresholdResponse)(nil), "cosmos.distribution.v1beta1.QueryRestakeThresholdResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
@@ -1116,90 +1440,104 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0xc5,
-	0x17, 0xf7, 0x3a, 0x69, 0xfa, 0xcf, 0xf4, 0x5f, 0x9a, 0x4c, 0x23, 0x64, 0x9c, 0x60, 0x47, 0x1b,
-	0xda, 0x04, 0x42, 0x76, 0xf3, 0x81, 0x52, 0x48, 0x41, 0x25, 0x9f, 0x54, 0x6a, 0x94, 0xa6, 0x26,
-	0x4a, 0xc2, 0x97, 0xac, 0x8d, 0x77, 0x58, 0xaf, 0x62, 0xef, 0xb8, 0x3b, 0xe3, 0x7c, 0xa8, 0xea,
-	0x85, 0x80, 0xc4, 0x05, 0x09, 0x09, 0x0e, 0x3d, 0xe6, 0xcc, 0x9d, 0x0b, 0x12, 0x07, 0x6e, 0x3d,
-	0x56, 0x42, 0x42, 0x88, 0x43, 0x41, 0x09, 0x42, 0x95, 0x10, 0x67, 0xae, 0x68, 0x67, 0x67, 0x3f,
-	0xbd, 0x5e, 0xaf, 0x6d, 0xf5, 0x64, 0xeb, 0xed, 0xbc, 0xdf, 0xfb, 0xfd, 0xde, 0xcc, 0x9b, 0xf7,
-	0x34, 0x60, 0xbc, 0x84, 0x49, 0x15, 0x13, 0x59, 0xd5, 0x09, 0x35, 0xf5, 0xbd, 0x3a, 0xd5, 0xb1,
-	0x21, 0x1f, 0xcc, 0xec, 0x21, 0xaa, 0xcc, 0xc8, 0xf7, 0xeb, 0xc8, 0x3c, 0x96, 0x6a, 0x26, 0xa6,
-	0x18, 0x0e, 0xdb, 0x0b, 0x25, 0xff, 0x42, 0x89, 0x2f, 0xcc, 0xbe, 0xc6, 0x51, 0xf6, 0x14, 0x82,
-	0x6c, 0x2f, 0x17, 0xa3, 0xa6, 0x68, 0xba, 0xa1, 0xb0, 0xd5, 0x0c, 0x28, 0x3b, 0xa4, 0x61, 0x0d,
-	0xb3, 0xbf, 0xb2, 0xf5, 0x8f, 0x5b, 0x47, 0x34, 0x8c, 0xb5, 0x0a, 0x92, 0x95, 0x9a, 0x2e, 0x2b,
-	0x86, 0x81, 0x29, 0x73, 0x21, 0xfc, 0x6b, 0xce, 0x8f, 0xef, 0x20, 0x97, 0xb0, 0xee, 0x60, 0x4a,
-	0x71, 0x2a, 0x02, 0x8c, 0xd9, 0x7a, 0x71, 0x01, 0x64, 0xef, 0x59, 0x2c, 0x0b, 0x88, 0x50, 0x65,
-	0x1f, 0xad, 0x1a, 0xd4, 0xd4, 0x11, 0x29, 0xa0, 0xfb, 0x75, 0x44, 0x28, 0x1c, 0x01, 0xfd, 0x2a,
-	0xaa, 0x20, 0x4d, 0xa1, 0xd8, 0xcc, 0x08, 0xa3, 0xc2, 0x44, 0x7f, 0xc1, 0x33, 0x88, 0xb7, 0xc0,
-	0xcb, 0x3e, 0x5f, 0xdd, 0xd0, 0x5c, 0x6f, 0x52, 0xc3, 0x06, 0x41, 0x30, 0x07, 0xc0, 0x81, 0x52,
-	0xd1, 0x55, 0x6b, 0x35, 0xc9, 0x08, 0xa3, 0x3d, 0x13, 0xfd, 0x05, 0x9f, 0x45, 0xcc, 0x81, 0x11,
-	0x7f, 0xf0, 0xad, 0xb2, 0x89, 0x48, 0x19, 0x57, 0x54, 0x1e, 0x5e, 0xac, 0x06, 0x02, 0xf8, 0xbf,
-	0xf3, 0x00, 0xeb, 0xa0, 0x9f, 0x3a, 0x46, 0x9b, 0xdf, 0x92, 0xf4, 0xf8, 0x69, 0x3e, 0xf5, 0xdb,
-	0xd3, 0xfc, 0x75, 0x4d, 0xa7, 0xe5, 0xfa, 0x9e, 0x54, 0xc2, 0x55, 0x99, 0xe7, 0xc4, 0xfe, 0x99,
-	0x22, 0xea, 0xbe, 0x4c, 0x8f, 0x6b, 0x88, 0x48, 0x2b, 0xa8, 0x54, 0xf0, 0x00, 0xc4, 0x21, 0x00,
-	0x59, 0xb8, 0x4d, 0xc5, 0x54, 0xaa, 0x4e, 0x0e, 0xc4, 0x5d, 0x70, 0x35, 0x60, 0xe5, 0xa1, 0x17,
-	0x41, 0x5f, 0x8d, 0x59, 0x58, 0xdc, 0x4b, 0xb3, 0x63, 0x52, 0xcc, 0xb1, 0x90, 0x6c, 0xe7, 0xa5,
-	0x5e, 0x8b, 0x5c, 0x81, 0x3b, 0x8a, 0xdb, 0x60, 0x9c, 0x21, 0x6f, 0x3b, 0x19, 0xb9, 0x5b, 0xa7,
-	0x84, 0x2a, 0x86, 0xaa, 0x1b, 0x5a, 0x01, 0x1d, 0x2a, 0xa6, 0xea, 0x6e, 0xc4, 0x24, 0x18, 0x74,
-	0xf3, 0x56, 0x54, 0x54, 0xd5, 0x44, 0x84, 0xf0, 0x0d, 0x19, 0x70, 0x3f, 0x2c, 0xda, 0x76, 0xf1,
-	0x73, 0x01, 0x4c, 0xb4, 0x06, 0xe6, 0x3a, 0x76, 0xc1, 0x45, 0xd3, 0x36, 0x71, 0x21, 0x6f, 0xc6,
-	0x0a, 0x89, 0x81, 0xe4, 0xea, 0x1c, 0x38, 0x71, 0x03, 0xe4, 0x83, 0x2c, 0x96, 0x71, 0xb5, 0xaa,
-	0x13, 0xa2, 0x63, 0xa3, 0x23, 0x59, 0x5f, 0x08, 0x60, 0xb4, 0x39, 0x20, 0x97, 0xa3, 0x00, 0x50,
-	0x72, 0xad, 0x5c, 0xd1, 0xcd, 0x64, 0x8a, 0x16, 0x4b, 0xa5, 0x7a, 0xb5, 0x5e, 0x51, 0x28, 0x52,
-	0x3d, 0x60, 0x2e, 0xca, 0x07, 0x2a, 0xfe, 0x2d, 0xf0, 0x63, 0xeb, 0x7a, 0xbe, 0x5f, 0x51, 0x48,
-	0x19, 0x75, 0xb4, 0x59, 0x70, 0x1c, 0x5c, 0x21, 0x54, 0x31, 0xa9, 0x6e, 0x68, 0xc5, 0x32, 0xd2,
-	0xb5, 0x32, 0xcd, 0xa4, 0x47, 0x85, 0x89, 0xde, 0xc2, 0x0b, 0x8e, 0xf9, 0x36, 0xb3, 0xc2, 0x31,
-	0x70, 0x19, 0xb1, 0x74, 0x3b, 0xcb, 0x7a, 0xd8, 0xb2, 0xff, 0xdb, 0x46, 0xbe, 0x68, 0x0d, 0x00,
-	0xef, 0x9a, 0xc9, 0xf4, 0x32, 0xf9, 0xd7, 0x1d, 0xf9, 0xd6, 0x9d, 0x21, 0xd9, 0x37, 0x99, 0x77,
-	0x2e, 0x35, 0xc4, 0x69, 0x17, 0x7c, 0x9e, 0x0b, 0xff, 0xfb, 0xf2, 0x34, 0x9f, 0x7a, 0x74, 0x9a,
-	0x17, 0xc4, 0x1f, 0x04, 0x5e, 0x84, 0x8d, 0x6a, 0x79, 0xca, 0x37, 0xc1, 0x45, 0x62, 0x9b, 0x58,
-	0x89, 0x5f, 0x9a, 0x9d, 0x4e, 0x96, 0x6f, 0x86, 0xb3, 0x7a, 0x80, 0x0c, 0xea, 0x9c, 0x1c, 0x0e,
-	0x03, 0xdf, 0x0b, 0xa8, 0x48, 0x33, 0x15, 0xe3, 0x2d, 0x55, 0xd8, 0x74, 0xfc, 0x32, 0xc4, 0x13,
-	0x87, 0xfc, 0x8a, 0x7d, 0x69, 0xb1, 0x93, 0x12, 0x2e, 0x2c, 0xf7, 0x42, 0x0b, 0xef, 0x95, 0xfb,
-	0xc1, 0xd9, 0xab, 0xc8, 0x8d, 0x4d, 0x47, 0x6f, 0xac, 0x9d, 0xc2, 0x67, 0xa7, 0xf9, 0x94, 0xf8,
-	0x95, 0x00, 0x72, 0xcd, 0x58, 0xf0, 0x1c, 0xee, 0xfb, 0xab, 0xd0, 0xca, 0xe1, 0x48, 0x40, 0xae,
-	0x23, 0x74, 0x05, 0x95, 0x96, 0xb1, 0x6e, 0x2c, 0xcd, 0x59, 0xf9, 0xfa, 0xee, 0xf7, 0xfc, 0x64,
-	0xb2, 0x4b, 0xce, 0xf2, 0x21, 0x5e, 0x61, 0x7e, 0x04, 0xc4, 0x10, 0x9d, 0x2d, 0x4c, 0x95, 0x4a,
-	0x17, 0x99, 0xf1, 0x89, 0xfd, 0x4b, 0x00, 0x63, 0xb1, 0xe8, 0x5c, 0xf1, 0x76, 0x58, 0xf1, 0x7c,
-	0xec, 0xa9, 0xf1, 0xd0, 0x56, 0x9c, 0xd8, 0x36, 0x62, 0xe8, 0xd6, 0x81, 0x1a, 0xb8, 0x40, 0xad,
-	0x78, 0x99, 0xf4, 0xf3, 0xca, 0xa3, 0x8d, 0x2f, 0xee, 0xf2, 0xeb, 0xcd, 0xe5, 0xe3, 0x1e, 0xec,
-	0x6e, 0x53, 0xb8, 0xce, 0xef, 0xb9, 0x48, 0xe4, 0x64, 0xad, 0xd5, 0x87, 0xf6, 0x09, 0x78, 0x25,
-	0x88, 0xb6, 0xa3, 0xd3, 0xb2, 0x6a, 0x2a, 0x87, 0x3c, 0x70, 0x97, 0x64, 0x3f, 0x06, 0xd7, 0x5a,
-	0xc0, 0x73, 0xc6, 0xaf, 0x82, 0x81, 0x43, 0xfe, 0x29, 0x04, 0x7f, 0xe5, 0x30, 0xe8, 0xe2, 0x43,
-	0x1f, 0x06, 0x2f, 0x31, 0xf4, 0x35, 0x5c, 0x37, 0x54, 0xfb, 0x30, 0x29, 0x47, 0x4e, 0x67, 0xd6,
-	0xf8, 0xec, 0x12, 0xfa, 0xc8, 0xe3, 0x0d, 0x80, 0x1e, 0xaa, 0x1c, 0xf1, 0x10, 0xd6, 0x5f, 0x38,
-	0x05, 0xe0, 0xa7, 0xee, 0xd2, 0x50, 0xfd, 0x0e, 0x7a, 0x5f, 0x9a, 0xb3, 0xb0, 0xda, 0x42, 0xdd,
-	0xd0, 0xe9, 0xf1, 0x26, 0xc6, 0x15, 0x87, 0xc5, 0x89, 0xc0, 0x69, 0x84, 0xbe, 0x72, 0x1a, 0x08,
-	0xf4, 0xd6, 0x30, 0xae, 0x3c, 0xbf, 0xb2, 0x66, 0xf0, 0xb3, 0xdf, 0x0e, 0x81, 0x0b, 0x8c, 0x05,
-	0x7c, 0x24, 0x80, 0x3e, 0x7b, 0xdc, 0x80, 0x72, 0x6c, 0x49, 0x35, 0xce, 0x3a, 0xd9, 0xe9, 0xe4,
-	0x0e, 0xb6, 0x3c, 0x71, 0xf2, 0xb3, 0x9f, 0xff, 0xfc, 0x26, 0x7d, 0x0d, 0x8e, 0xc9, 0x71, 0x83,
-	0xa7, 0x3d, 0xf0, 0xc0, 0x93, 0x34, 0x18, 0x8e, 0x19, 0x20, 0xe0, 0x4a, 0xeb, 0xf0, 0xad, 0x67,
-	0xa5, 0xec, 0x6a, 0x97, 0x28, 0x5c, 0xd9, 0x0e, 0x53, 0x76, 0x0f, 0xde, 0x8d, 0x55, 0xe6, 0x95,
-	0x9c, 0xfc, 0xa0, 0xa1, 0x37, 0x3c, 0x94, 0xb1, 0x87, 0x5f, 0x74, 0x6e, 0xa8, 0x33, 0x01, 0x5c,
-	0x8d, 0x18, 0x61, 0xe0, 0xdb, 0x6d, 0xf0, 0x6e, 0x18, 0xa5, 0xb2, 0xef, 0x74, 0xe8, 0xcd, 0xd5,
-	0x6e, 0x30, 0xb5, 0xb7, 0xe1, 0x5a, 0x37, 0x6a, 0xbd, 0x21, 0x09, 0xfe, 0x22, 0x80, 0x81, 0xf0,
-	0xc4, 0x00, 0xdf, 0x6a, 0x83, 0x63, 0x70, 0xa6, 0xca, 0x2e, 0x74, 0xe2, 0xca, 0xb5, 0xdd, 0x61,
-	0xda, 0x56, 0xe1, 0x72, 0x37, 0xda, 0x9c, 0xd9, 0xe4, 0x1f, 0x01, 0x0c, 0x36, 0xf4, 0x71, 0x98,
-	0x80, 0x5e, 0xb3, 0x11, 0x24, 0x7b, 0xb3, 0x23, 0x5f, 0xae, 0xad, 0xc8, 0xb4, 0x7d, 0x00, 0x77,
-	0x62, 0xb5, 0xb9, 0xf7, 0x37, 0x91, 0x1f, 0x34, 0x5c, 0xf2, 0x0f, 0x65, 0x7e, 0x32, 0xa3, 0x74,
-	0xc3, 0x67, 0x02, 0x78, 0x31, 0xba, 0x95, 0xc3, 0x5b, 0xed, 0x10, 0x8f, 0x18, 0x31, 0xb2, 0xef,
-	0x76, 0x0e, 0xd0, 0xd6, 0xd6, 0x26, 0x93, 0xcf, 0x0a, 0x33, 0xa2, 0xe7, 0x26, 0x29, 0xcc, 0xe6,
-	0x43, 0x40, 0x92, 0xc2, 0x8c, 0x69, 0xf4, 0x09, 0x0b, 0xb3, 0x85, 0x42, 0xef, 0x6c, 0xc3, 0x7f,
-	0x05, 0x90, 0x69, 0xd6, 0xab, 0xe1, 0x62, 0x1b, 0x5c, 0xa3, 0xc7, 0x88, 0xec, 0x52, 0x37, 0x10,
-	0x5c, 0xf3, 0x16, 0xd3, 0xbc, 0x01, 0xd7, 0xbb, 0xd1, 0x1c, 0x1e, 0x36, 0xe0, 0xf7, 0x02, 0xb8,
-	0x1c, 0xe8, 0xd1, 0x70, 0xbe, 0x35, 0xd7, 0xa8, 0x96, 0x9f, 0xbd, 0xd1, 0xb6, 0x1f, 0x17, 0x36,
-	0xc7, 0x84, 0x4d, 0xc1, 0xc9, 0x58, 0x61, 0x25, 0xc7, 0xb7, 0x68, 0xb5, 0x76, 0xc6, 0x3b, 0x30,
-	0xe2, 0x24, 0xe1, 0x1d, 0x35, 0x30, 0x25, 0xe1, 0x1d, 0x39, 0x4b, 0x25, 0xe4, 0xed, 0x1b, 0xae,
-	0xac, 0x71, 0xeb, 0x27, 0x01, 0x0c, 0x84, 0x5f, 0x6e, 0x92, 0xb4, 0x80, 0x26, 0xaf, 0x41, 0x49,
-	0x5a, 0x40, 0xb3, 0x87, 0x22, 0x71, 0x9e, 0x09, 0x98, 0x86, 0x52, 0xac, 0x00, 0xd3, 0x76, 0x2f,
-	0xba, 0x4f, 0x42, 0xf0, 0x47, 0x57, 0x83, 0xf7, 0xbc, 0x05, 0x6f, 0x24, 0x26, 0x12, 0x7c, 0x4e,
-	0x4b, 0xae, 0xa0, 0xf1, 0x2d, 0x4d, 0x7c, 0x83, 0x29, 0x90, 0xe0, 0xeb, 0x89, 0x14, 0x20, 0xdb,
-	0x7b, 0xe9, 0xce, 0xe3, 0xb3, 0x9c, 0xf0, 0xe4, 0x2c, 0x27, 0xfc, 0x71, 0x96, 0x13, 0xbe, 0x3e,
-	0xcf, 0xa5, 0x9e, 0x9c, 0xe7, 0x52, 0xbf, 0x9e, 0xe7, 0x52, 0x1f, 0xce, 0xc4, 0xce, 0x98, 0x47,
-	0x41, 0x78, 0x36, 0x72, 0xee, 0xf5, 0xb1, 0x27, 0xc3, 0xb9, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0xa8, 0x78, 0x9b, 0x2a, 0x15, 0x00, 0x00,
+	// 1549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0x5f, 0x68, 0x1c, 0xd5,
+	0x17, 0xc7, 0x73, 0x93, 0xb4, 0xfd, 0xe5, 0xf4, 0x57, 0x9b, 0xdc, 0x16, 0x49, 0xa7, 0xe9, 0x6e,
+	0x98, 0xd8, 0x26, 0x36, 0x66, 0x27, 0x4d, 0xa5, 0xad, 0xa9, 0x45, 0xb3, 0x49, 0x6a, 0xa1, 0x6d,
+	0x9a, 0x6e, 0x4a, 0x5b, 0xff, 0xb1, 0x4c, 0x76, 0xae, 0xb3, 0x43, 0x67, 0x67, 0xb6, 0x33, 0x77,
+	0x9b, 0x84, 0xd2, 0x17, 0xab, 0xe0, 0x8b, 0x22, 0xf8, 0x52, 0x50, 0xa1, 0xcf, 0xbe, 0x0b, 0x22,
+	0x08, 0xfa, 0x22, 0x7d, 0x2c, 0x08, 0x22, 0x3e, 0x54, 0x49, 0x45, 0x0a, 0xe2, 0xb3, 0xf8, 0x26,
+	0x73, 0xe7, 0xce, 0xec, 0xcc, 0xec, 0xec, 0xec, 0xec, 0xac, 0x05, 0x9f, 0x76, 0xb9, 0xf7, 0x9e,
+	0xef, 0x3d, 0x9f, 0x73, 0xff, 0x9c, 0xb3, 0x77, 0x61, 0xb2, 0x62, 0xda, 0x35, 0xd3, 0x96, 0x14,
+	0xcd, 0xa6, 0x96, 0xb6, 0xde, 0xa0, 0x9a, 0x69, 0x48, 0xb7, 0x8e, 0xad, 0x13, 0x2a, 0x1f, 0x93,
+	0x6e, 0x36, 0x88, 0xb5, 0x55, 0xa8, 0x5b, 0x26, 0x35, 0xf1, 0x41, 0x77, 0x60, 0x21, 0x38, 0xb0,
+	0xc0, 0x07, 0x0a, 0x47, 0xb9, 0xca, 0xba, 0x6c, 0x13, 0xd7, 0xca, 0xd7, 0xa8, 0xcb, 0xaa, 0x66,
+	0xc8, 0x6c, 0x34, 0x13, 0x12, 0xf6, 0xab, 0xa6, 0x6a, 0xb2, 0xaf, 0x92, 0xf3, 0x8d, 0xb7, 0x8e,
+	0xa9, 0xa6, 0xa9, 0xea, 0x44, 0x92, 0xeb, 0x9a, 0x24, 0x1b, 0x86, 0x49, 0x99, 0x89, 0xcd, 0x7b,
+	0x73, 0x41, 0x7d, 0x4f, 0xb9, 0x62, 0x6a, 0x9e, 0x66, 0x21, 0x89, 0x22, 0xe4, 0x31, 0x1b, 0x2f,
+	0xe6, 0x60, 0xec, 0xb2, 0xe3, 0x65, 0x89, 0xd8, 0x54, 0xbe, 0x41, 0xd6, 0x2a, 0x55, 0xa2, 0x34,
+	0x74, 0x62, 0x95, 0xc8, 0xcd, 0x06, 0xb1, 0xa9, 0xf8, 0x29, 0x82, 0x43, 0x6d, 0x06, 0xd8, 0x75,
+	0xd3, 0xb0, 0x09, 0x5e, 0x81, 0x1d, 0x36, 0x95, 0x29, 0x19, 0x45, 0xe3, 0x68, 0x6a, 0xf7, 0xdc,
+	0x5c, 0x21, 0x21, 0x3c, 0x85, 0xa8, 0xca, 0x9a, 0x63, 0x59, 0x1c, 0x7c, 0xf0, 0x28, 0xdf, 0x57,
+	0x72, 0x65, 0xf0, 0x51, 0x18, 0x31, 0xc8, 0x26, 0x2d, 0x5b, 0x66, 0xc3, 0x50, 0xca, 0x55, 0xa2,
+	0xa9, 0x55, 0x3a, 0xda, 0x3f, 0x8e, 0xa6, 0x06, 0x4a, 0x7b, 0x9d, 0x8e, 0x92, 0xd3, 0x7e, 0x8e,
+	0x35, 0x8b, 0xf3, 0x20, 0x04, 0x9d, 0x5b, 0x36, 0xa8, 0xa5, 0x11, 0x9b, 0xfb, 0x8e, 0xc7, 0x60,
+	0x48, 0x21, 0x3a, 0x51, 0x65, 0x6a, 0x5a, 0xcc, 0xbb, 0xa1, 0x52, 0xb3, 0x41, 0xfc, 0x28, 0x4c,
+	0xa6, 0x19, 0xaa, 0x6f, 0xce, 0xc9, 0x72, 0x00, 0xb7, 0x64, 0x5d, 0x53, 0x9c, 0xe1, 0xf6, 0x28,
+	0x1a, 0x1f, 0x98, 0x1a, 0x2a, 0x05, 0x5a, 0xf0, 0x45, 0xd8, 0x45, 0x5c, 0x93, 0xd1, 0xfe, 0xf1,
+	0x81, 0xa9, 0xdd, 0x73, 0x33, 0x89, 0xec, 0x0b, 0x0d, 0x6a, 0x06, 0x1c, 0xdd, 0xe2, 0xd8, 0x9e,
+	0x86, 0xf8, 0x19, 0x82, 0xc3, 0x31, 0x34, 0xc5, 0xad, 0xab, 0xde, 0x94, 0x1e, 0xd8, 0x34, 0x8c,
+	0xf8, 0x6e, 0x94, 0x65, 0x45, 0xb1, 0x88, 0x6d, 0x73, 0xc0, 0x61, 0xbf, 0x63, 0xc1, 0x6d, 0xc7,
+	0x67, 0x01, 0x9a, 0x3b, 0x8f, 0x05, 0x72, 0xf7, 0xdc, 0x11, 0xcf, 0x51, 0x67, 0x1b, 0x15, 0xdc,
+	0xcd, 0xed, 0xb9, 0xb9, 0x2a, 0xab, 0x84, 0x4f, 0x54, 0x0a, 0x58, 0x8a, 0xdf, 0x22, 0x38, 0xd2,
+	0xc9, 0x3d, 0x1e, 0xb8, 0x40, 0x60, 0x50, 0xef, 0x81, 0xc1, 0xaf, 0xc5, 0x10, 0x4c, 0x76, 0x24,
+	0x70, 0x7d, 0x09, 0x21, 0xa8, 0x7c, 0xc5, 0x17, 0x74, 0x3d, 0x7e, 0xc7, 0x84, 0x63, 0x85, 0x32,
+	0xc7, 0xea, 0x2b, 0x04, 0xb9, 0x76, 0x33, 0xfd, 0xc7, 0x63, 0x14, 0xb9, 0x10, 0xae, 0x54, 0x2d,
+	0x62, 0x57, 0x4d, 0x5d, 0xf1, 0x2e, 0x84, 0x1a, 0x1c, 0x6a, 0xd3, 0xcf, 0xc1, 0x2e, 0xc0, 0x10,
+	0xf5, 0x1a, 0xdd, 0x4d, 0x59, 0x2c, 0x38, 0xbe, 0xfe, 0xfc, 0x28, 0x7f, 0x44, 0xd5, 0x68, 0xb5,
+	0xb1, 0x5e, 0xa8, 0x98, 0x35, 0x89, 0xdf, 0x53, 0xee, 0xc7, 0x8c, 0xad, 0xdc, 0x90, 0xe8, 0x56,
+	0x9d, 0xd8, 0x85, 0x25, 0x52, 0x29, 0x35, 0x05, 0xc4, 0xfd, 0x80, 0xd9, 0x74, 0xab, 0xb2, 0x25,
+	0xd7, 0xbc, 0x75, 0x12, 0xaf, 0xc3, 0xbe, 0x50, 0x2b, 0x9f, 0x7a, 0x01, 0x76, 0xd6, 0x59, 0x0b,
+	0x5f, 0xba, 0x89, 0xc4, 0x90, 0xba, 0xc6, 0x3c, 0x90, 0xdc, 0x50, 0xbc, 0x0a, 0x93, 0x4c, 0xd9,
+	0xdf, 0xd4, 0x97, 0x1a, 0xd4, 0xa6, 0xb2, 0xa1, 0x68, 0x86, 0x5a, 0x22, 0x1b, 0xb2, 0xa5, 0xd8,
+	0x59, 0x4e, 0xa1, 0xf8, 0x1e, 0x82, 0xa9, 0xce, 0xc2, 0x9c, 0xe3, 0x3a, 0xec, 0xb2, 0xdc, 0x26,
+	0x0e, 0x72, 0x2a, 0x11, 0x24, 0x41, 0xd2, 0xdb, 0x26, 0x5c, 0x4e, 0x5c, 0x81, 0x7c, 0xd8, 0x8b,
+	0x45, 0xb3, 0x56, 0xd3, 0x6c, 0x5b, 0x33, 0x8d, 0x4c, 0x58, 0xef, 0x23, 0x18, 0x6f, 0x2f, 0xc8,
+	0x71, 0x64, 0x80, 0x8a, 0xdf, 0xca, 0x89, 0x4e, 0xa7, 0x23, 0x5a, 0xa8, 0x54, 0x1a, 0xb5, 0x86,
+	0x2e, 0x53, 0xa2, 0x34, 0x85, 0x39, 0x54, 0x40, 0x54, 0xfc, 0x03, 0xf1, 0x6d, 0xeb, 0x5b, 0xae,
+	0xe9, 0xb2, 0x5d, 0x25, 0x99, 0x16, 0x0b, 0x4f, 0xc2, 0x5e, 0x9b, 0xca, 0x16, 0xd5, 0x0c, 0x35,
+	0x98, 0x80, 0x06, 0x4b, 0xcf, 0x78, 0xcd, 0x6e, 0xfe, 0xc1, 0x13, 0xb0, 0x87, 0x18, 0x4a, 0x60,
+	0xd8, 0x00, 0x1b, 0xf6, 0x7f, 0xb7, 0x91, 0x0f, 0x0a, 0x5f, 0x2a, 0x83, 0x59, 0x2f, 0x95, 0xf9,
+	0xff, 0x7d, 0x70, 0x3f, 0xdf, 0x77, 0xef, 0x7e, 0x1e, 0x89, 0x5f, 0x7b, 0xa9, 0xab, 0x95, 0x96,
+	0x87, 0x7c, 0x15, 0x76, 0xd9, 0x6e, 0x13, 0xbf, 0x5d, 0x66, 0xd3, 0xc5, 0x9b, 0xe9, 0x2c, 0xdf,
+	0x22, 0x06, 0xf5, 0x76, 0x0e, 0x97, 0xf9, 0xf7, 0x2e, 0x98, 0xbb, 0x9e, 0xf3, 0x4b, 0x6e, 0x2a,
+	0x66, 0x3b, 0x25, 0x7a, 0xb0, 0xfc, 0x34, 0x1d, 0x5d, 0x2b, 0xbf, 0xc3, 0x5b, 0xab, 0xd8, 0x85,
+	0xed, 0x8f, 0x5f, 0x58, 0x37, 0x84, 0x4f, 0xee, 0xe7, 0xfb, 0xc4, 0x0f, 0xbd, 0x1b, 0x3a, 0xc6,
+	0x0b, 0x1e, 0xc3, 0x1b, 0xc1, 0x53, 0xe8, 0xc4, 0x70, 0x2c, 0x84, 0xeb, 0x81, 0x2e, 0x91, 0xca,
+	0xa2, 0xa9, 0x19, 0xc5, 0xe3, 0x4e, 0xbc, 0xbe, 0xf8, 0x25, 0x3f, 0x9d, 0xee, 0x92, 0x73, 0x6c,
+	0xec, 0xe6, 0xc1, 0x7c, 0x13, 0xc4, 0x88, 0x3b, 0x57, 0x4c, 0x2a, 0xeb, 0x3d, 0x44, 0x26, 0x00,
+	0xfb, 0x3b, 0x82, 0x89, 0x44, 0x75, 0x4e, 0x7c, 0x35, 0x4a, 0x7c, 0x22, 0x71, 0xd7, 0x34, 0xd5,
+	0x96, 0xbc, 0xb9, 0x5d, 0xc5, 0xc8, 0xad, 0x83, 0x55, 0xd8, 0x41, 0x9d, 0xf9, 0x46, 0xfb, 0x9f,
+	0x56, 0x1c, 0x5d, 0x7d, 0xf1, 0x3a, 0xbf, 0xde, 0x7c, 0x7f, 0xfc, 0x8d, 0xdd, 0x6b, 0x08, 0x2f,
+	0xc0, 0x78, 0x7b, 0xe5, 0x74, 0xf5, 0x62, 0x40, 0xed, 0x6d, 0x78, 0x2e, 0xac, 0x76, 0x4d, 0xa3,
+	0x55, 0xc5, 0x92, 0x37, 0xf8, 0xc4, 0x3d, 0x3a, 0xfb, 0x16, 0x1c, 0xee, 0x20, 0xcf, 0x3d, 0x7e,
+	0x1e, 0x86, 0x37, 0x78, 0x57, 0x44, 0x7e, 0xef, 0x46, 0xd8, 0x24, 0xa0, 0x7e, 0x10, 0x0e, 0x30,
+	0xf5, 0xb3, 0x4e, 0x21, 0xee, 0x6e, 0x26, 0x79, 0xd3, 0xcb, 0xcc, 0x2a, 0x08, 0x71, 0x9d, 0x7c,
+	0xbe, 0x61, 0x18, 0xa0, 0xf2, 0x26, 0x9f, 0xc2, 0xf9, 0x8a, 0x67, 0x00, 0xbf, 0xe3, 0x0f, 0x8d,
+	0x9c, 0xdf, 0x91, 0x66, 0x4f, 0x7b, 0x2f, 0x9c, 0xb4, 0xd0, 0x30, 0x34, 0xba, 0xb5, 0x6a, 0x9a,
+	0xba, 0xe7, 0xc5, 0x5d, 0x04, 0x42, 0x5c, 0x2f, 0x77, 0x83, 0xc0, 0x60, 0xdd, 0x34, 0xf5, 0xa7,
+	0x77, 0xac, 0x99, 0xfc, 0xdc, 0xe7, 0x07, 0x60, 0x07, 0xf3, 0x02, 0xdf, 0x43, 0xb0, 0xd3, 0x2d,
+	0x37, 0xb0, 0x94, 0x78, 0xa4, 0x5a, 0x6b, 0x1d, 0x61, 0x36, 0xbd, 0x81, 0x8b, 0x27, 0x4e, 0xbf,
+	0xfb, 0xc3, 0x6f, 0x9f, 0xf4, 0x1f, 0xc6, 0x13, 0x52, 0xd2, 0x8f, 0x41, 0xb7, 0xe0, 0xc1, 0x77,
+	0xfb, 0xe1, 0x60, 0x42, 0x01, 0x81, 0x97, 0x3a, 0x4f, 0xdf, 0xb9, 0x56, 0x12, 0x96, 0x7b, 0x54,
+	0xe1, 0x64, 0xd7, 0x18, 0xd9, 0x65, 0x7c, 0x29, 0x91, 0xac, 0x79, 0xe4, 0xa4, 0xdb, 0x2d, 0xb9,
+	0xe1, 0x8e, 0x64, 0x36, 0xf5, 0xcb, 0xde, 0x0d, 0xb5, 0x8d, 0x60, 0x5f, 0x4c, 0x09, 0x83, 0x5f,
+	0xee, 0xc2, 0xef, 0x96, 0x52, 0x4a, 0x38, 0x93, 0xd1, 0x9a, 0xd3, 0xae, 0x30, 0xda, 0x73, 0xf8,
+	0x6c, 0x2f, 0xb4, 0xcd, 0x22, 0x09, 0xff, 0x88, 0x60, 0x38, 0x5a, 0x31, 0xe0, 0x97, 0xba, 0xf0,
+	0x31, 0x5c, 0x53, 0x09, 0xf3, 0x59, 0x4c, 0x39, 0xdb, 0x79, 0xc6, 0xb6, 0x8c, 0x17, 0x7b, 0x61,
+	0xf3, 0x6a, 0x93, 0x3f, 0x11, 0x8c, 0xb4, 0xe4, 0x71, 0x9c, 0xc2, 0xbd, 0x76, 0x25, 0x88, 0x70,
+	0x3a, 0x93, 0x2d, 0x67, 0x2b, 0x33, 0xb6, 0xd7, 0xf1, 0xb5, 0x44, 0x36, 0xff, 0xfe, 0xb6, 0xa5,
+	0xdb, 0x2d, 0x97, 0xfc, 0x1d, 0x89, 0xef, 0xcc, 0x38, 0x6e, 0xfc, 0x04, 0xc1, 0xb3, 0xf1, 0xa9,
+	0x1c, 0xbf, 0xd2, 0x8d, 0xe3, 0x31, 0x25, 0x86, 0xf0, 0x6a, 0x76, 0x81, 0xae, 0x96, 0x36, 0x1d,
+	0x3e, 0x3b, 0x98, 0x31, 0x39, 0x37, 0xcd, 0xc1, 0x6c, 0x5f, 0x04, 0x08, 0x67, 0x32, 0x5a, 0x77,
+	0x75, 0x30, 0x3b, 0x10, 0x06, 0x1e, 0x92, 0xfe, 0x42, 0x30, 0xda, 0x2e, 0x57, 0xe3, 0x85, 0x2e,
+	0x7c, 0x8d, 0x2f, 0x23, 0x84, 0x62, 0x2f, 0x12, 0x9c, 0xf9, 0x0a, 0x63, 0x5e, 0xc1, 0x17, 0x7a,
+	0x61, 0x8e, 0x16, 0x1b, 0xf8, 0x4b, 0x04, 0x7b, 0x42, 0x39, 0x1a, 0x9f, 0xe8, 0xec, 0x6b, 0x5c,
+	0xca, 0x17, 0x4e, 0x76, 0x6d, 0xc7, 0xc1, 0x8e, 0x33, 0xb0, 0x19, 0x3c, 0x9d, 0x08, 0x56, 0xf1,
+	0x6c, 0xcb, 0x4e, 0x6a, 0x67, 0x7e, 0x87, 0x4a, 0x9c, 0x34, 0x7e, 0xc7, 0x15, 0x4c, 0xc2, 0xc9,
+	0xae, 0xed, 0xba, 0xf2, 0x3b, 0x50, 0x5c, 0x39, 0xe5, 0xd6, 0x77, 0x08, 0x86, 0xa3, 0x2f, 0x37,
+	0x69, 0x52, 0x40, 0x9b, 0xd7, 0x20, 0x61, 0x3e, 0x8b, 0x29, 0x07, 0x38, 0xc1, 0x00, 0x66, 0x71,
+	0x21, 0x11, 0xc0, 0x72, 0xcd, 0xcb, 0xfe, 0x93, 0x10, 0xfe, 0xc6, 0x67, 0x68, 0xbe, 0xd9, 0xe2,
+	0x93, 0xa9, 0x1d, 0x09, 0x3f, 0xf9, 0xa5, 0x27, 0x68, 0x7d, 0x20, 0x16, 0x5f, 0x64, 0x04, 0x05,
+	0xfc, 0x42, 0x2a, 0x02, 0xef, 0xa9, 0xee, 0x6f, 0x04, 0x07, 0xda, 0xbe, 0xa1, 0xe2, 0x62, 0xb7,
+	0x20, 0xad, 0xef, 0xc3, 0xc2, 0x62, 0x4f, 0x1a, 0x1c, 0x6e, 0x8d, 0xc1, 0x5d, 0xc4, 0xe7, 0x7b,
+	0xc9, 0xd0, 0x51, 0xf6, 0xef, 0x11, 0x8c, 0xb4, 0xbc, 0x89, 0xa6, 0xc9, 0xd4, 0xed, 0x9e, 0x6c,
+	0x85, 0xd3, 0x99, 0x6c, 0x39, 0xe3, 0x29, 0xc6, 0x38, 0x87, 0x67, 0x13, 0x19, 0x65, 0x5d, 0x2f,
+	0x47, 0x41, 0x02, 0x07, 0xc9, 0xff, 0x33, 0xa3, 0x8b, 0x83, 0x14, 0xfd, 0x9f, 0x45, 0x98, 0xcf,
+	0x62, 0x9a, 0xe9, 0x20, 0xd9, 0x9e, 0x7d, 0xf1, 0xfc, 0x83, 0xed, 0x1c, 0x7a, 0xb8, 0x9d, 0x43,
+	0xbf, 0x6e, 0xe7, 0xd0, 0xc7, 0x8f, 0x73, 0x7d, 0x0f, 0x1f, 0xe7, 0xfa, 0x7e, 0x7a, 0x9c, 0xeb,
+	0x7b, 0xe3, 0x58, 0xe2, 0x8f, 0x9d, 0xcd, 0xf0, 0x04, 0xec, 0xb7, 0xcf, 0xfa, 0x4e, 0xf6, 0x7f,
+	0xd2, 0xf1, 0x7f, 0x06, 0x00, 0xc3, 0x65, 0x6f, 0x3d, 0x47, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1239,6 +1577,13 @@ type QueryClient interface {
 	RestakeThreshold(ctx context.Context, in *QueryRestakeThresholdRequest, opts ...grpc.CallOption) (*QueryRestakeThresholdResponse, error)
 	// RestakeThreshold queries the community pool coins.
 	RestakingEntries(ctx context.Context, in *QueryRestakeEntriesRequest, opts ...grpc.CallOption) (*QueryRestakingEntriesResponse, error)
+	// RestakeEntriesByValidator queries the auto-restake entries of all delegators
+	// of a validator.
+	RestakeEntriesByValidator(ctx context.Context, in *QueryRestakeEntriesByValidatorRequest, opts ...grpc.CallOption) (*QueryRestakeEntriesByValidatorResponse, error)
+	// AllRestakeEntries queries all auto-restake entries.
+	AllRestakeEntries(ctx context.Context, in *QueryAllRestakeEntriesRequest, opts ...grpc.CallOption) (*QueryAllRestakeEntriesResponse, error)
+	// RestakeScheduler queries the progress of the auto-restake scheduler.
+	RestakeScheduler(ctx context.Context, in *QueryRestakeSchedulerRequest, opts ...grpc.CallOption) (*QueryRestakeSchedulerResponse, error)
 }
 
 type queryClient struct {
@@ -1357,6 +1702,33 @@ func (c *queryClient) RestakingEntries(ctx context.Context, in *QueryRestakeEntr
 	return out, nil
 }
 
+func (c *queryClient) RestakeEntriesByValidator(ctx context.Context, in *QueryRestakeEntriesByValidatorRequest, opts ...grpc.CallOption) (*QueryRestakeEntriesByValidatorResponse, error) {
+	out := new(QueryRestakeEntriesByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/RestakeEntriesByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllRestakeEntries(ctx context.Context, in *QueryAllRestakeEntriesRequest, opts ...grpc.CallOption) (*QueryAllRestakeEntriesResponse, error) {
+	out := new(QueryAllRestakeEntriesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AllRestakeEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RestakeScheduler(ctx context.Context, in *QueryRestakeSchedulerRequest, opts ...grpc.CallOption) (*QueryRestakeSchedulerResponse, error) {
+	out := new(QueryRestakeSchedulerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/RestakeScheduler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1384,6 +1756,13 @@ type QueryServer interface {
 	RestakeThreshold(context.Context, *QueryRestakeThresholdRequest) (*QueryRestakeThresholdResponse, error)
 	// RestakeThreshold queries the community pool coins.
 	RestakingEntries(context.Context, *QueryRestakeEntriesRequest) (*QueryRestakingEntriesResponse, error)
+	// RestakeEntriesByValidator queries the auto-restake entries of all delegators
+	// of a validator.
+	RestakeEntriesByValidator(context.Context, *QueryRestakeEntriesByValidatorRequest) (*QueryRestakeEntriesByValidatorResponse, error)
+	// AllRestakeEntries queries all auto-restake entries.
+	AllRestakeEntries(context.Context, *QueryAllRestakeEntriesRequest) (*QueryAllRestakeEntriesResponse, error)
+	// RestakeScheduler queries the progress of the auto-restake scheduler.
+	RestakeScheduler(context.Context, *QueryRestakeSchedulerRequest) (*QueryRestakeSchedulerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1426,8 +1805,17 @@ func (*UnimplementedQueryServer) RestakeThreshold(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) RestakingEntries(ctx context.Context, req *QueryRestakeEntriesRequest) (*QueryRestakingEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestakingEntries not implemented")
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+func (*UnimplementedQueryServer) RestakeEntriesByValidator(ctx context.Context, req *QueryRestakeEntriesByValidatorRequest) (*QueryRestakeEntriesByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestakeEntriesByValidator not implemented")
+}
+func (*UnimplementedQueryServer) AllRestakeEntries(ctx context.Context, req *QueryAllRestakeEntriesRequest) (*QueryAllRestakeEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRestakeEntries not implemented")
+}
+func (*UnimplementedQueryServer) RestakeScheduler(ctx context.Context, req *QueryRestakeSchedulerRequest) (*QueryRestakeSchedulerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestakeScheduler not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
@@ -1647,6 +2035,60 @@ func _Query_RestakingEntries_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RestakeEntriesByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestakeEntriesByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RestakeEntriesByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/RestakeEntriesByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestakeEntriesByValidator(ctx, req.(*QueryRestakeEntriesByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRestakeEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRestakeEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRestakeEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AllRestakeEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRestakeEntries(ctx, req.(*QueryAllRestakeEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RestakeScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestakeSchedulerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RestakeScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/RestakeScheduler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestakeScheduler(ctx, req.(*QueryRestakeSchedulerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1699,12 +2141,24 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestakingEntries",
 			Handler:    _Query_RestakingEntries_Handler,
 		},
+		{
+			MethodName: "RestakeEntriesByValidator",
+			Handler:    _Query_RestakeEntriesByValidator_Handler,
+		},
+		{
+			MethodName: "AllRestakeEntries",
+			Handler:    _Query_AllRestakeEntries_Handler,
+		},
+		{
+			MethodName: "RestakeScheduler",
+			Handler:    _Query_RestakeScheduler_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
 }
 
-func (m *QueryRestakeEntriesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeSchedulerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1714,27 +2168,20 @@ func (m *QueryRestakeEntriesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRestakeEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeSchedulerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRestakeEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeSchedulerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRestakingEntriesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeSchedulerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1744,29 +2191,35 @@ func (m *QueryRestakingEntriesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRestakingEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeSchedulerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRestakingEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeSchedulerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Validators[iNdEx])
-			copy(dAtA[i:], m.Validators[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.NextRoundHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRoundHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRestakeThresholdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeEntriesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1776,20 +2229,27 @@ func (m *QueryRestakeThresholdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRestakeThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRestakeThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRestakeThresholdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakingEntriesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1799,30 +2259,43 @@ func (m *QueryRestakeThresholdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRestakeThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakingEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRestakeThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakingEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Threshold.Size()
-		i -= size
-		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeEntriesByValidatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1832,20 +2305,39 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeEntriesByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeEntriesByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeEntriesByValidatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1855,30 +2347,46 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeEntriesByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeEntriesByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllRestakeEntriesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1888,27 +2396,32 @@ func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllRestakeEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllRestakeEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllRestakeEntriesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1918,30 +2431,46 @@ func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllRestakeEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllRestakeEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeThresholdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1951,27 +2480,20 @@ func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRestakeThresholdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1981,22 +2503,22 @@ func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRestakeThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRestakeThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -2004,7 +2526,7 @@ func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2014,7 +2536,189 @@ func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2513,6 +3217,29 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryRestakeSchedulerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRestakeSchedulerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextRoundHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextRoundHeight))
+	}
+	return n
+}
+
 func (m *QueryRestakeEntriesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2538,83 +3265,157 @@ func (m *QueryRestakingEntriesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryRestakeThresholdRequest) Size() (n int) {
+func (m *QueryRestakeEntriesByValidatorRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryRestakeThresholdResponse) Size() (n int) {
+func (m *QueryRestakeEntriesByValidatorResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Threshold.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryAllRestakeEntriesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryAllRestakeEntriesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+func (m *QueryRestakeThresholdRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+func (m *QueryRestakeThresholdResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Rewards.Size()
+	l = m.Threshold.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2847,6 +3648,158 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *QueryRestakeSchedulerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakeSchedulerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakeSchedulerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakeSchedulerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakeSchedulerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakeSchedulerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRoundHeight", wireType)
+			}
+			m.NextRoundHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRoundHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryRestakeEntriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2990,6 +3943,484 @@ func (m *QueryRestakingEntriesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AutoRestakeEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakeEntriesByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakeEntriesByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakeEntriesByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakeEntriesByValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakeEntriesByValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakeEntriesByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AutoRestakeEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRestakeEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRestakeEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRestakeEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRestakeEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRestakeEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRestakeEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AutoRestakeEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
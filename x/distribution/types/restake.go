@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAutoRestakeEntry creates a new auto-restake entry without restake history.
+func NewAutoRestakeEntry(delegator sdk.AccAddress, validator sdk.ValAddress) AutoRestakeEntry {
+	return AutoRestakeEntry{
+		DelegatorAddress:  delegator.String(),
+		ValidatorAddress:  validator.String(),
+		LastRestakeAmount: sdk.ZeroInt(),
+		TotalRestaked:     sdk.ZeroInt(),
+	}
+}
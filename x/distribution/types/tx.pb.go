@@ -417,6 +417,85 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgRestakeDelegatorReward withdraws the rewards of a delegator from a single
+// validator and delegates amount of them back to the validator. The amount must
+// be in the bond denom and cannot exceed the withdrawn rewards.
+type MsgRestakeDelegatorReward struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRestakeDelegatorReward) Reset()         { *m = MsgRestakeDelegatorReward{} }
+func (m *MsgRestakeDelegatorReward) String() string { return proto.CompactTextString(m) }
+func (*MsgRestakeDelegatorReward) ProtoMessage()    {}
+func (*MsgRestakeDelegatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
+}
+func (m *MsgRestakeDelegatorReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestakeDelegatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestakeDelegatorReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestakeDelegatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestakeDelegatorReward.Merge(m, src)
+}
+func (m *MsgRestakeDelegatorReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestakeDelegatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestakeDelegatorReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestakeDelegatorReward proto.InternalMessageInfo
+
+// MsgRestakeDelegatorRewardResponse defines the Msg/RestakeDelegatorReward response type.
+type MsgRestakeDelegatorRewardResponse struct {
+}
+
+func (m *MsgRestakeDelegatorRewardResponse) Reset()         { *m = MsgRestakeDelegatorRewardResponse{} }
+func (m *MsgRestakeDelegatorRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestakeDelegatorRewardResponse) ProtoMessage()    {}
+func (*MsgRestakeDelegatorRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
+}
+func (m *MsgRestakeDelegatorRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestakeDelegatorRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestakeDelegatorRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestakeDelegatorRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestakeDelegatorRewardResponse.Merge(m, src)
+}
+func (m *MsgRestakeDelegatorRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestakeDelegatorRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestakeDelegatorRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestakeDelegatorRewardResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetAutoRestake)(nil), "cosmos.distribution.v1beta1.MsgSetAutoRestake")
@@ -428,6 +507,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgRestakeDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgRestakeDelegatorReward")
+	proto.RegisterType((*MsgRestakeDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgRestakeDelegatorRewardResponse")
 }
 
 func init() {
@@ -435,47 +516,50 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x8d, 0x28, 0xed, 0x21, 0x95, 0xc4, 0x2a, 0x6a, 0xea, 0x04, 0x3b, 0xb2, 0x2a,
-	0x94, 0x01, 0x6c, 0x12, 0x24, 0x10, 0x61, 0x40, 0x4d, 0x50, 0xa5, 0x0e, 0x11, 0xc8, 0x48, 0x20,
-	0xb1, 0x20, 0x3b, 0x3e, 0xb9, 0xa7, 0xc6, 0xbe, 0xc8, 0x77, 0x6e, 0x92, 0x11, 0x89, 0x81, 0x11,
-	0x89, 0x0f, 0x40, 0x25, 0x16, 0xc4, 0xcc, 0xc8, 0xc4, 0xd4, 0xb1, 0x23, 0x53, 0x80, 0x64, 0x61,
-	0xce, 0x27, 0x40, 0x89, 0xff, 0xe0, 0x24, 0x4e, 0xda, 0x50, 0x06, 0xa6, 0x44, 0x77, 0xcf, 0xf3,
-	0xbb, 0xe7, 0xb5, 0xdf, 0xf7, 0x0c, 0x77, 0x1a, 0x84, 0xda, 0x84, 0xaa, 0x26, 0xa6, 0xcc, 0xc5,
-	0x86, 0xc7, 0x30, 0x71, 0xd4, 0xa3, 0x92, 0x81, 0x98, 0x5e, 0x52, 0x59, 0x47, 0x69, 0xb9, 0x84,
-	0x11, 0x3e, 0xe7, 0xab, 0x94, 0xb8, 0x4a, 0x09, 0x54, 0xc2, 0xa6, 0x45, 0x2c, 0x32, 0xd6, 0xa9,
-	0xa3, 0x7f, 0xbe, 0x45, 0x10, 0x03, 0xb0, 0xa1, 0x53, 0x14, 0x01, 0x1b, 0x04, 0x3b, 0xfe, 0xbe,
-	0xfc, 0x19, 0xc0, 0x6b, 0x75, 0x6a, 0x3d, 0x45, 0xec, 0x39, 0x66, 0x07, 0xa6, 0xab, 0xb7, 0x77,
-	0x4d, 0xd3, 0x45, 0x94, 0xf2, 0xfb, 0x30, 0x63, 0xa2, 0x26, 0xb2, 0x74, 0x46, 0xdc, 0x97, 0xba,
-	0xbf, 0x98, 0x05, 0x05, 0x50, 0x5c, 0xaf, 0xe6, 0x87, 0x3d, 0x29, 0xdb, 0xd5, 0xed, 0x66, 0x45,
-	0x9e, 0x91, 0xc8, 0x5a, 0x3a, 0x5a, 0x0b, 0x51, 0x7b, 0x30, 0xdd, 0x0e, 0xe8, 0x11, 0x69, 0x65,
-	0x4c, 0xca, 0x0d, 0x7b, 0xd2, 0x96, 0x4f, 0x9a, 0x56, 0xc8, 0xda, 0xd5, 0xf6, 0x64, 0xa4, 0xca,
-	0xda, 0x9b, 0x63, 0x89, 0xfb, 0x75, 0x2c, 0x71, 0xf2, 0x4f, 0x00, 0x33, 0x7e, 0xec, 0x5d, 0x8f,
-	0x11, 0x0d, 0x51, 0xa6, 0x1f, 0xa2, 0x7f, 0x19, 0x79, 0x1f, 0x66, 0x8e, 0xf4, 0x26, 0x36, 0x27,
-	0x50, 0x2b, 0xd3, 0xa8, 0x19, 0x89, 0xac, 0xa5, 0xa3, 0xb5, 0x10, 0x75, 0x13, 0x5e, 0x46, 0x8e,
-	0x6e, 0x34, 0x91, 0x99, 0x4d, 0x15, 0x40, 0x71, 0xad, 0xca, 0x0f, 0x7b, 0xd2, 0x86, 0x0f, 0x08,
-	0x36, 0x64, 0x2d, 0x94, 0xc4, 0x6a, 0xcc, 0xc1, 0xed, 0x99, 0x12, 0x35, 0x44, 0x5b, 0xc4, 0xa1,
-	0x48, 0x96, 0xe0, 0xf5, 0xc4, 0xd7, 0x16, 0x09, 0xbe, 0x00, 0x28, 0xd4, 0xa9, 0x15, 0x6e, 0x3f,
-	0x0a, 0x0b, 0xd4, 0x50, 0x5b, 0x77, 0xcd, 0xff, 0xf3, 0x51, 0xc5, 0x8a, 0xdf, 0x81, 0xf2, 0xfc,
-	0xf4, 0x51, 0x91, 0x1e, 0x14, 0x63, 0xaa, 0x67, 0x21, 0xae, 0x46, 0x6c, 0x1b, 0x53, 0x8a, 0x89,
-	0x93, 0x1c, 0x0e, 0x5c, 0x30, 0x5c, 0x11, 0xde, 0x58, 0x7c, 0x6c, 0x14, 0xf0, 0x03, 0x80, 0x9b,
-	0x75, 0x6a, 0xed, 0x79, 0x8e, 0x39, 0xda, 0xf5, 0x1c, 0xcc, 0xba, 0x4f, 0x08, 0x69, 0xf2, 0x0d,
-	0xb8, 0xaa, 0xdb, 0xc4, 0x73, 0x58, 0x16, 0x14, 0x52, 0xc5, 0x2b, 0xe5, 0x6d, 0x25, 0x98, 0xed,
-	0xd1, 0xa0, 0x86, 0x33, 0xad, 0xd4, 0x08, 0x76, 0xaa, 0xb7, 0x4f, 0x7a, 0x12, 0xf7, 0xe9, 0xbb,
-	0x54, 0xb4, 0x30, 0x3b, 0xf0, 0x0c, 0xa5, 0x41, 0x6c, 0x35, 0x98, 0x6a, 0xff, 0xe7, 0x16, 0x35,
-	0x0f, 0x55, 0xd6, 0x6d, 0x21, 0x3a, 0x36, 0x50, 0x2d, 0x40, 0xf3, 0x79, 0xb8, 0x6e, 0xa2, 0x16,
-	0xa1, 0x98, 0x11, 0xd7, 0x7f, 0x23, 0xda, 0x9f, 0x85, 0x58, 0x3d, 0x22, 0xcc, 0x27, 0x85, 0x0c,
-	0xab, 0x28, 0x7f, 0xbd, 0x04, 0x53, 0x75, 0x6a, 0xf1, 0xaf, 0x01, 0xe4, 0x13, 0x6e, 0x8a, 0xb2,
-	0xb2, 0xe0, 0x5e, 0x52, 0x12, 0xdb, 0x54, 0xa8, 0x2c, 0xef, 0x09, 0xe3, 0xf0, 0xef, 0x00, 0xdc,
-	0x9a, 0xd7, 0xd7, 0xf7, 0xce, 0xe2, 0xce, 0x31, 0x0a, 0x0f, 0xff, 0xd2, 0x18, 0xa5, 0x7a, 0x0f,
-	0x60, 0x6e, 0x51, 0x27, 0x3e, 0x38, 0xef, 0x01, 0x09, 0x66, 0xa1, 0x76, 0x01, 0x73, 0x94, 0xf0,
-	0x15, 0x80, 0x99, 0xd9, 0x4e, 0x2c, 0x9d, 0x85, 0x9e, 0xb1, 0x08, 0xf7, 0x97, 0xb6, 0x44, 0x19,
-	0x3a, 0x70, 0x63, 0xea, 0xd2, 0x56, 0xce, 0xd1, 0x09, 0x31, 0xbd, 0x70, 0x77, 0x39, 0x7d, 0x78,
-	0x72, 0xf5, 0xf1, 0xc7, 0xbe, 0x08, 0x4e, 0xfa, 0x22, 0x38, 0xed, 0x8b, 0xe0, 0x47, 0x5f, 0x04,
-	0x6f, 0x07, 0x22, 0x77, 0x3a, 0x10, 0xb9, 0x6f, 0x03, 0x91, 0x7b, 0x51, 0x5a, 0x38, 0x5c, 0x9d,
-	0xc9, 0x0f, 0xf3, 0x78, 0xd6, 0x8c, 0xd5, 0xf1, 0x17, 0xf4, 0xce, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xbd, 0x47, 0xb4, 0x90, 0xbc, 0x07, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xb4, 0x3f, 0xfa, 0x6b, 0x5f, 0xa1, 0x36, 0x4b, 0xb5, 0xe9, 0xa6, 0xee, 0xd6, 0xb5,
+	0x48, 0x0e, 0xba, 0x31, 0x15, 0x2c, 0x56, 0x50, 0x9a, 0x4a, 0xa1, 0x87, 0xa0, 0xac, 0xa0, 0xe0,
+	0x45, 0x76, 0xb3, 0xc3, 0x76, 0x68, 0x76, 0x27, 0xec, 0xcc, 0x36, 0xed, 0x51, 0xf0, 0xe0, 0xb1,
+	0xe0, 0x07, 0xb0, 0xe0, 0x45, 0x3c, 0x7b, 0xf4, 0x03, 0xf4, 0xd8, 0x8b, 0xe0, 0x29, 0x6a, 0x7a,
+	0xf1, 0x5c, 0xfc, 0x00, 0x92, 0xfd, 0x33, 0x6e, 0x9b, 0x4d, 0xfa, 0xcf, 0x83, 0x9e, 0x12, 0x66,
+	0x9e, 0xe7, 0x99, 0xe7, 0x7d, 0x77, 0x9e, 0x77, 0x17, 0xe6, 0xea, 0x94, 0xb9, 0x94, 0x95, 0x6d,
+	0xc2, 0xb8, 0x4f, 0xac, 0x80, 0x13, 0xea, 0x95, 0x37, 0x2a, 0x16, 0xe6, 0x66, 0xa5, 0xcc, 0x37,
+	0xf5, 0xa6, 0x4f, 0x39, 0x95, 0x8a, 0x11, 0x4a, 0x4f, 0xa3, 0xf4, 0x18, 0x25, 0x4f, 0x3a, 0xd4,
+	0xa1, 0x21, 0xae, 0xdc, 0xfd, 0x17, 0x51, 0x64, 0x25, 0x16, 0xb6, 0x4c, 0x86, 0x85, 0x60, 0x9d,
+	0x12, 0x2f, 0xda, 0xd7, 0x3e, 0x22, 0xb8, 0x54, 0x63, 0xce, 0x13, 0xcc, 0x9f, 0x11, 0xbe, 0x66,
+	0xfb, 0x66, 0x6b, 0xc9, 0xb6, 0x7d, 0xcc, 0x98, 0xb4, 0x0a, 0x79, 0x1b, 0x37, 0xb0, 0x63, 0x72,
+	0xea, 0xbf, 0x30, 0xa3, 0xc5, 0x02, 0x9a, 0x45, 0xa5, 0xb1, 0xea, 0xcc, 0x41, 0x5b, 0x2d, 0x6c,
+	0x99, 0x6e, 0x63, 0x51, 0xeb, 0x81, 0x68, 0xc6, 0x84, 0x58, 0x4b, 0xa4, 0x56, 0x60, 0xa2, 0x15,
+	0xab, 0x0b, 0xa5, 0xa1, 0x50, 0xa9, 0x78, 0xd0, 0x56, 0xa7, 0x22, 0xa5, 0xa3, 0x08, 0xcd, 0xb8,
+	0xd8, 0x3a, 0x6c, 0x69, 0x71, 0xf4, 0xf5, 0x8e, 0x9a, 0xfb, 0xb1, 0xa3, 0xe6, 0xb4, 0xef, 0x08,
+	0xf2, 0x91, 0xed, 0xa5, 0x80, 0x53, 0x03, 0x33, 0x6e, 0xae, 0xe3, 0x3f, 0x69, 0x79, 0x15, 0xf2,
+	0x1b, 0x66, 0x83, 0xd8, 0x87, 0xa4, 0x86, 0x8e, 0x4a, 0xf5, 0x40, 0x34, 0x63, 0x42, 0xac, 0x25,
+	0x52, 0x37, 0xe0, 0x7f, 0xec, 0x99, 0x56, 0x03, 0xdb, 0x85, 0xe1, 0x59, 0x54, 0x1a, 0xad, 0x4a,
+	0x07, 0x6d, 0x75, 0x3c, 0x12, 0x88, 0x37, 0x34, 0x23, 0x81, 0xa4, 0x6a, 0x2c, 0xc2, 0x74, 0x4f,
+	0x89, 0x06, 0x66, 0x4d, 0xea, 0x31, 0xac, 0xa9, 0x70, 0x25, 0xf3, 0xb1, 0x09, 0xc0, 0x27, 0x04,
+	0x72, 0x8d, 0x39, 0xc9, 0xf6, 0xc3, 0xa4, 0x40, 0x03, 0xb7, 0x4c, 0xdf, 0xfe, 0x3b, 0x5b, 0x95,
+	0x2a, 0x7e, 0x0e, 0xb4, 0xfe, 0xee, 0x45, 0x91, 0x01, 0x28, 0x29, 0xd4, 0xd3, 0x44, 0x6e, 0x99,
+	0xba, 0x2e, 0x61, 0x8c, 0x50, 0x2f, 0xdb, 0x1c, 0x3a, 0xa7, 0xb9, 0x12, 0x5c, 0x1f, 0x7c, 0xac,
+	0x30, 0xf8, 0x0e, 0xc1, 0x64, 0x8d, 0x39, 0x2b, 0x81, 0x67, 0x77, 0x77, 0x03, 0x8f, 0xf0, 0xad,
+	0xc7, 0x94, 0x36, 0xa4, 0x3a, 0x8c, 0x98, 0x2e, 0x0d, 0x3c, 0x5e, 0x40, 0xb3, 0xc3, 0xa5, 0x0b,
+	0xf3, 0xd3, 0x7a, 0x9c, 0xed, 0x6e, 0x50, 0x93, 0x4c, 0xeb, 0xcb, 0x94, 0x78, 0xd5, 0x5b, 0xbb,
+	0x6d, 0x35, 0xf7, 0xe1, 0xab, 0x5a, 0x72, 0x08, 0x5f, 0x0b, 0x2c, 0xbd, 0x4e, 0xdd, 0x72, 0x9c,
+	0xea, 0xe8, 0xe7, 0x26, 0xb3, 0xd7, 0xcb, 0x7c, 0xab, 0x89, 0x59, 0x48, 0x60, 0x46, 0x2c, 0x2d,
+	0xcd, 0xc0, 0x98, 0x8d, 0x9b, 0x94, 0x11, 0x4e, 0xfd, 0xe8, 0x89, 0x18, 0xbf, 0x17, 0x52, 0xf5,
+	0x28, 0x30, 0x93, 0x65, 0x52, 0x54, 0xf1, 0x13, 0x85, 0x57, 0x31, 0xbe, 0x83, 0xff, 0xc4, 0x55,
+	0x92, 0x16, 0x44, 0x83, 0xbb, 0xa1, 0x1b, 0xd8, 0xe0, 0xff, 0xba, 0x0d, 0x4e, 0x9a, 0x96, 0x6a,
+	0xcb, 0x35, 0xb8, 0xda, 0xb7, 0xea, 0xa4, 0x37, 0xf3, 0x9f, 0x47, 0x60, 0xb8, 0xc6, 0x1c, 0xe9,
+	0x15, 0x02, 0x29, 0x63, 0x8a, 0xce, 0xeb, 0x03, 0x66, 0xb6, 0x9e, 0x19, 0x61, 0x79, 0xf1, 0xf4,
+	0x9c, 0xc4, 0x8e, 0xf4, 0x06, 0xc1, 0x54, 0xbf, 0xcc, 0x2f, 0x1c, 0xa7, 0xdb, 0x87, 0x28, 0x3f,
+	0x38, 0x23, 0x51, 0xb8, 0x7a, 0x8b, 0xa0, 0x38, 0x28, 0xa5, 0xf7, 0x4e, 0x7a, 0x40, 0x06, 0x59,
+	0x5e, 0x3e, 0x07, 0x59, 0x38, 0x7c, 0x89, 0x20, 0xdf, 0x9b, 0xd2, 0xca, 0x71, 0xd2, 0x3d, 0x14,
+	0xf9, 0xee, 0xa9, 0x29, 0xc2, 0xc3, 0x26, 0x8c, 0x1f, 0x79, 0xa1, 0xe9, 0x27, 0xb8, 0x09, 0x29,
+	0xbc, 0x7c, 0xe7, 0x74, 0x78, 0x71, 0xf2, 0x36, 0x82, 0xcb, 0x7d, 0xd2, 0x7d, 0xac, 0x64, 0x36,
+	0x4f, 0xbe, 0x7f, 0x36, 0x5e, 0x62, 0xa9, 0xfa, 0xe8, 0x7d, 0x47, 0x41, 0xbb, 0x1d, 0x05, 0xed,
+	0x75, 0x14, 0xf4, 0xad, 0xa3, 0xa0, 0xed, 0x7d, 0x25, 0xb7, 0xb7, 0xaf, 0xe4, 0xbe, 0xec, 0x2b,
+	0xb9, 0xe7, 0x95, 0x81, 0xb3, 0x70, 0xf3, 0xf0, 0x77, 0x54, 0x38, 0x1a, 0xad, 0x91, 0xf0, 0x83,
+	0xe7, 0xf6, 0xaf, 0x01, 0x00, 0xe3, 0x24, 0x4d, 0xfa, 0x6b, 0x09, 0x00, 0x00,
 }
 
 func (this *MsgSetAutoRestakeResponse) Equal(that interface{}) bool {
@@ -583,6 +667,27 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRestakeDelegatorRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRestakeDelegatorRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgRestakeDelegatorRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -611,6 +716,9 @@ type MsgClient interface {
 	// SetAutoRestake enables or disables automatic restaking for a delegator
 	// validator pair
 	SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
+	// RestakeDelegatorReward defines a method to withdraw the rewards of a
+	// delegator from a single validator and delegate part of them back to it.
+	RestakeDelegatorReward(ctx context.Context, in *MsgRestakeDelegatorReward, opts ...grpc.CallOption) (*MsgRestakeDelegatorRewardResponse, error)
 }
 
 type msgClient struct {
@@ -666,6 +774,15 @@ func (c *msgClient) SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, o
 	return out, nil
 }
 
+func (c *msgClient) RestakeDelegatorReward(ctx context.Context, in *MsgRestakeDelegatorReward, opts ...grpc.CallOption) (*MsgRestakeDelegatorRewardResponse, error) {
+	out := new(MsgRestakeDelegatorRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/RestakeDelegatorReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -683,6 +800,9 @@ type MsgServer interface {
 	// SetAutoRestake enables or disables automatic restaking for a delegator
 	// validator pair
 	SetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
+	// RestakeDelegatorReward defines a method to withdraw the rewards of a
+	// delegator from a single validator and delegate part of them back to it.
+	RestakeDelegatorReward(context.Context, *MsgRestakeDelegatorReward) (*MsgRestakeDelegatorRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -704,6 +824,9 @@ func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFu
 func (*UnimplementedMsgServer) SetAutoRestake(ctx context.Context, req *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRestake not implemented")
 }
+func (*UnimplementedMsgServer) RestakeDelegatorReward(ctx context.Context, req *MsgRestakeDelegatorReward) (*MsgRestakeDelegatorRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestakeDelegatorReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -799,6 +922,24 @@ func _Msg_SetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RestakeDelegatorReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestakeDelegatorReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestakeDelegatorReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/RestakeDelegatorReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestakeDelegatorReward(ctx, req.(*MsgRestakeDelegatorReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -823,6 +964,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAutoRestake",
 			Handler:    _Msg_SetAutoRestake_Handler,
 		},
+		{
+			MethodName: "RestakeDelegatorReward",
+			Handler:    _Msg_RestakeDelegatorReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1138,6 +1283,76 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRestakeDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestakeDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestakeDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestakeDelegatorRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestakeDelegatorRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestakeDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1280,6 +1495,34 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRestakeDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRestakeDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2096,6 +2339,203 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRestakeDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRestakeDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRestakeDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRestakeDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRestakeDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRestakeDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0